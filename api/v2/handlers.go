@@ -0,0 +1,316 @@
+package v2
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MinterTeam/minter-go-node/api/v2/service"
+	gw "github.com/MinterTeam/node-grpc-gateway/api_pb"
+	"github.com/gorilla/handlers"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// jsonHandlerFunc handles requests of methods which are not described in the gateway proto
+type jsonHandlerFunc func(ctx context.Context, query url.Values) (interface{}, error)
+
+// registerJSONHandlers adds methods which are not described in the gateway proto
+func registerJSONHandlers(mux *http.ServeMux, srv *service.Service) {
+	handle := func(path string, handler jsonHandlerFunc) {
+		mux.Handle("/v2"+path, handlers.CompressHandler(allowCORS(jsonHandler(srv.TimeoutDuration(), handler))))
+	}
+	// handlePath overrides a gateway route, the last segment of the path is passed as the param of the query
+	handlePath := func(prefix, param string, handler jsonHandlerFunc) {
+		mux.Handle("/v2"+prefix, handlers.CompressHandler(allowCORS(pathParam("/v2"+prefix, param, jsonHandler(srv.TimeoutDuration(), handler)))))
+	}
+
+	handle("/state_diff", func(ctx context.Context, query url.Values) (interface{}, error) {
+		from, err := uint64Param(query, "from")
+		if err != nil {
+			return nil, err
+		}
+		to, err := uint64Param(query, "to")
+		if err != nil {
+			return nil, err
+		}
+		return srv.StateDiff(ctx, from, to)
+	})
+
+	handle("/candidate_jail", func(ctx context.Context, query url.Values) (interface{}, error) {
+		height, err := optionalUint64Param(query, "height")
+		if err != nil {
+			return nil, err
+		}
+		return srv.CandidateJail(ctx, query.Get("public_key"), height)
+	})
+
+	handle("/rewards", func(ctx context.Context, query url.Values) (interface{}, error) {
+		from, err := uint64Param(query, "from")
+		if err != nil {
+			return nil, err
+		}
+		to, err := optionalUint64Param(query, "to")
+		if err != nil {
+			return nil, err
+		}
+		return srv.Rewards(ctx, query.Get("address"), query.Get("public_key"), from, to)
+	})
+
+	handle("/coin_curve", func(ctx context.Context, query url.Values) (interface{}, error) {
+		coinID, err := uint64Param(query, "coin_id")
+		if err != nil {
+			return nil, err
+		}
+		height, err := optionalUint64Param(query, "height")
+		if err != nil {
+			return nil, err
+		}
+		points, err := optionalUint64Param(query, "points")
+		if err != nil {
+			return nil, err
+		}
+		var amounts []string
+		if query.Get("amounts") != "" {
+			amounts = strings.Split(query.Get("amounts"), ",")
+		}
+		return srv.CoinCurve(ctx, coinID, height, points, query.Get("to_supply"), amounts, query.Get("target_price"))
+	})
+
+	handle("/swap_pool_tiers", func(ctx context.Context, query url.Values) (interface{}, error) {
+		coin0, err := uint64Param(query, "coin0")
+		if err != nil {
+			return nil, err
+		}
+		coin1, err := uint64Param(query, "coin1")
+		if err != nil {
+			return nil, err
+		}
+		height, err := optionalUint64Param(query, "height")
+		if err != nil {
+			return nil, err
+		}
+		return srv.SwapPoolTiers(ctx, coin0, coin1, height)
+	})
+
+	handle("/estimate_swap", func(ctx context.Context, query url.Values) (interface{}, error) {
+		coins, err := uint64ListParam(query, "coins")
+		if err != nil {
+			return nil, err
+		}
+		fees, err := uint64ListParam(query, "fees")
+		if err != nil {
+			return nil, err
+		}
+		height, err := optionalUint64Param(query, "height")
+		if err != nil {
+			return nil, err
+		}
+		fees32 := make([]uint32, 0, len(fees))
+		for _, fee := range fees {
+			fees32 = append(fees32, uint32(fee))
+		}
+		return srv.EstimateSwap(ctx, coins, fees32, query.Get("value_to_sell"), query.Get("value_to_buy"), height)
+	})
+
+	handle("/lp_report", func(ctx context.Context, query url.Values) (interface{}, error) {
+		height, err := optionalUint64Param(query, "height")
+		if err != nil {
+			return nil, err
+		}
+		return srv.LPReport(ctx, query.Get("address"), height)
+	})
+
+	handle("/allowances", func(ctx context.Context, query url.Values) (interface{}, error) {
+		height, err := optionalUint64Param(query, "height")
+		if err != nil {
+			return nil, err
+		}
+		return srv.Allowances(ctx, query.Get("owner"), query.Get("spender"), height)
+	})
+
+	handle("/coin_controls", func(ctx context.Context, query url.Values) (interface{}, error) {
+		coinID, err := uint64Param(query, "coin_id")
+		if err != nil {
+			return nil, err
+		}
+		height, err := optionalUint64Param(query, "height")
+		if err != nil {
+			return nil, err
+		}
+		return srv.CoinControls(ctx, coinID, height)
+	})
+
+	handlePath("/coin_info/", "symbol", func(ctx context.Context, query url.Values) (interface{}, error) {
+		height, err := optionalUint64Param(query, "height")
+		if err != nil {
+			return nil, err
+		}
+		return srv.CoinInfoWithMetadata(ctx, &gw.CoinInfoRequest{Symbol: query.Get("symbol"), Height: height})
+	})
+
+	handlePath("/coin_info_by_id/", "id", func(ctx context.Context, query url.Values) (interface{}, error) {
+		id, err := uint64Param(query, "id")
+		if err != nil {
+			return nil, err
+		}
+		height, err := optionalUint64Param(query, "height")
+		if err != nil {
+			return nil, err
+		}
+		return srv.CoinInfoByIdWithMetadata(ctx, &gw.CoinIdRequest{Id: id, Height: height})
+	})
+
+	handle("/airdrop", func(ctx context.Context, query url.Values) (interface{}, error) {
+		id, err := uint64Param(query, "id")
+		if err != nil {
+			return nil, err
+		}
+		var index *uint64
+		if query.Get("index") != "" {
+			value, err := uint64Param(query, "index")
+			if err != nil {
+				return nil, err
+			}
+			index = &value
+		}
+		height, err := optionalUint64Param(query, "height")
+		if err != nil {
+			return nil, err
+		}
+		return srv.Airdrop(ctx, id, index, height)
+	})
+
+	handle("/schedule", func(ctx context.Context, query url.Values) (interface{}, error) {
+		id, err := uint64Param(query, "id")
+		if err != nil {
+			return nil, err
+		}
+		height, err := optionalUint64Param(query, "height")
+		if err != nil {
+			return nil, err
+		}
+		return srv.Schedule(ctx, id, height)
+	})
+
+	handle("/htlc", func(ctx context.Context, query url.Values) (interface{}, error) {
+		id, err := uint64Param(query, "id")
+		if err != nil {
+			return nil, err
+		}
+		height, err := optionalUint64Param(query, "height")
+		if err != nil {
+			return nil, err
+		}
+		return srv.HTLC(ctx, id, height)
+	})
+
+	handle("/public_key", func(ctx context.Context, query url.Values) (interface{}, error) {
+		return srv.PublicKey(ctx, query.Get("address"))
+	})
+
+	handle("/bls_key", func(ctx context.Context, query url.Values) (interface{}, error) {
+		height, err := optionalUint64Param(query, "height")
+		if err != nil {
+			return nil, err
+		}
+		return srv.BLSKey(ctx, query.Get("address"), height)
+	})
+
+	handle("/candles", func(ctx context.Context, query url.Values) (interface{}, error) {
+		poolID, err := uint64Param(query, "pool_id")
+		if err != nil {
+			return nil, err
+		}
+		var bounds [4]uint64
+		for i, name := range []string{"from_time", "to_time", "from_height", "to_height"} {
+			if bounds[i], err = optionalUint64Param(query, name); err != nil {
+				return nil, err
+			}
+		}
+		return srv.Candles(ctx, poolID, query.Get("interval"), bounds[0], bounds[1], bounds[2], bounds[3])
+	})
+}
+
+func jsonHandler(timeout time.Duration, handler jsonHandlerFunc) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, cancel := context.WithTimeout(r.Context(), timeout)
+		defer cancel()
+
+		result, err := handler(ctx, r.URL.Query())
+		if err != nil {
+			writeJSONError(w, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(result)
+	})
+}
+
+func pathParam(prefix, param string, h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		query.Set(param, strings.TrimPrefix(r.URL.Path, prefix))
+		r.URL.RawQuery = query.Encode()
+		h.ServeHTTP(w, r)
+	})
+}
+
+func writeJSONError(w http.ResponseWriter, err error) {
+	s, ok := status.FromError(err)
+	if !ok {
+		s = status.New(codes.Unknown, err.Error())
+	}
+
+	codeString, data := parseStatus(s)
+	delete(data, "code")
+
+	body, _ := protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}.Marshal(&gw.ErrorBody{
+		Error: &gw.ErrorBody_Error{
+			Code:    codeString,
+			Message: s.Message(),
+			Data:    data,
+		},
+	})
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(runtime.HTTPStatusFromCode(s.Code()))
+	_, _ = w.Write(body)
+}
+
+func uint64Param(query url.Values, name string) (uint64, error) {
+	value, err := strconv.ParseUint(query.Get(name), 10, 64)
+	if err != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "invalid %s: %s", name, query.Get(name))
+	}
+	return value, nil
+}
+
+func optionalUint64Param(query url.Values, name string) (uint64, error) {
+	if query.Get(name) == "" {
+		return 0, nil
+	}
+	return uint64Param(query, name)
+}
+
+func uint64ListParam(query url.Values, name string) ([]uint64, error) {
+	if query.Get(name) == "" {
+		return nil, nil
+	}
+	var values []uint64
+	for _, item := range strings.Split(query.Get(name), ",") {
+		value, err := strconv.ParseUint(item, 10, 32)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid %s: %s", name, query.Get(name))
+		}
+		values = append(values, value)
+	}
+	return values, nil
+}
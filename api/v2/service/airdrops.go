@@ -0,0 +1,54 @@
+package service
+
+import (
+	"context"
+	"encoding/hex"
+	"strconv"
+
+	"github.com/MinterTeam/minter-go-node/coreV2/code"
+	"github.com/MinterTeam/minter-go-node/coreV2/transaction"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// AirdropResponse is an airdrop with the value which is not claimed yet
+type AirdropResponse struct {
+	ID           uint64 `json:"id,string"`
+	Creator      string `json:"creator"`
+	Coin         *Coin  `json:"coin"`
+	Root         string `json:"root"`
+	Value        string `json:"value"`
+	ExpireHeight uint64 `json:"expire_height,string"`
+	Claimed      *bool  `json:"claimed,omitempty"`
+}
+
+// Airdrop returns the airdrop and whether the entry with the index is claimed if the index is given
+func (s *Service) Airdrop(ctx context.Context, id uint64, index *uint64, height uint64) (*AirdropResponse, error) {
+	cState, err := s.blockchain.GetStateForHeight(height)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	airdrop := cState.Airdrops().GetAirdrop(uint32(id))
+	if airdrop == nil {
+		return nil, s.createError(status.New(codes.NotFound, "Airdrop not found"), transaction.EncodeError(code.NewAirdropNotExists(strconv.FormatUint(id, 10))))
+	}
+
+	result := &AirdropResponse{
+		ID:      id,
+		Creator: airdrop.Creator.String(),
+		Coin: &Coin{
+			ID:     uint64(airdrop.Coin),
+			Symbol: cState.Coins().GetCoin(airdrop.Coin).GetFullSymbol(),
+		},
+		Root:         hex.EncodeToString(airdrop.Root[:]),
+		Value:        airdrop.GetValue().String(),
+		ExpireHeight: airdrop.ExpireHeight,
+	}
+	if index != nil {
+		claimed := cState.Airdrops().IsClaimed(uint32(id), uint32(*index))
+		result.Claimed = &claimed
+	}
+
+	return result, nil
+}
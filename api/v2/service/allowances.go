@@ -0,0 +1,82 @@
+package service
+
+import (
+	"context"
+	"encoding/hex"
+	"strings"
+
+	"github.com/MinterTeam/minter-go-node/coreV2/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// AllowancesResponse is a list of allowances given by the owner
+type AllowancesResponse struct {
+	Owner      string       `json:"owner"`
+	Allowances []*Allowance `json:"allowances"`
+}
+
+// Allowance is an amount of the coin which the spender is allowed to transfer from the account of the owner
+type Allowance struct {
+	Spender string `json:"spender"`
+	Coin    *Coin  `json:"coin"`
+	Value   string `json:"value"`
+}
+
+// Coin is an ID and a symbol of a coin
+type Coin struct {
+	ID     uint64 `json:"id,string"`
+	Symbol string `json:"symbol"`
+}
+
+// Allowances returns allowances given by the owner, optionally filtered by the spender
+func (s *Service) Allowances(ctx context.Context, owner, spender string, height uint64) (*AllowancesResponse, error) {
+	ownerAddress, err := decodeAddress(owner)
+	if err != nil {
+		return nil, err
+	}
+	var spenderAddress *types.Address
+	if spender != "" {
+		address, err := decodeAddress(spender)
+		if err != nil {
+			return nil, err
+		}
+		spenderAddress = &address
+	}
+
+	cState, err := s.blockchain.GetStateForHeight(height)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	result := &AllowancesResponse{
+		Owner:      ownerAddress.String(),
+		Allowances: []*Allowance{},
+	}
+	for _, allowance := range cState.Allowances().GetAllowances(ownerAddress) {
+		if spenderAddress != nil && allowance.Spender() != *spenderAddress {
+			continue
+		}
+		result.Allowances = append(result.Allowances, &Allowance{
+			Spender: allowance.Spender().String(),
+			Coin: &Coin{
+				ID:     uint64(allowance.Coin()),
+				Symbol: cState.Coins().GetCoin(allowance.Coin()).GetFullSymbol(),
+			},
+			Value: allowance.GetValue().String(),
+		})
+	}
+
+	return result, nil
+}
+
+func decodeAddress(address string) (types.Address, error) {
+	if !strings.HasPrefix(strings.Title(address), "Mx") {
+		return types.Address{}, status.Error(codes.InvalidArgument, "invalid address")
+	}
+	decoded, err := hex.DecodeString(address[2:])
+	if err != nil || len(decoded) != types.AddressLength {
+		return types.Address{}, status.Error(codes.InvalidArgument, "invalid address")
+	}
+	return types.BytesToAddress(decoded), nil
+}
@@ -0,0 +1,40 @@
+package service
+
+import (
+	"context"
+	"encoding/hex"
+
+	"github.com/MinterTeam/minter-go-node/coreV2/code"
+	"github.com/MinterTeam/minter-go-node/coreV2/transaction"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// BLSKeyResponse is a BLS public key registered by the address
+type BLSKeyResponse struct {
+	Address   string `json:"address"`
+	PublicKey string `json:"public_key"`
+}
+
+// BLSKey returns the BLS public key which the address uses in aggregated multisig signatures
+func (s *Service) BLSKey(ctx context.Context, address string, height uint64) (*BLSKeyResponse, error) {
+	decoded, err := decodeAddress(address)
+	if err != nil {
+		return nil, err
+	}
+
+	cState, err := s.blockchain.GetStateForHeight(height)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	publicKey := cState.BLSKeys().GetKey(decoded)
+	if publicKey == nil {
+		return nil, s.createError(status.New(codes.NotFound, "BLS key not found"), transaction.EncodeError(code.NewBLSKeyNotRegistered(decoded.String())))
+	}
+
+	return &BLSKeyResponse{
+		Address:   decoded.String(),
+		PublicKey: hex.EncodeToString(publicKey),
+	}, nil
+}
@@ -0,0 +1,74 @@
+package service
+
+import (
+	"context"
+	"encoding/hex"
+	"strings"
+
+	"github.com/MinterTeam/minter-go-node/coreV2/state/candidates"
+	"github.com/MinterTeam/minter-go-node/coreV2/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CandidateJailResponse describes the jail of a candidate
+type CandidateJailResponse struct {
+	PublicKey       string `json:"public_key"`
+	Jailed          bool   `json:"jailed"`
+	Reason          string `json:"reason,omitempty"`
+	JailStartHeight uint64 `json:"jail_start_height,string,omitempty"`
+	JailedUntil     uint64 `json:"jailed_until,string"`
+	RemainingBlocks uint64 `json:"remaining_blocks,string"`
+	CanUnjail       bool   `json:"can_unjail"`
+}
+
+// CandidateJail returns jail status of a candidate: the reason, the start height and remaining blocks of the jail.
+// The reason is known only for candidates jailed since v250 update.
+func (s *Service) CandidateJail(ctx context.Context, publicKey string, height uint64) (*CandidateJailResponse, error) {
+	if !strings.HasPrefix(publicKey, "Mp") {
+		return nil, status.Error(codes.InvalidArgument, "invalid public_key")
+	}
+
+	decodeString, err := hex.DecodeString(publicKey[2:])
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	pubkey := types.BytesToPubkey(decodeString)
+
+	cState, err := s.blockchain.GetStateForHeight(height)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	if height != 0 {
+		cState.Candidates().LoadCandidates()
+	} else {
+		height = s.blockchain.Height()
+	}
+
+	candidate := cState.Candidates().GetCandidate(pubkey)
+	if candidate == nil {
+		return nil, status.Error(codes.NotFound, "Candidate not found")
+	}
+
+	if timeoutStatus := s.checkTimeout(ctx); timeoutStatus != nil {
+		return nil, timeoutStatus.Err()
+	}
+
+	result := &CandidateJailResponse{
+		PublicKey:   candidate.PubKey.String(),
+		Jailed:      cState.Candidates().IsCandidateJailed(pubkey, height),
+		JailedUntil: candidate.JailedUntil,
+	}
+	if candidate.JailedUntil > height {
+		result.RemainingBlocks = candidate.JailedUntil - height
+	}
+	if jail := candidate.GetJail(); jail != nil {
+		result.Reason = candidates.JailReasonString(jail.Reason)
+		result.JailStartHeight = jail.Height
+		result.CanUnjail = candidate.JailedUntil < height+1
+	}
+
+	return result, nil
+}
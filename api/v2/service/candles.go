@@ -0,0 +1,115 @@
+package service
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/MinterTeam/minter-go-node/coreV2/candles"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const maxCandles = 1000
+
+// CandlesResponse is OHLCV candles of a swap pool
+type CandlesResponse struct {
+	PoolID   uint32    `json:"pool_id,string"`
+	Interval string    `json:"interval"`
+	Candles  []*Candle `json:"candles"`
+}
+
+// Candle is prices of coin0 in coin1 and volumes of swaps in the pool during an interval starting at Time
+type Candle struct {
+	Time        uint64 `json:"time,string"`
+	Coin0       uint64 `json:"coin0,string"`
+	Coin1       uint64 `json:"coin1,string"`
+	Open        string `json:"open"`
+	High        string `json:"high"`
+	Low         string `json:"low"`
+	Close       string `json:"close"`
+	Volume0     string `json:"volume0"`
+	Volume1     string `json:"volume1"`
+	Trades      uint64 `json:"trades,string"`
+	FirstHeight uint64 `json:"first_height,string"`
+	LastHeight  uint64 `json:"last_height,string"`
+}
+
+// Candles returns candles of the pool in a range of block time in unix seconds or in a range of heights.
+// Heights are converted to the time of their blocks, zero values mean an open range.
+func (s *Service) Candles(ctx context.Context, poolID uint64, interval string, fromTime, toTime, fromHeight, toHeight uint64) (*CandlesResponse, error) {
+	swapCandles := s.blockchain.Candles()
+	if swapCandles == nil {
+		return nil, status.Error(codes.Unavailable, "candles are disabled, set swap_candles in config")
+	}
+
+	if interval == "" {
+		interval = candles.Hour.String()
+	}
+	candleInterval, err := candles.ParseInterval(interval)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if (fromTime != 0 || toTime != 0) && (fromHeight != 0 || toHeight != 0) {
+		return nil, status.Error(codes.InvalidArgument, "either time or height range should be set")
+	}
+	if fromHeight != 0 {
+		if fromTime, err = s.blockTime(ctx, fromHeight); err != nil {
+			return nil, err
+		}
+	}
+	if toHeight != 0 {
+		if toTime, err = s.blockTime(ctx, toHeight); err != nil {
+			return nil, err
+		}
+	}
+	if toTime == 0 {
+		toTime = ^uint64(0)
+	}
+	if fromTime > toTime {
+		return nil, status.Error(codes.InvalidArgument, "start of the range should be less than its end")
+	}
+
+	items, err := swapCandles.Candles(uint32(poolID), candleInterval, fromTime, toTime, maxCandles)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	result := &CandlesResponse{
+		PoolID:   uint32(poolID),
+		Interval: candleInterval.String(),
+		Candles:  make([]*Candle, 0, len(items)),
+	}
+	for _, item := range items {
+		result.Candles = append(result.Candles, &Candle{
+			Time:        item.Start,
+			Coin0:       uint64(item.Coin0),
+			Coin1:       uint64(item.Coin1),
+			Open:        candlePrice(item.Open),
+			High:        candlePrice(item.High),
+			Low:         candlePrice(item.Low),
+			Close:       candlePrice(item.Close),
+			Volume0:     item.Volume0.String(),
+			Volume1:     item.Volume1.String(),
+			Trades:      item.Trades,
+			FirstHeight: item.FirstHeight,
+			LastHeight:  item.LastHeight,
+		})
+	}
+
+	return result, nil
+}
+
+func (s *Service) blockTime(ctx context.Context, height uint64) (uint64, error) {
+	h := int64(height)
+	block, err := s.client.Block(ctx, &h)
+	if err != nil {
+		return 0, status.Error(codes.NotFound, err.Error())
+	}
+	return uint64(block.Block.Time.Unix()), nil
+}
+
+func candlePrice(price *big.Int) string {
+	result := new(big.Float).SetPrec(256).SetInt(price)
+	return result.Quo(result, new(big.Float).SetInt(candles.PricePrecision)).Text('f', 18)
+}
@@ -0,0 +1,201 @@
+package service
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/MinterTeam/minter-go-node/coreV2/code"
+	"github.com/MinterTeam/minter-go-node/coreV2/transaction"
+	"github.com/MinterTeam/minter-go-node/coreV2/types"
+	"github.com/MinterTeam/minter-go-node/formula"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultCurvePoints = 20
+	maxCurvePoints     = 1000
+	maxCurveTrades     = 100
+
+	curvePricePrecision = 18
+)
+
+// CoinCurveResponse describes the bancor curve of a coin
+type CoinCurveResponse struct {
+	CoinID      uint64            `json:"coin_id,string"`
+	Symbol      string            `json:"symbol"`
+	Crr         uint32            `json:"crr,string"`
+	Supply      string            `json:"supply"`
+	Reserve     string            `json:"reserve"`
+	MaxSupply   string            `json:"max_supply"`
+	Price       string            `json:"price"`
+	Points      []*CoinCurvePoint `json:"points"`
+	Trades      []*CoinCurveTrade `json:"trades"`
+	TargetPrice *CoinCurveTarget  `json:"target_price,omitempty"`
+}
+
+// CoinCurvePoint is a sampled point of the curve
+type CoinCurvePoint struct {
+	Supply  string `json:"supply"`
+	Reserve string `json:"reserve"`
+	Price   string `json:"price"`
+}
+
+// CoinCurveTrade is the slippage of buying and selling given amount of the coin for BIP.
+// Slippage is a difference in percents between the average price of the trade and the current price.
+type CoinCurveTrade struct {
+	Amount           string `json:"amount"`
+	BuyCost          string `json:"buy_cost,omitempty"`
+	BuyAveragePrice  string `json:"buy_average_price,omitempty"`
+	BuySlippage      string `json:"buy_slippage,omitempty"`
+	SellReturn       string `json:"sell_return,omitempty"`
+	SellAveragePrice string `json:"sell_average_price,omitempty"`
+	SellSlippage     string `json:"sell_slippage,omitempty"`
+}
+
+// CoinCurveTarget is the state of the coin at which its price reaches the target price
+type CoinCurveTarget struct {
+	Price            string `json:"price"`
+	Supply           string `json:"supply"`
+	Reserve          string `json:"reserve"`
+	ReserveChange    string `json:"reserve_change"`
+	ExceedsMaxSupply bool   `json:"exceeds_max_supply"`
+}
+
+// CoinCurve returns analytics of the bancor curve of a coin: price vs supply sampled up to toSupply (default is
+// doubled current supply limited by max supply), the current marginal price, the slippage of trades of given amounts
+// and the reserve needed to reach a target price. Prices are in BIP per coin, amounts are in pips.
+func (s *Service) CoinCurve(ctx context.Context, coinID uint64, height uint64, points uint64, toSupply string, amounts []string, targetPrice string) (*CoinCurveResponse, error) {
+	if points == 0 {
+		points = defaultCurvePoints
+	}
+	if points > maxCurvePoints {
+		return nil, status.Errorf(codes.InvalidArgument, "maximum number of points is %d", maxCurvePoints)
+	}
+	if len(amounts) > maxCurveTrades {
+		return nil, status.Errorf(codes.InvalidArgument, "maximum number of amounts is %d", maxCurveTrades)
+	}
+
+	cState, err := s.blockchain.GetStateForHeight(height)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	coin := cState.Coins().GetCoin(types.CoinID(coinID))
+	if coin == nil {
+		return nil, s.createError(status.New(codes.NotFound, "Coin not found"), transaction.EncodeError(code.NewCoinNotExists("", types.CoinID(coinID).String())))
+	}
+	if coin.ID().IsBaseCoin() || coin.IsToken() {
+		return nil, status.Error(codes.InvalidArgument, "coin has no reserve")
+	}
+
+	supply, reserve, crr := coin.Volume(), coin.Reserve(), coin.Crr()
+	if supply.Sign() == 0 || reserve.Sign() == 0 {
+		return nil, status.Error(codes.FailedPrecondition, "coin has empty supply or reserve")
+	}
+	price := formula.CalculatePrice(supply, reserve, crr)
+
+	maxSupply := big.NewInt(0).Mul(supply, big.NewInt(2))
+	if maxSupply.Cmp(coin.MaxSupply()) > 0 {
+		maxSupply = coin.MaxSupply()
+	}
+	if toSupply != "" {
+		var ok bool
+		maxSupply, ok = big.NewInt(0).SetString(toSupply, 10)
+		if !ok || maxSupply.Sign() <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "invalid to_supply")
+		}
+	}
+
+	result := &CoinCurveResponse{
+		CoinID:    coinID,
+		Symbol:    coin.GetFullSymbol(),
+		Crr:       crr,
+		Supply:    supply.String(),
+		Reserve:   reserve.String(),
+		MaxSupply: coin.MaxSupply().String(),
+		Price:     price.Text('f', curvePricePrecision),
+		Points:    make([]*CoinCurvePoint, 0, points),
+		Trades:    make([]*CoinCurveTrade, 0, len(amounts)),
+	}
+
+	for i := uint64(1); i <= points; i++ {
+		if timeoutStatus := s.checkTimeout(ctx); timeoutStatus != nil {
+			return nil, timeoutStatus.Err()
+		}
+
+		pointSupply := big.NewInt(0).Mul(maxSupply, big.NewInt(0).SetUint64(i))
+		pointSupply.Div(pointSupply, big.NewInt(0).SetUint64(points))
+		if pointSupply.Sign() == 0 {
+			continue
+		}
+
+		pointReserve := formula.CalculateReserve(supply, reserve, crr, pointSupply)
+		result.Points = append(result.Points, &CoinCurvePoint{
+			Supply:  pointSupply.String(),
+			Reserve: pointReserve.String(),
+			Price:   formula.CalculatePrice(pointSupply, pointReserve, crr).Text('f', curvePricePrecision),
+		})
+	}
+
+	for _, value := range amounts {
+		if timeoutStatus := s.checkTimeout(ctx); timeoutStatus != nil {
+			return nil, timeoutStatus.Err()
+		}
+
+		amount, ok := big.NewInt(0).SetString(value, 10)
+		if !ok || amount.Sign() <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid amount: %s", value)
+		}
+
+		trade := &CoinCurveTrade{Amount: amount.String()}
+		if big.NewInt(0).Add(supply, amount).Cmp(coin.MaxSupply()) <= 0 {
+			cost := formula.CalculatePurchaseAmount(supply, reserve, crr, amount)
+			averagePrice := curveAveragePrice(cost, amount)
+			trade.BuyCost = cost.String()
+			trade.BuyAveragePrice = averagePrice.Text('f', curvePricePrecision)
+			trade.BuySlippage = curveSlippage(averagePrice, price).Text('f', 4)
+		}
+		if amount.Cmp(supply) <= 0 {
+			ret := formula.CalculateSaleReturn(supply, reserve, crr, amount)
+			averagePrice := curveAveragePrice(ret, amount)
+			trade.SellReturn = ret.String()
+			trade.SellAveragePrice = averagePrice.Text('f', curvePricePrecision)
+			trade.SellSlippage = curveSlippage(price, averagePrice).Text('f', 4)
+		}
+		result.Trades = append(result.Trades, trade)
+	}
+
+	if targetPrice != "" {
+		target, ok := big.NewFloat(0).SetPrec(100).SetString(targetPrice)
+		if !ok || target.Sign() <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "invalid target_price")
+		}
+		if crr == 100 {
+			return nil, status.Error(codes.InvalidArgument, "price of coin with crr 100 does not depend on supply")
+		}
+
+		targetSupply, targetReserve := formula.CalculateReserveForPrice(supply, reserve, crr, target)
+		result.TargetPrice = &CoinCurveTarget{
+			Price:            target.Text('f', curvePricePrecision),
+			Supply:           targetSupply.String(),
+			Reserve:          targetReserve.String(),
+			ReserveChange:    big.NewInt(0).Sub(targetReserve, reserve).String(),
+			ExceedsMaxSupply: targetSupply.Cmp(coin.MaxSupply()) > 0,
+		}
+	}
+
+	return result, nil
+}
+
+func curveAveragePrice(bipAmount, coinAmount *big.Int) *big.Float {
+	averagePrice := big.NewFloat(0).SetPrec(100).SetInt(bipAmount)
+	return averagePrice.Quo(averagePrice, big.NewFloat(0).SetPrec(100).SetInt(coinAmount))
+}
+
+// curveSlippage returns (higher / lower - 1) * 100
+func curveSlippage(higher, lower *big.Float) *big.Float {
+	slippage := big.NewFloat(0).SetPrec(100).Quo(higher, lower)
+	slippage.Sub(slippage, big.NewFloat(1))
+	return slippage.Mul(slippage, big.NewFloat(100))
+}
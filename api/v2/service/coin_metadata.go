@@ -0,0 +1,69 @@
+package service
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+
+	"github.com/MinterTeam/minter-go-node/coreV2/types"
+	pb "github.com/MinterTeam/node-grpc-gateway/api_pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// CoinMetadata is a description of the coin set by the owner of the coin
+type CoinMetadata struct {
+	URL         string `json:"url"`
+	Description string `json:"description"`
+	IconHash    string `json:"icon_hash"`
+	Decimals    uint32 `json:"decimals"`
+}
+
+// CoinInfoWithMetadata returns information about coin symbol with metadata of the coin
+func (s *Service) CoinInfoWithMetadata(ctx context.Context, req *pb.CoinInfoRequest) (map[string]interface{}, error) {
+	response, err := s.CoinInfo(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return s.withCoinMetadata(response, req.Height)
+}
+
+// CoinInfoByIdWithMetadata returns information about coin ID with metadata of the coin
+func (s *Service) CoinInfoByIdWithMetadata(ctx context.Context, req *pb.CoinIdRequest) (map[string]interface{}, error) {
+	response, err := s.CoinInfoById(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return s.withCoinMetadata(response, req.Height)
+}
+
+// withCoinMetadata adds metadata field to the response, CoinInfoResponse message of the gateway has no such field
+func (s *Service) withCoinMetadata(response *pb.CoinInfoResponse, height uint64) (map[string]interface{}, error) {
+	cState, err := s.blockchain.GetStateForHeight(height)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	b, err := protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}.Marshal(response)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	result := map[string]interface{}{}
+	if err := json.Unmarshal(b, &result); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	var metadata *CoinMetadata
+	if m := cState.Coins().GetCoin(types.CoinID(response.Id)).Metadata(); m != nil {
+		metadata = &CoinMetadata{
+			URL:         m.URL(),
+			Description: m.Description(),
+			IconHash:    hex.EncodeToString(m.IconHash()),
+			Decimals:    m.Decimals(),
+		}
+	}
+	result["metadata"] = metadata
+
+	return result, nil
+}
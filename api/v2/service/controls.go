@@ -0,0 +1,53 @@
+package service
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/MinterTeam/minter-go-node/coreV2/code"
+	"github.com/MinterTeam/minter-go-node/coreV2/transaction"
+	"github.com/MinterTeam/minter-go-node/coreV2/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CoinControlsResponse is a list of addresses restricted by the owner of the controlled token
+type CoinControlsResponse struct {
+	Coin        *Coin    `json:"coin"`
+	Controlled  bool     `json:"controlled"`
+	Frozen      []string `json:"frozen"`
+	Blocklisted []string `json:"blocklisted"`
+}
+
+// CoinControls returns frozen and blocklisted addresses of the token
+func (s *Service) CoinControls(ctx context.Context, coinID uint64, height uint64) (*CoinControlsResponse, error) {
+	cState, err := s.blockchain.GetStateForHeight(height)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	coin := cState.Coins().GetCoin(types.CoinID(coinID))
+	if coin == nil {
+		return nil, s.createError(status.New(codes.NotFound, "Coin not found"), transaction.EncodeError(code.NewCoinNotExists("", strconv.FormatUint(coinID, 10))))
+	}
+
+	result := &CoinControlsResponse{
+		Coin: &Coin{
+			ID:     coinID,
+			Symbol: coin.GetFullSymbol(),
+		},
+		Controlled:  cState.Controls().IsControlled(coin.ID()),
+		Frozen:      []string{},
+		Blocklisted: []string{},
+	}
+	for _, restriction := range cState.Controls().GetRestrictions(coin.ID()) {
+		if restriction.IsFrozen() {
+			result.Frozen = append(result.Frozen, restriction.Address().String())
+		}
+		if restriction.IsBlocklisted() {
+			result.Blocklisted = append(result.Blocklisted, restriction.Address().String())
+		}
+	}
+
+	return result, nil
+}
@@ -2,18 +2,35 @@ package service
 
 import (
 	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"github.com/MinterTeam/minter-go-node/coreV2/state/coins"
 	"github.com/MinterTeam/minter-go-node/coreV2/transaction"
 	pb "github.com/MinterTeam/node-grpc-gateway/api_pb"
 	"github.com/golang/protobuf/ptypes/any"
+	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 	_struct "google.golang.org/protobuf/types/known/structpb"
+	"strconv"
 )
 
 func encode(data transaction.Data, rCoins coins.RCoins) (*any.Any, error) {
+	m, err := encodeData(data, rCoins)
+	if err != nil {
+		return nil, err
+	}
+
+	a, err := anypb.New(m)
+	if err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+func encodeData(data transaction.Data, rCoins coins.RCoins) (proto.Message, error) {
 	var m proto.Message
 	switch d := data.(type) {
 	case *transaction.BuyCoinData:
@@ -327,16 +344,254 @@ func encode(data transaction.Data, rCoins coins.RCoins) (*any.Any, error) {
 			Volume0: d.Volume0.String(),
 			Volume1: d.Volume1.String(),
 		}
+	case *transaction.VoteSlotsData:
+		var err error
+		m, err = toStruct(map[string]interface{}{
+			"pub_key":    d.PubKey.String(),
+			"height":     strconv.FormatUint(d.Height, 10),
+			"validators": strconv.FormatUint(uint64(d.Validators), 10),
+			"candidates": strconv.FormatUint(uint64(d.Candidates), 10),
+		})
+		if err != nil {
+			return nil, err
+		}
+	case *transaction.UnjailData:
+		var err error
+		m, err = toStruct(map[string]interface{}{
+			"pub_key": d.PubKey.String(),
+		})
+		if err != nil {
+			return nil, err
+		}
+	case *transaction.ApproveData:
+		var err error
+		m, err = toStruct(map[string]interface{}{
+			"spender": d.Spender.String(),
+			"coin": map[string]interface{}{
+				"id":     strconv.FormatUint(uint64(d.Coin), 10),
+				"symbol": rCoins.GetCoin(d.Coin).GetFullSymbol(),
+			},
+			"value": d.Value.String(),
+		})
+		if err != nil {
+			return nil, err
+		}
+	case *transaction.CreateAirdropData:
+		var err error
+		m, err = toStruct(map[string]interface{}{
+			"coin": map[string]interface{}{
+				"id":     strconv.FormatUint(uint64(d.Coin), 10),
+				"symbol": rCoins.GetCoin(d.Coin).GetFullSymbol(),
+			},
+			"value":         d.Value.String(),
+			"root":          hex.EncodeToString(d.Root[:]),
+			"expire_height": strconv.FormatUint(d.ExpireHeight, 10),
+		})
+		if err != nil {
+			return nil, err
+		}
+	case *transaction.ClaimAirdropData:
+		proof := make([]string, 0, len(d.Proof))
+		for _, node := range d.Proof {
+			proof = append(proof, hex.EncodeToString(node[:]))
+		}
+		var err error
+		m, err = toStruct(map[string]interface{}{
+			"airdrop": strconv.FormatUint(uint64(d.Airdrop), 10),
+			"index":   strconv.FormatUint(uint64(d.Index), 10),
+			"value":   d.Value.String(),
+			"proof":   proof,
+		})
+		if err != nil {
+			return nil, err
+		}
+	case *transaction.ReclaimAirdropData:
+		var err error
+		m, err = toStruct(map[string]interface{}{
+			"airdrop": strconv.FormatUint(uint64(d.Airdrop), 10),
+		})
+		if err != nil {
+			return nil, err
+		}
+	case *transaction.RegisterBLSKeyData:
+		var err error
+		m, err = toStruct(map[string]interface{}{
+			"public_key": hex.EncodeToString(d.PublicKey),
+			"proof":      hex.EncodeToString(d.Proof),
+		})
+		if err != nil {
+			return nil, err
+		}
+	case *transaction.SetAccountKeyData:
+		var err error
+		m, err = toStruct(map[string]interface{}{
+			"signer": d.Signer.String(),
+		})
+		if err != nil {
+			return nil, err
+		}
+	case *transaction.CreateScheduleData:
+		var err error
+		m, err = toStruct(map[string]interface{}{
+			"recipient": d.Recipient.String(),
+			"coin": map[string]interface{}{
+				"id":     strconv.FormatUint(uint64(d.Coin), 10),
+				"symbol": rCoins.GetCoin(d.Coin).GetFullSymbol(),
+			},
+			"value":        d.Value.String(),
+			"start_height": strconv.FormatUint(d.StartHeight, 10),
+			"interval":     strconv.FormatUint(d.Interval, 10),
+			"count":        strconv.FormatUint(uint64(d.Count), 10),
+		})
+		if err != nil {
+			return nil, err
+		}
+	case *transaction.CancelScheduleData:
+		var err error
+		m, err = toStruct(map[string]interface{}{
+			"schedule": strconv.FormatUint(uint64(d.Schedule), 10),
+		})
+		if err != nil {
+			return nil, err
+		}
+	case *transaction.CreateHTLCData:
+		var err error
+		m, err = toStruct(map[string]interface{}{
+			"recipient": d.Recipient.String(),
+			"coin": map[string]interface{}{
+				"id":     strconv.FormatUint(uint64(d.Coin), 10),
+				"symbol": rCoins.GetCoin(d.Coin).GetFullSymbol(),
+			},
+			"value":     d.Value.String(),
+			"hash_lock": hex.EncodeToString(d.HashLock[:]),
+			"timeout":   strconv.FormatUint(d.Timeout, 10),
+		})
+		if err != nil {
+			return nil, err
+		}
+	case *transaction.ClaimHTLCData:
+		var err error
+		m, err = toStruct(map[string]interface{}{
+			"htlc":     strconv.FormatUint(uint64(d.HTLC), 10),
+			"preimage": hex.EncodeToString(d.Preimage),
+		})
+		if err != nil {
+			return nil, err
+		}
+	case *transaction.RefundHTLCData:
+		var err error
+		m, err = toStruct(map[string]interface{}{
+			"htlc": strconv.FormatUint(uint64(d.HTLC), 10),
+		})
+		if err != nil {
+			return nil, err
+		}
+	case *transaction.TransferFromData:
+		var err error
+		m, err = toStruct(map[string]interface{}{
+			"owner": d.Owner.String(),
+			"to":    d.To.String(),
+			"coin": map[string]interface{}{
+				"id":     strconv.FormatUint(uint64(d.Coin), 10),
+				"symbol": rCoins.GetCoin(d.Coin).GetFullSymbol(),
+			},
+			"value": d.Value.String(),
+		})
+		if err != nil {
+			return nil, err
+		}
+	case *transaction.ControlTokenData:
+		var err error
+		m, err = toStruct(map[string]interface{}{
+			"coin": map[string]interface{}{
+				"id":     strconv.FormatUint(uint64(d.Coin), 10),
+				"symbol": rCoins.GetCoin(d.Coin).GetFullSymbol(),
+			},
+			"address":     d.Address.String(),
+			"frozen":      d.Frozen,
+			"blocklisted": d.Blocklisted,
+		})
+		if err != nil {
+			return nil, err
+		}
+	case *transaction.EditCoinMetadataData:
+		var err error
+		m, err = toStruct(map[string]interface{}{
+			"coin": map[string]interface{}{
+				"id":     strconv.FormatUint(uint64(d.Coin), 10),
+				"symbol": rCoins.GetCoin(d.Coin).GetFullSymbol(),
+			},
+			"url":         d.URL,
+			"description": d.Description,
+			"icon_hash":   hex.EncodeToString(d.IconHash),
+			"decimals":    strconv.FormatUint(uint64(d.Decimals), 10),
+		})
+		if err != nil {
+			return nil, err
+		}
+	case *transaction.RedeemCheckDataV250:
+		return encodeData(&transaction.RedeemCheckData{RawCheck: d.RawCheck, Proof: d.Proof}, rCoins)
+	case *transaction.CreateTokenDataV250:
+		m, err := encodeData(&transaction.CreateTokenData{Name: d.Name, Symbol: d.Symbol, InitialAmount: d.InitialAmount, MaxSupply: d.MaxSupply, Mintable: d.Mintable, Burnable: d.Burnable}, rCoins)
+		if err != nil || len(d.Controlled) == 0 {
+			return m, err
+		}
+		return withField(m, "controlled", d.Controlled[0])
+	case *transaction.CreateSwapPoolDataV250:
+		return encodeWithFees(&transaction.CreateSwapPoolData{Coin0: d.Coin0, Coin1: d.Coin1, Volume0: d.Volume0, Volume1: d.Volume1}, rCoins, "fee", d.Fee)
+	case *transaction.AddLiquidityDataV250:
+		return encodeWithFees(&transaction.AddLiquidityData{Coin0: d.Coin0, Coin1: d.Coin1, Volume0: d.Volume0, MaximumVolume1: d.MaximumVolume1}, rCoins, "fee", d.Fee)
+	case *transaction.RemoveLiquidityV250:
+		return encodeWithFees(&transaction.RemoveLiquidity{Coin0: d.Coin0, Coin1: d.Coin1, Liquidity: d.Liquidity, MinimumVolume0: d.MinimumVolume0, MinimumVolume1: d.MinimumVolume1}, rCoins, "fee", d.Fee)
+	case *transaction.SellSwapPoolDataV250:
+		return encodeWithFees(&transaction.SellSwapPoolData{Coins: d.Coins, ValueToSell: d.ValueToSell, MinimumValueToBuy: d.MinimumValueToBuy}, rCoins, "fees", d.Fees)
+	case *transaction.BuySwapPoolDataV250:
+		return encodeWithFees(&transaction.BuySwapPoolData{Coins: d.Coins, ValueToBuy: d.ValueToBuy, MaximumValueToSell: d.MaximumValueToSell}, rCoins, "fees", d.Fees)
+	case *transaction.SellAllSwapPoolDataV250:
+		return encodeWithFees(&transaction.SellAllSwapPoolData{Coins: d.Coins, MinimumValueToBuy: d.MinimumValueToBuy}, rCoins, "fees", d.Fees)
 	default:
 		return nil, errors.New("unknown tx type")
 	}
 
-	a, err := anypb.New(m)
+	return m, nil
+}
+
+// encodeWithFees encodes data of swap pool transactions with fee tiers of pools.
+// Data without fees is encoded into the same message as before fee tiers.
+func encodeWithFees(data transaction.Data, rCoins coins.RCoins, key string, fees []uint32) (proto.Message, error) {
+	m, err := encodeData(data, rCoins)
+	if err != nil || len(fees) == 0 {
+		return m, err
+	}
+
+	values := make([]interface{}, 0, len(fees))
+	for _, fee := range fees {
+		values = append(values, strconv.FormatUint(uint64(fee), 10))
+	}
+	if len(values) == 1 && key == "fee" {
+		return withField(m, key, values[0])
+	}
+	return withField(m, key, values)
+}
+
+// withField encodes the message into a struct with an additional field
+func withField(m proto.Message, key string, v interface{}) (proto.Message, error) {
+	b, err := protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	dataStruct, err := encodeToStruct(b)
 	if err != nil {
 		return nil, err
 	}
 
-	return a, nil
+	value, err := _struct.NewValue(v)
+	if err != nil {
+		return nil, err
+	}
+	dataStruct.Fields[key] = value
+
+	return dataStruct, nil
 }
 
 func priceCommissionData(d *transaction.VoteCommissionData, coin *coins.Model) proto.Message {
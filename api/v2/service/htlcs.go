@@ -0,0 +1,49 @@
+package service
+
+import (
+	"context"
+	"encoding/hex"
+	"strconv"
+
+	"github.com/MinterTeam/minter-go-node/coreV2/code"
+	"github.com/MinterTeam/minter-go-node/coreV2/transaction"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// HTLCResponse is a hash time-locked contract which is not claimed or refunded yet
+type HTLCResponse struct {
+	ID        uint64 `json:"id,string"`
+	Sender    string `json:"sender"`
+	Recipient string `json:"recipient"`
+	Coin      *Coin  `json:"coin"`
+	Value     string `json:"value"`
+	HashLock  string `json:"hash_lock"`
+	Timeout   uint64 `json:"timeout,string"`
+}
+
+// HTLC returns the hash time-locked contract by its ID
+func (s *Service) HTLC(ctx context.Context, id uint64, height uint64) (*HTLCResponse, error) {
+	cState, err := s.blockchain.GetStateForHeight(height)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	htlc := cState.HTLCs().GetHTLC(uint32(id))
+	if htlc == nil {
+		return nil, s.createError(status.New(codes.NotFound, "HTLC not found"), transaction.EncodeError(code.NewHTLCNotExists(strconv.FormatUint(id, 10))))
+	}
+
+	return &HTLCResponse{
+		ID:        id,
+		Sender:    htlc.Sender.String(),
+		Recipient: htlc.Recipient.String(),
+		Coin: &Coin{
+			ID:     uint64(htlc.Coin),
+			Symbol: cState.Coins().GetCoin(htlc.Coin).GetFullSymbol(),
+		},
+		Value:    htlc.GetValue().String(),
+		HashLock: hex.EncodeToString(htlc.HashLock[:]),
+		Timeout:  htlc.Timeout,
+	}, nil
+}
@@ -0,0 +1,205 @@
+package service
+
+import (
+	"context"
+	"encoding/hex"
+	"math/big"
+	"strings"
+
+	"github.com/MinterTeam/minter-go-node/coreV2/lpstats"
+	"github.com/MinterTeam/minter-go-node/coreV2/state"
+	"github.com/MinterTeam/minter-go-node/coreV2/state/swap"
+	"github.com/MinterTeam/minter-go-node/coreV2/transaction"
+	"github.com/MinterTeam/minter-go-node/coreV2/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// LPReportNote describes limits of the data of LPReportResponse
+const LPReportNote = "positions include only liquidity added and removed since the node started to track pool statistics, " +
+	"earlier history is not indexed and pool tokens sent or received by transfers are not followed"
+
+// LPReportResponse is a report of earnings of a liquidity provider
+type LPReportResponse struct {
+	Address   string        `json:"address"`
+	Height    uint64        `json:"height,string"`
+	Positions []*LPPosition `json:"positions"`
+	Note      string        `json:"note"`
+}
+
+// LPPosition is a position of a provider in a pool.
+// Values are in coin1 at the current price of the pool, impermanent loss is in percents and excludes earned fees.
+// Liquidity is the tracked one, Balance is the actual balance of pool tokens which includes transferred ones.
+type LPPosition struct {
+	PoolID           uint32            `json:"pool_id,string"`
+	Fee              uint32            `json:"fee,string"`
+	Coin0            uint64            `json:"coin0,string"`
+	Coin1            uint64            `json:"coin1,string"`
+	Liquidity        string            `json:"liquidity"`
+	Balance          string            `json:"balance"`
+	Amount0          string            `json:"amount0"`
+	Amount1          string            `json:"amount1"`
+	CostBasis0       string            `json:"cost_basis0"`
+	CostBasis1       string            `json:"cost_basis1"`
+	EarnedFee0       string            `json:"earned_fee0"`
+	EarnedFee1       string            `json:"earned_fee1"`
+	PoolFees0        string            `json:"pool_fees0"`
+	PoolFees1        string            `json:"pool_fees1"`
+	HoldValue        string            `json:"hold_value"`
+	PositionValue    string            `json:"position_value"`
+	FeesValue        string            `json:"fees_value"`
+	ImpermanentLoss  string            `json:"impermanent_loss"`
+	LiquidityHistory []*LPHistoryEntry `json:"history"`
+}
+
+// LPHistoryEntry is an add or remove of liquidity by the provider
+type LPHistoryEntry struct {
+	Height    uint64 `json:"height,string"`
+	Type      string `json:"type"`
+	Liquidity string `json:"liquidity"`
+	Amount0   string `json:"amount0"`
+	Amount1   string `json:"amount1"`
+}
+
+// LPReport returns earned fees, cost basis and impermanent loss of positions of a liquidity provider.
+// Only liquidity added and removed after the node started to track pool statistics is counted, there is no backfill
+// of earlier blocks. Pool tokens sent or received by Send, Multisend and other transfers are not followed.
+func (s *Service) LPReport(ctx context.Context, address string, height uint64) (*LPReportResponse, error) {
+	if !strings.HasPrefix(strings.Title(address), "Mx") {
+		return nil, status.Error(codes.InvalidArgument, "invalid address")
+	}
+	decodeAddress, err := hex.DecodeString(address[2:])
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid address")
+	}
+	provider := types.BytesToAddress(decodeAddress)
+
+	lpStats := s.blockchain.LPStats()
+	if lpStats == nil {
+		return nil, status.Error(codes.Unavailable, "pool statistics are not tracked in validator mode")
+	}
+
+	cState, err := s.blockchain.GetStateForHeight(height)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if height == 0 {
+		height = s.blockchain.Height()
+	}
+
+	entries, err := lpStats.History(provider, height)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	result := &LPReportResponse{
+		Address:   provider.String(),
+		Height:    height,
+		Positions: []*LPPosition{},
+		Note:      LPReportNote,
+	}
+	for _, position := range lpstats.Positions(entries) {
+		if timeoutStatus := s.checkTimeout(ctx); timeoutStatus != nil {
+			return nil, timeoutStatus.Err()
+		}
+
+		poolFees, err := lpStats.PoolFees(position.PoolID, height)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		position.Earn(poolFees)
+
+		result.Positions = append(result.Positions, lpPosition(cState, provider, position, poolFees))
+	}
+
+	return result, nil
+}
+
+func lpPosition(cState *state.CheckState, provider types.Address, position *lpstats.Position, poolFees *lpstats.PoolFees) *LPPosition {
+	result := &LPPosition{
+		PoolID:           position.PoolID,
+		Coin0:            uint64(position.Coin0),
+		Coin1:            uint64(position.Coin1),
+		Liquidity:        position.Liquidity.String(),
+		Balance:          "0",
+		Amount0:          "0",
+		Amount1:          "0",
+		CostBasis0:       position.CostBasis0.String(),
+		CostBasis1:       position.CostBasis1.String(),
+		EarnedFee0:       position.Earned0.String(),
+		EarnedFee1:       position.Earned1.String(),
+		PoolFees0:        "0",
+		PoolFees1:        "0",
+		HoldValue:        "0",
+		PositionValue:    "0",
+		FeesValue:        "0",
+		ImpermanentLoss:  "0",
+		LiquidityHistory: make([]*LPHistoryEntry, 0, len(position.History)),
+	}
+	if poolFees != nil {
+		result.PoolFees0 = poolFees.Amount0.String()
+		result.PoolFees1 = poolFees.Amount1.String()
+	}
+	for _, entry := range position.History {
+		result.LiquidityHistory = append(result.LiquidityHistory, &LPHistoryEntry{
+			Height:    entry.Height,
+			Type:      entry.Type.String(),
+			Liquidity: entry.Liquidity.String(),
+			Amount0:   entry.Amount0.String(),
+			Amount1:   entry.Amount1.String(),
+		})
+	}
+
+	swapper := poolByID(cState, position.Coin0, position.Coin1, position.PoolID)
+	if swapper == nil {
+		return result
+	}
+	result.Fee = swapper.Fee()
+
+	coinLiquidity := cState.Coins().GetCoinBySymbol(transaction.LiquidityCoinSymbol(position.PoolID), 0)
+	if coinLiquidity == nil || coinLiquidity.Volume().Sign() != 1 {
+		return result
+	}
+	result.Balance = cState.Accounts().GetBalance(provider, coinLiquidity.ID()).String()
+
+	reserve0, reserve1 := swapper.Reserves()
+	amount0 := new(big.Int).Quo(new(big.Int).Mul(position.Liquidity, reserve0), coinLiquidity.Volume())
+	amount1 := new(big.Int).Quo(new(big.Int).Mul(position.Liquidity, reserve1), coinLiquidity.Volume())
+	result.Amount0 = amount0.String()
+	result.Amount1 = amount1.String()
+
+	if reserve0.Sign() != 1 {
+		return result
+	}
+	// value in coin1 is amount0 * reserve1 / reserve0 + amount1
+	value := func(a0, a1 *big.Int) *big.Int {
+		v := new(big.Int).Quo(new(big.Int).Mul(a0, reserve1), reserve0)
+		return v.Add(v, a1)
+	}
+	holdValue := value(position.CostBasis0, position.CostBasis1)
+	positionValue := value(amount0, amount1)
+	feesValue := value(position.Earned0, position.Earned1)
+	result.HoldValue = holdValue.String()
+	result.PositionValue = positionValue.String()
+	result.FeesValue = feesValue.String()
+
+	if holdValue.Sign() == 1 {
+		loss := new(big.Float).SetInt(new(big.Int).Sub(positionValue, feesValue))
+		loss.Quo(loss, new(big.Float).SetInt(holdValue))
+		loss.Sub(loss, big.NewFloat(1))
+		result.ImpermanentLoss = loss.Mul(loss, big.NewFloat(100)).Text('f', 4)
+	}
+
+	return result
+}
+
+// poolByID returns the pool of the pair with given ID among pools of all fee tiers
+func poolByID(cState *state.CheckState, coin0, coin1 types.CoinID, id uint32) swap.EditableChecker {
+	for _, fee := range cState.Swap().SwapPoolFees(coin0, coin1) {
+		swapper := cState.Swap().GetSwapperWithFee(coin0, coin1, fee)
+		if swapper.GetID() == id {
+			return swapper
+		}
+	}
+	return nil
+}
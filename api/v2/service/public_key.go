@@ -0,0 +1,68 @@
+package service
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/MinterTeam/minter-go-node/crypto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// PublicKeyResponse is a secp256k1 public key of the address recovered from its transaction
+type PublicKeyResponse struct {
+	Address     string `json:"address"`
+	PublicKey   string `json:"public_key"`
+	Transaction string `json:"transaction"`
+}
+
+// PublicKey recovers the public key of the address from the signature of its latest single signature transaction,
+// or the authorised key from the latest account signature transaction if the key of the address is rotated.
+// The key can be used to encrypt payloads of transactions to the address.
+func (s *Service) PublicKey(ctx context.Context, address string) (*PublicKeyResponse, error) {
+	decoded, err := decodeAddress(address)
+	if err != nil {
+		return nil, err
+	}
+
+	cState, err := s.blockchain.GetStateForHeight(0)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	signer := decoded
+	if authorised := cState.Accounts().GetAccount(decoded).GetSigner(); authorised != nil {
+		signer = *authorised
+	}
+
+	page, perPage := 1, 100
+	query := fmt.Sprintf("tx.from='%s'", hex.EncodeToString(decoded[:]))
+	rpcResult, err := s.client.TxSearch(ctx, query, false, &page, &perPage, "desc")
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	for _, tx := range rpcResult.Txs {
+		if timeoutStatus := s.checkTimeout(ctx); timeoutStatus != nil {
+			return nil, timeoutStatus.Err()
+		}
+
+		decodedTx, err := s.executor.DecodeFromBytes(tx.Tx)
+		if err != nil {
+			continue
+		}
+		pub, err := decodedTx.PublicKey()
+		if err != nil || crypto.PubkeyToAddress(*pub) != signer {
+			continue
+		}
+
+		return &PublicKeyResponse{
+			Address:     decoded.String(),
+			PublicKey:   hex.EncodeToString(crypto.FromECDSAPub(pub)),
+			Transaction: "Mt" + strings.ToLower(hex.EncodeToString(tx.Tx.Hash())),
+		}, nil
+	}
+
+	return nil, status.Error(codes.NotFound, "Public key not found, the address has no transactions signed by its current key")
+}
@@ -0,0 +1,191 @@
+package service
+
+import (
+	"context"
+	"encoding/hex"
+	"math/big"
+	"sort"
+	"strings"
+
+	eventsdb "github.com/MinterTeam/minter-go-node/coreV2/events"
+	"github.com/MinterTeam/minter-go-node/coreV2/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const secondsPerYear = 365 * 24 * 60 * 60
+
+// RewardsResponse is a sum of rewards earned by an address over a height range
+type RewardsResponse struct {
+	Address       string              `json:"address"`
+	FromHeight    uint64              `json:"from_height,string"`
+	ToHeight      uint64              `json:"to_height,string"`
+	Total         string              `json:"total"`
+	Roles         map[string]string   `json:"roles"`
+	StakeBipValue string              `json:"stake_bip_value"`
+	APR           string              `json:"apr"`
+	Candidates    []*CandidateRewards `json:"candidates"`
+}
+
+// CandidateRewards is a sum of rewards earned by an address from a candidate
+type CandidateRewards struct {
+	PublicKey     string            `json:"public_key"`
+	Total         string            `json:"total"`
+	Roles         map[string]string `json:"roles"`
+	StakeBipValue string            `json:"stake_bip_value"`
+	APR           string            `json:"apr"`
+}
+
+// Rewards returns rewards earned by an address over a height range grouped by candidates and roles.
+// APR is calculated from delegator rewards and BIP value of stakes of the address at the end of the range.
+func (s *Service) Rewards(ctx context.Context, address string, publicKey string, from, to uint64) (*RewardsResponse, error) {
+	if !strings.HasPrefix(strings.Title(address), "Mx") {
+		return nil, status.Error(codes.InvalidArgument, "invalid address")
+	}
+	decodeAddress, err := hex.DecodeString(address[2:])
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid address")
+	}
+	owner := types.BytesToAddress(decodeAddress)
+
+	var pubKey *types.Pubkey
+	if publicKey != "" {
+		if !strings.HasPrefix(publicKey, "Mp") {
+			return nil, status.Error(codes.InvalidArgument, "invalid public_key")
+		}
+		decodePubKey, err := hex.DecodeString(publicKey[2:])
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid public_key")
+		}
+		p := types.BytesToPubkey(decodePubKey)
+		pubKey = &p
+	}
+
+	if to == 0 {
+		to = s.blockchain.Height()
+	}
+	if from == 0 || from >= to {
+		return nil, status.Error(codes.InvalidArgument, "from height should be greater than zero and less than to height")
+	}
+	if maxBlocks := s.minterCfg.RewardsMaxBlocks; maxBlocks != 0 && to-from > maxBlocks {
+		return nil, status.Errorf(codes.InvalidArgument, "difference between heights should be at most %d blocks", maxBlocks)
+	}
+	if height := s.blockchain.Height(); to > height {
+		return nil, status.Errorf(codes.InvalidArgument, "to height should be at most current height %d", height)
+	}
+
+	fromHeight, toHeight := int64(from), int64(to)
+	fromBlock, err := s.client.Block(ctx, &fromHeight)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	toBlock, err := s.client.Block(ctx, &toHeight)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	seconds := toBlock.Block.Time.Sub(fromBlock.Block.Time).Seconds()
+
+	cState, err := s.blockchain.GetStateForHeight(to)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	period := s.blockchain.PayRewardsPeriod()
+	rewards := map[types.Pubkey]eventsdb.RewardsByRole{}
+	for height := (from + period - 1) / period * period; height <= to; height += period {
+		if timeoutStatus := s.checkTimeout(ctx); timeoutStatus != nil {
+			return nil, timeoutStatus.Err()
+		}
+
+		eventsdb.SumRewards(rewards, s.blockchain.GetEventsDB().LoadEvents(uint32(height)), owner, pubKey)
+	}
+
+	cState.Candidates().LoadCandidates()
+
+	if pubKey != nil {
+		if _, ok := rewards[*pubKey]; !ok {
+			rewards[*pubKey] = eventsdb.RewardsByRole{}
+		}
+	}
+
+	pubKeys := make([]types.Pubkey, 0, len(rewards))
+	for key := range rewards {
+		pubKeys = append(pubKeys, key)
+	}
+	sort.Slice(pubKeys, func(i, j int) bool {
+		return pubKeys[i].String() < pubKeys[j].String()
+	})
+
+	total := eventsdb.RewardsByRole{}
+	totalStake := big.NewInt(0)
+	result := &RewardsResponse{
+		Address:    owner.String(),
+		FromHeight: from,
+		ToHeight:   to,
+		Candidates: make([]*CandidateRewards, 0, len(pubKeys)),
+	}
+	for _, key := range pubKeys {
+		if timeoutStatus := s.checkTimeout(ctx); timeoutStatus != nil {
+			return nil, timeoutStatus.Err()
+		}
+
+		byRole := rewards[key]
+		for role, value := range byRole {
+			if total[role] == nil {
+				total[role] = big.NewInt(0)
+			}
+			total[role].Add(total[role], value)
+		}
+
+		stake := big.NewInt(0)
+		if cState.Candidates().Exists(key) {
+			cState.Candidates().LoadStakesOfCandidate(key)
+			for _, st := range cState.Candidates().GetStakes(key) {
+				if st.Owner == owner {
+					stake.Add(stake, st.BipValue)
+				}
+			}
+		}
+		totalStake.Add(totalStake, stake)
+
+		result.Candidates = append(result.Candidates, &CandidateRewards{
+			PublicKey:     key.String(),
+			Total:         byRole.Total().String(),
+			Roles:         rolesToMap(byRole),
+			StakeBipValue: stake.String(),
+			APR:           calculateAPR(byRole[eventsdb.RoleDelegator], stake, seconds),
+		})
+	}
+
+	result.Total = total.Total().String()
+	result.Roles = rolesToMap(total)
+	result.StakeBipValue = totalStake.String()
+	result.APR = calculateAPR(total[eventsdb.RoleDelegator], totalStake, seconds)
+
+	return result, nil
+}
+
+func rolesToMap(byRole eventsdb.RewardsByRole) map[string]string {
+	result := map[string]string{}
+	for _, role := range []eventsdb.Role{eventsdb.RoleValidator, eventsdb.RoleDelegator, eventsdb.RoleDAO, eventsdb.RoleDevelopers} {
+		value := byRole[role]
+		if value == nil {
+			value = big.NewInt(0)
+		}
+		result[role.String()] = value.String()
+	}
+	return result
+}
+
+// calculateAPR returns annual percentage rate of rewards for a stake over given duration in seconds
+func calculateAPR(reward *big.Int, stake *big.Int, seconds float64) string {
+	if reward == nil || stake.Sign() == 0 || seconds <= 0 {
+		return "0"
+	}
+
+	apr := new(big.Float).SetInt(reward)
+	apr.Quo(apr, new(big.Float).SetInt(stake))
+	apr.Mul(apr, big.NewFloat(secondsPerYear/seconds*100))
+
+	return apr.Text('f', 2)
+}
@@ -0,0 +1,21 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MinterTeam/minter-go-node/config"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestService_RewardsMaxBlocks(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.RewardsMaxBlocks = 100
+	s := &Service{minterCfg: cfg}
+
+	_, err := s.Rewards(context.Background(), "Mx0000000000000000000000000000000000000000", "", 1, 102)
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument, got %v", err)
+	}
+}
@@ -0,0 +1,52 @@
+package service
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/MinterTeam/minter-go-node/coreV2/code"
+	"github.com/MinterTeam/minter-go-node/coreV2/transaction"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ScheduleResponse is a schedule with the sends which are not executed yet
+type ScheduleResponse struct {
+	ID         uint64 `json:"id,string"`
+	Owner      string `json:"owner"`
+	Recipient  string `json:"recipient"`
+	Coin       *Coin  `json:"coin"`
+	Value      string `json:"value"`
+	NextHeight uint64 `json:"next_height,string"`
+	Interval   uint64 `json:"interval,string"`
+	Count      uint64 `json:"count,string"`
+	Remaining  string `json:"remaining"`
+}
+
+// Schedule returns the schedule of sends by its ID
+func (s *Service) Schedule(ctx context.Context, id uint64, height uint64) (*ScheduleResponse, error) {
+	cState, err := s.blockchain.GetStateForHeight(height)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	schedule := cState.Schedules().GetSchedule(uint32(id))
+	if schedule == nil {
+		return nil, s.createError(status.New(codes.NotFound, "Schedule not found"), transaction.EncodeError(code.NewScheduleNotExists(strconv.FormatUint(id, 10))))
+	}
+
+	return &ScheduleResponse{
+		ID:        id,
+		Owner:     schedule.Owner.String(),
+		Recipient: schedule.Recipient.String(),
+		Coin: &Coin{
+			ID:     uint64(schedule.Coin),
+			Symbol: cState.Coins().GetCoin(schedule.Coin).GetFullSymbol(),
+		},
+		Value:      schedule.Value.String(),
+		NextHeight: schedule.GetNextHeight(),
+		Interval:   schedule.Interval,
+		Count:      uint64(schedule.GetCount()),
+		Remaining:  schedule.GetRemaining().String(),
+	}, nil
+}
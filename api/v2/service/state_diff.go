@@ -0,0 +1,43 @@
+package service
+
+import (
+	"context"
+
+	"github.com/MinterTeam/minter-go-node/coreV2/statediff"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// StateDiff returns changes of balances, coins, stakes, pools and candidates between two heights
+func (s *Service) StateDiff(ctx context.Context, from, to uint64) (*statediff.Diff, error) {
+	if !s.minterCfg.StateDiffAPI {
+		return nil, status.Error(codes.Unavailable, "state diff is disabled, set state_diff_api in config")
+	}
+
+	if from == 0 || from >= to {
+		return nil, status.Error(codes.InvalidArgument, "from height should be greater than zero and less than to height")
+	}
+	if maxBlocks := s.minterCfg.StateDiffMaxBlocks; maxBlocks != 0 && to-from > maxBlocks {
+		return nil, status.Errorf(codes.InvalidArgument, "difference between heights should be at most %d blocks", maxBlocks)
+	}
+
+	fromState, err := s.blockchain.GetStateForHeight(from)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	toState, err := s.blockchain.GetStateForHeight(to)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	diff, err := statediff.Compare(ctx, from, fromState, to, toState, s.minterCfg.StateDiffMaxChanges)
+	if err == statediff.ErrTooManyChanges {
+		return nil, status.Error(codes.ResourceExhausted, err.Error())
+	}
+	if err != nil {
+		return nil, status.FromContextError(err).Err()
+	}
+
+	return diff, nil
+}
@@ -0,0 +1,167 @@
+package service
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/MinterTeam/minter-go-node/coreV2/state/swap"
+	"github.com/MinterTeam/minter-go-node/coreV2/transaction"
+	"github.com/MinterTeam/minter-go-node/coreV2/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SwapPoolTiersResponse is a list of pools of a pair with different fee tiers
+type SwapPoolTiersResponse struct {
+	Pools []*SwapPoolTier `json:"pools"`
+}
+
+// SwapPoolTier is a pool of a pair with its fee tier in basis points
+type SwapPoolTier struct {
+	ID        uint32 `json:"id,string"`
+	Fee       uint32 `json:"fee,string"`
+	Amount0   string `json:"amount0"`
+	Amount1   string `json:"amount1"`
+	Liquidity string `json:"liquidity"`
+}
+
+// SwapPoolTiers returns pools of all fee tiers of the pair
+func (s *Service) SwapPoolTiers(_ context.Context, coin0, coin1 uint64, height uint64) (*SwapPoolTiersResponse, error) {
+	if coin0 == coin1 {
+		return nil, status.Error(codes.InvalidArgument, "equal coins id")
+	}
+
+	cState, err := s.blockchain.GetStateForHeight(height)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	result := &SwapPoolTiersResponse{Pools: []*SwapPoolTier{}}
+	for _, fee := range cState.Swap().SwapPoolFees(types.CoinID(coin0), types.CoinID(coin1)) {
+		swapper := cState.Swap().GetSwapperWithFee(types.CoinID(coin0), types.CoinID(coin1), fee)
+		reserve0, reserve1 := swapper.Reserves()
+		result.Pools = append(result.Pools, &SwapPoolTier{
+			ID:        swapper.GetID(),
+			Fee:       fee,
+			Amount0:   reserve0.String(),
+			Amount1:   reserve1.String(),
+			Liquidity: cState.Coins().GetCoinBySymbol(transaction.LiquidityCoinSymbol(swapper.GetID()), 0).Volume().String(),
+		})
+	}
+
+	if len(result.Pools) == 0 {
+		return nil, status.Error(codes.NotFound, "pair not found")
+	}
+
+	return result, nil
+}
+
+// EstimateSwapResponse is an estimate of a swap by the route of pools
+type EstimateSwapResponse struct {
+	ValueToSell string              `json:"value_to_sell"`
+	ValueToBuy  string              `json:"value_to_buy"`
+	Steps       []*EstimateSwapStep `json:"steps"`
+}
+
+// EstimateSwapStep is a swap in one pool of the route
+type EstimateSwapStep struct {
+	PoolID   uint32 `json:"pool_id,string"`
+	Fee      uint32 `json:"fee,string"`
+	CoinIn   uint64 `json:"coin_in,string"`
+	ValueIn  string `json:"value_in"`
+	CoinOut  uint64 `json:"coin_out,string"`
+	ValueOut string `json:"value_out"`
+}
+
+// EstimateSwap returns an estimate of selling valueToSell or buying valueToBuy by the route of coins without tx commission.
+// Fees are fee tiers of pools for each step of the route, zero fee selects the pool with the best price
+// and pools of the default tier are used if fees are empty.
+func (s *Service) EstimateSwap(ctx context.Context, coins []uint64, fees []uint32, valueToSell, valueToBuy string, height uint64) (*EstimateSwapResponse, error) {
+	if len(coins) < 2 {
+		return nil, status.Error(codes.InvalidArgument, "route should contain at least two coins")
+	}
+	if len(coins) > 5 {
+		return nil, status.Error(codes.OutOfRange, "maximum allowed length of the exchange chain is 5")
+	}
+	if len(fees) != 0 && len(fees) != len(coins)-1 {
+		return nil, status.Error(codes.InvalidArgument, "count of fees should be equal to count of steps in the route")
+	}
+	for _, fee := range fees {
+		if fee != 0 && !swap.IsFeeTier(fee) {
+			return nil, status.Errorf(codes.InvalidArgument, "fee %d is not one of fee tiers %v", fee, swap.FeeTiers)
+		}
+	}
+	if (valueToSell == "") == (valueToBuy == "") {
+		return nil, status.Error(codes.InvalidArgument, "either value_to_sell or value_to_buy should be specified")
+	}
+
+	isBuy := valueToBuy != ""
+	value, ok := big.NewInt(0).SetString(valueToSell+valueToBuy, 10)
+	if !ok || value.Sign() != 1 {
+		return nil, status.Error(codes.InvalidArgument, "invalid value")
+	}
+
+	cState, err := s.blockchain.GetStateForHeight(height)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	steps := make([]*EstimateSwapStep, len(coins)-1)
+	for n := range steps {
+		i := n
+		if isBuy {
+			i = len(steps) - 1 - n
+		}
+
+		if timeoutStatus := s.checkTimeout(ctx); timeoutStatus != nil {
+			return nil, timeoutStatus.Err()
+		}
+
+		coinIn, coinOut := types.CoinID(coins[i]), types.CoinID(coins[i+1])
+		if coinIn == coinOut {
+			return nil, status.Error(codes.InvalidArgument, "\"From\" coin equals to \"to\" coin")
+		}
+
+		fee := swap.DefaultFee
+		if len(fees) != 0 {
+			fee = fees[i]
+		}
+
+		var swapper swap.EditableChecker
+		switch {
+		case fee != 0:
+			swapper = cState.Swap().GetSwapperWithFee(coinIn, coinOut, fee)
+		case isBuy:
+			swapper = cState.Swap().GetBestSwapperForBuy(coinIn, coinOut, value)
+		default:
+			swapper = cState.Swap().GetBestSwapperForSell(coinIn, coinOut, value)
+		}
+		if !swapper.Exists() {
+			return nil, status.Errorf(codes.NotFound, "swap pool %d-%d with fee %d not found", coinIn, coinOut, fee)
+		}
+
+		step := &EstimateSwapStep{PoolID: swapper.GetID(), Fee: swapper.Fee(), CoinIn: uint64(coinIn), CoinOut: uint64(coinOut)}
+		if isBuy {
+			step.ValueOut = value.String()
+			value = swapper.CalculateSellForBuy(value)
+			if value == nil {
+				return nil, status.Errorf(codes.FailedPrecondition, "not enough liquidity in swap pool %d", swapper.GetID())
+			}
+			step.ValueIn = value.String()
+		} else {
+			step.ValueIn = value.String()
+			value = swapper.CalculateBuyForSell(value)
+			if value == nil {
+				return nil, status.Errorf(codes.FailedPrecondition, "not enough liquidity in swap pool %d", swapper.GetID())
+			}
+			step.ValueOut = value.String()
+		}
+		steps[i] = step
+	}
+
+	return &EstimateSwapResponse{
+		ValueToSell: steps[0].ValueIn,
+		ValueToBuy:  steps[len(steps)-1].ValueOut,
+		Steps:       steps,
+	}, nil
+}
@@ -98,6 +98,7 @@ func Run(srv *service.Service, addrGRPC, addrAPI string, logger log.Logger) erro
 	mux := http.NewServeMux()
 	openapi := "/v2/openapi-ui/"
 	_ = serveOpenAPI(openapi, mux)
+	registerJSONHandlers(mux, srv)
 	mux.HandleFunc("/v2/", func(writer http.ResponseWriter, request *http.Request) {
 		if request.URL.Path == "/v2/" {
 			http.Redirect(writer, request, openapi, 302)
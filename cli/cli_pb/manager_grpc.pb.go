@@ -24,6 +24,12 @@ type ManagerServiceClient interface {
 	PruneBlocks(ctx context.Context, in *PruneBlocksRequest, opts ...grpc.CallOption) (ManagerService_PruneBlocksClient, error)
 	DealPeer(ctx context.Context, in *DealPeerRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	Dashboard(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (ManagerService_DashboardClient, error)
+	SetLogLevel(ctx context.Context, in *SetLogLevelRequest, opts ...grpc.CallOption) (*LogLevelResponse, error)
+	GetLogLevel(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*LogLevelResponse, error)
+	BanPeer(ctx context.Context, in *BanPeerRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	UnbanPeer(ctx context.Context, in *UnbanPeerRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	BannedPeers(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*BannedPeersResponse, error)
+	MarkPeer(ctx context.Context, in *MarkPeerRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type managerServiceClient struct {
@@ -134,6 +140,60 @@ func (x *managerServiceDashboardClient) Recv() (*DashboardResponse, error) {
 	return m, nil
 }
 
+func (c *managerServiceClient) SetLogLevel(ctx context.Context, in *SetLogLevelRequest, opts ...grpc.CallOption) (*LogLevelResponse, error) {
+	out := new(LogLevelResponse)
+	err := c.cc.Invoke(ctx, "/cli_pb.ManagerService/SetLogLevel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerServiceClient) GetLogLevel(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*LogLevelResponse, error) {
+	out := new(LogLevelResponse)
+	err := c.cc.Invoke(ctx, "/cli_pb.ManagerService/GetLogLevel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerServiceClient) BanPeer(ctx context.Context, in *BanPeerRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/cli_pb.ManagerService/BanPeer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerServiceClient) UnbanPeer(ctx context.Context, in *UnbanPeerRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/cli_pb.ManagerService/UnbanPeer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerServiceClient) BannedPeers(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*BannedPeersResponse, error) {
+	out := new(BannedPeersResponse)
+	err := c.cc.Invoke(ctx, "/cli_pb.ManagerService/BannedPeers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerServiceClient) MarkPeer(ctx context.Context, in *MarkPeerRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/cli_pb.ManagerService/MarkPeer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ManagerServiceServer is the server API for ManagerService service.
 // All implementations must embed UnimplementedManagerServiceServer
 // for forward compatibility
@@ -144,6 +204,12 @@ type ManagerServiceServer interface {
 	PruneBlocks(*PruneBlocksRequest, ManagerService_PruneBlocksServer) error
 	DealPeer(context.Context, *DealPeerRequest) (*emptypb.Empty, error)
 	Dashboard(*emptypb.Empty, ManagerService_DashboardServer) error
+	SetLogLevel(context.Context, *SetLogLevelRequest) (*LogLevelResponse, error)
+	GetLogLevel(context.Context, *emptypb.Empty) (*LogLevelResponse, error)
+	BanPeer(context.Context, *BanPeerRequest) (*emptypb.Empty, error)
+	UnbanPeer(context.Context, *UnbanPeerRequest) (*emptypb.Empty, error)
+	BannedPeers(context.Context, *emptypb.Empty) (*BannedPeersResponse, error)
+	MarkPeer(context.Context, *MarkPeerRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedManagerServiceServer()
 }
 
@@ -169,6 +235,24 @@ func (UnimplementedManagerServiceServer) DealPeer(context.Context, *DealPeerRequ
 func (UnimplementedManagerServiceServer) Dashboard(*emptypb.Empty, ManagerService_DashboardServer) error {
 	return status.Errorf(codes.Unimplemented, "method Dashboard not implemented")
 }
+func (UnimplementedManagerServiceServer) SetLogLevel(context.Context, *SetLogLevelRequest) (*LogLevelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLogLevel not implemented")
+}
+func (UnimplementedManagerServiceServer) GetLogLevel(context.Context, *emptypb.Empty) (*LogLevelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLogLevel not implemented")
+}
+func (UnimplementedManagerServiceServer) BanPeer(context.Context, *BanPeerRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BanPeer not implemented")
+}
+func (UnimplementedManagerServiceServer) UnbanPeer(context.Context, *UnbanPeerRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbanPeer not implemented")
+}
+func (UnimplementedManagerServiceServer) BannedPeers(context.Context, *emptypb.Empty) (*BannedPeersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BannedPeers not implemented")
+}
+func (UnimplementedManagerServiceServer) MarkPeer(context.Context, *MarkPeerRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkPeer not implemented")
+}
 func (UnimplementedManagerServiceServer) mustEmbedUnimplementedManagerServiceServer() {}
 
 // UnsafeManagerServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -296,6 +380,114 @@ func (x *managerServiceDashboardServer) Send(m *DashboardResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _ManagerService_SetLogLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLogLevelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServiceServer).SetLogLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cli_pb.ManagerService/SetLogLevel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServiceServer).SetLogLevel(ctx, req.(*SetLogLevelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagerService_GetLogLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServiceServer).GetLogLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cli_pb.ManagerService/GetLogLevel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServiceServer).GetLogLevel(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagerService_BanPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BanPeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServiceServer).BanPeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cli_pb.ManagerService/BanPeer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServiceServer).BanPeer(ctx, req.(*BanPeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagerService_UnbanPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnbanPeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServiceServer).UnbanPeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cli_pb.ManagerService/UnbanPeer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServiceServer).UnbanPeer(ctx, req.(*UnbanPeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagerService_BannedPeers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServiceServer).BannedPeers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cli_pb.ManagerService/BannedPeers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServiceServer).BannedPeers(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagerService_MarkPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkPeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServiceServer).MarkPeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cli_pb.ManagerService/MarkPeer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServiceServer).MarkPeer(ctx, req.(*MarkPeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _ManagerService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cli_pb.ManagerService",
 	HandlerType: (*ManagerServiceServer)(nil),
@@ -316,6 +508,30 @@ var _ManagerService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DealPeer",
 			Handler:    _ManagerService_DealPeer_Handler,
 		},
+		{
+			MethodName: "SetLogLevel",
+			Handler:    _ManagerService_SetLogLevel_Handler,
+		},
+		{
+			MethodName: "GetLogLevel",
+			Handler:    _ManagerService_GetLogLevel_Handler,
+		},
+		{
+			MethodName: "BanPeer",
+			Handler:    _ManagerService_BanPeer_Handler,
+		},
+		{
+			MethodName: "UnbanPeer",
+			Handler:    _ManagerService_UnbanPeer_Handler,
+		},
+		{
+			MethodName: "BannedPeers",
+			Handler:    _ManagerService_BannedPeers_Handler,
+		},
+		{
+			MethodName: "MarkPeer",
+			Handler:    _ManagerService_MarkPeer_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -146,6 +146,47 @@ func NewCLI(socketPath string) (interface {
 			},
 			Action: dealPeerCMD(client),
 		},
+		{
+			Name:    "ban_peer",
+			Aliases: []string{"bp"},
+			Usage:   "disconnect and reject peer by node ID or IP, requires filter_peers = true in config",
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "peer", Aliases: []string{"p"}, Required: true, Usage: "node ID or IP"},
+				&cli.DurationFlag{Name: "duration", Aliases: []string{"d"}, Required: false, Usage: "ban duration, e.g. 24h, permanent by default"},
+				&cli.StringFlag{Name: "reason", Aliases: []string{"r"}, Required: false},
+			},
+			Action: banPeerCMD(client),
+		},
+		{
+			Name:    "unban_peer",
+			Aliases: []string{"up"},
+			Usage:   "remove peer from the ban list",
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "peer", Aliases: []string{"p"}, Required: true, Usage: "node ID or IP"},
+			},
+			Action: unbanPeerCMD(client),
+		},
+		{
+			Name:    "banned_peers",
+			Aliases: []string{"bps"},
+			Usage:   "display banned peers",
+			Flags: []cli.Flag{
+				jsonFlag,
+			},
+			Action: bannedPeersCMD(client),
+		},
+		{
+			Name:    "mark_peer",
+			Aliases: []string{"mp"},
+			Usage:   "mark peer as persistent, unconditional or private",
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "address", Aliases: []string{"a"}, Required: true, Usage: "id@ip:port, node ID is enough without --persistent"},
+				&cli.BoolFlag{Name: "persistent", Aliases: []string{"p"}, Required: false},
+				&cli.BoolFlag{Name: "unconditional", Aliases: []string{"unc"}, Required: false},
+				&cli.BoolFlag{Name: "private", Aliases: []string{"pr"}, Required: false},
+			},
+			Action: markPeerCMD(client),
+		},
 		{
 			Name:    "available_versions",
 			Aliases: []string{"av"},
@@ -184,6 +225,25 @@ func NewCLI(socketPath string) (interface {
 			},
 			Action: netInfoCMD(client),
 		},
+		{
+			Name:    "set_log_level",
+			Aliases: []string{"sll"},
+			Usage:   "change log level without restart",
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "level", Aliases: []string{"l"}, Required: true, Usage: "debug, info, error, none or list of module:level pairs"},
+				&cli.StringFlag{Name: "module", Aliases: []string{"m"}, Required: false, Usage: "change level only for given module, e.g. consensus"},
+			},
+			Action: setLogLevelCMD(client),
+		},
+		{
+			Name:    "log_level",
+			Aliases: []string{"ll"},
+			Usage:   "display current log level",
+			Flags: []cli.Flag{
+				jsonFlag,
+			},
+			Action: getLogLevelCMD(client),
+		},
 		{
 			Name:    "dashboard",
 			Aliases: []string{"db"},
@@ -482,3 +542,108 @@ func dealPeerCMD(client pb.ManagerServiceClient) func(c *cli.Context) error {
 		return nil
 	}
 }
+
+func setLogLevelCMD(client pb.ManagerServiceClient) func(c *cli.Context) error {
+	return func(c *cli.Context) error {
+		response, err := client.SetLogLevel(c.Context, &pb.SetLogLevelRequest{
+			Level:  c.String("level"),
+			Module: c.String("module"),
+		})
+		if err != nil {
+			return err
+		}
+		fmt.Println("OK", response.Level)
+		return nil
+	}
+}
+
+func getLogLevelCMD(client pb.ManagerServiceClient) func(c *cli.Context) error {
+	return func(c *cli.Context) error {
+		response, err := client.GetLogLevel(c.Context, &empty.Empty{})
+		if err != nil {
+			return err
+		}
+		if c.Bool("json") {
+			bb, err := protojson.Marshal(response)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(bb))
+			return nil
+		}
+		fmt.Println(response.Level)
+		return nil
+	}
+}
+
+func banPeerCMD(client pb.ManagerServiceClient) func(c *cli.Context) error {
+	return func(c *cli.Context) error {
+		_, err := client.BanPeer(c.Context, &pb.BanPeerRequest{
+			Peer:     c.String("peer"),
+			Duration: int64(c.Duration("duration").Seconds()),
+			Reason:   c.String("reason"),
+		})
+		if status.Code(err) == codes.FailedPrecondition {
+			return fmt.Errorf("WARNING: peer is not banned: %s", status.Convert(err).Message())
+		}
+		if err != nil {
+			return err
+		}
+		fmt.Println("OK")
+		return nil
+	}
+}
+
+func unbanPeerCMD(client pb.ManagerServiceClient) func(c *cli.Context) error {
+	return func(c *cli.Context) error {
+		_, err := client.UnbanPeer(c.Context, &pb.UnbanPeerRequest{
+			Peer: c.String("peer"),
+		})
+		if err != nil {
+			return err
+		}
+		fmt.Println("OK")
+		return nil
+	}
+}
+
+func bannedPeersCMD(client pb.ManagerServiceClient) func(c *cli.Context) error {
+	return func(c *cli.Context) error {
+		response, err := client.BannedPeers(c.Context, &empty.Empty{})
+		if err != nil {
+			return err
+		}
+		if c.Bool("json") {
+			bb, err := protojson.Marshal(response)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(bb))
+			return nil
+		}
+		for _, ban := range response.Bans {
+			until := ban.Until
+			if until == "" {
+				until = "permanent"
+			}
+			fmt.Printf("%s\t%s\t%s\n", ban.Peer, until, ban.Reason)
+		}
+		return nil
+	}
+}
+
+func markPeerCMD(client pb.ManagerServiceClient) func(c *cli.Context) error {
+	return func(c *cli.Context) error {
+		_, err := client.MarkPeer(c.Context, &pb.MarkPeerRequest{
+			Address:       c.String("address"),
+			Persistent:    c.Bool("persistent"),
+			Unconditional: c.Bool("unconditional"),
+			Private:       c.Bool("private"),
+		})
+		if err != nil {
+			return err
+		}
+		fmt.Println("OK")
+		return nil
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"github.com/MinterTeam/minter-go-node/config"
 	"github.com/MinterTeam/minter-go-node/coreV2/minter"
+	"github.com/MinterTeam/minter-go-node/log"
 	"github.com/tendermint/tendermint/node"
 	rpc "github.com/tendermint/tendermint/rpc/client/local"
 	"io/ioutil"
@@ -18,12 +19,13 @@ func TestStartCLIServer(t *testing.T) {
 		tmRPC      *rpc.Local
 		tmNode     *node.Node
 		cfg        *config.Config
+		logger     *log.Logger
 	)
 	ctx, cancel := context.WithCancel(context.Background())
 	socketPath, _ := filepath.Abs(filepath.Join(".", "file.sock"))
 	_ = ioutil.WriteFile(socketPath, []byte("address already in use"), 0644)
 	go func() {
-		err := StartCLIServer(socketPath, NewManager(blockchain, tmRPC, tmNode, cfg), ctx)
+		err := StartCLIServer(socketPath, NewManager(blockchain, tmRPC, tmNode, cfg, logger), ctx)
 		if err != nil {
 			t.Log(err)
 		}
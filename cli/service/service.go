@@ -2,12 +2,14 @@ package service
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
 	pb "github.com/MinterTeam/minter-go-node/cli/cli_pb"
 	"github.com/MinterTeam/minter-go-node/config"
 	"github.com/MinterTeam/minter-go-node/coreV2/minter"
 	"github.com/MinterTeam/minter-go-node/coreV2/state/candidates"
 	"github.com/MinterTeam/minter-go-node/coreV2/types"
+	"github.com/MinterTeam/minter-go-node/log"
 	"github.com/MinterTeam/minter-go-node/version"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/empty"
@@ -21,7 +23,9 @@ import (
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 	"math/big"
+	"net"
 	"runtime"
+	"strings"
 	"time"
 )
 
@@ -30,12 +34,13 @@ type managerServer struct {
 	tmRPC      *rpc.Local
 	tmNode     *tmNode.Node
 	cfg        *config.Config
+	logger     *log.Logger
 	pb.UnimplementedManagerServiceServer
 }
 
 // NewManager return backend for cli
-func NewManager(blockchain *minter.Blockchain, tmRPC *rpc.Local, tmNode *tmNode.Node, cfg *config.Config) pb.ManagerServiceServer {
-	return &managerServer{blockchain: blockchain, tmRPC: tmRPC, tmNode: tmNode, cfg: cfg}
+func NewManager(blockchain *minter.Blockchain, tmRPC *rpc.Local, tmNode *tmNode.Node, cfg *config.Config, logger *log.Logger) pb.ManagerServiceServer {
+	return &managerServer{blockchain: blockchain, tmRPC: tmRPC, tmNode: tmNode, cfg: cfg, logger: logger}
 }
 
 func (m *managerServer) Dashboard(_ *empty.Empty, stream pb.ManagerService_DashboardServer) error {
@@ -306,6 +311,113 @@ func (m *managerServer) DealPeer(_ context.Context, req *pb.DealPeerRequest) (*e
 	return res, nil
 }
 
+func (m *managerServer) SetLogLevel(_ context.Context, req *pb.SetLogLevelRequest) (*pb.LogLevelResponse, error) {
+	var err error
+	if req.Module == "" {
+		err = m.logger.SetLevel(req.Level)
+	} else {
+		err = m.logger.SetModuleLevel(req.Module, req.Level)
+	}
+	if err != nil {
+		return new(pb.LogLevelResponse), status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &pb.LogLevelResponse{Level: m.logger.Level()}, nil
+}
+
+func (m *managerServer) GetLogLevel(context.Context, *empty.Empty) (*pb.LogLevelResponse, error) {
+	return &pb.LogLevelResponse{Level: m.logger.Level()}, nil
+}
+
+func (m *managerServer) BanPeer(_ context.Context, req *pb.BanPeerRequest) (*empty.Empty, error) {
+	res := new(empty.Empty)
+	if !isPeerID(req.Peer) && net.ParseIP(req.Peer) == nil {
+		return res, status.Error(codes.InvalidArgument, "peer should be node ID or IP address")
+	}
+	if req.Duration < 0 {
+		return res, status.Error(codes.InvalidArgument, "duration should not be negative")
+	}
+	if !m.cfg.FilterPeers {
+		return res, status.Error(codes.FailedPrecondition, "banned peers can't be rejected while peer filtering is disabled, set filter_peers = true in config")
+	}
+
+	_, err := m.blockchain.BanList().Ban(req.Peer, time.Duration(req.Duration)*time.Second, req.Reason)
+	if err != nil {
+		return res, status.Error(codes.Internal, err.Error())
+	}
+
+	// disconnect already connected peers, new connections are rejected by the peer filters
+	sw := m.tmNode.Switch()
+	for _, peer := range sw.Peers().List() {
+		if string(peer.ID()) == req.Peer || peer.RemoteIP().String() == req.Peer {
+			sw.StopPeerForError(peer, "banned")
+		}
+	}
+
+	return res, nil
+}
+
+func (m *managerServer) UnbanPeer(_ context.Context, req *pb.UnbanPeerRequest) (*empty.Empty, error) {
+	res := new(empty.Empty)
+	ok, err := m.blockchain.BanList().Unban(req.Peer)
+	if err != nil {
+		return res, status.Error(codes.Internal, err.Error())
+	}
+	if !ok {
+		return res, status.Error(codes.NotFound, "peer is not banned")
+	}
+	return res, nil
+}
+
+func (m *managerServer) BannedPeers(context.Context, *empty.Empty) (*pb.BannedPeersResponse, error) {
+	bans := m.blockchain.BanList().List()
+	response := &pb.BannedPeersResponse{Bans: make([]*pb.BannedPeersResponse_Ban, 0, len(bans))}
+	for _, ban := range bans {
+		var until string
+		if !ban.IsPermanent() {
+			until = ban.Until.Format(time.RFC3339)
+		}
+		response.Bans = append(response.Bans, &pb.BannedPeersResponse_Ban{
+			Peer:   ban.Value,
+			Until:  until,
+			Reason: ban.Reason,
+		})
+	}
+	return response, nil
+}
+
+func (m *managerServer) MarkPeer(_ context.Context, req *pb.MarkPeerRequest) (*empty.Empty, error) {
+	res := new(empty.Empty)
+	id := strings.Split(req.Address, "@")[0]
+	if !isPeerID(id) {
+		return res, status.Error(codes.InvalidArgument, "address should be id@ip:port or node ID")
+	}
+
+	sw := m.tmNode.Switch()
+	if req.Persistent {
+		if err := sw.AddPersistentPeers([]string{req.Address}); err != nil {
+			return res, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	if req.Unconditional {
+		if err := sw.AddUnconditionalPeerIDs([]string{id}); err != nil {
+			return res, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	if req.Private {
+		if err := sw.AddPrivatePeerIDs([]string{id}); err != nil {
+			return res, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	return res, nil
+}
+
+func isPeerID(id string) bool {
+	bytes, err := hex.DecodeString(id)
+	return err == nil && len(bytes) == p2p.IDByteLength
+}
+
 func maxPeerHeight(sw *p2p.Switch) int64 {
 	var max int64
 	for _, peer := range sw.Peers().List() {
@@ -0,0 +1,22 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/MinterTeam/minter-go-node/cli/cli_pb"
+	"github.com/MinterTeam/minter-go-node/config"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestManagerServer_BanPeerWithoutFilter(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.FilterPeers = false
+	manager := NewManager(nil, nil, nil, cfg, nil)
+
+	_, err := manager.BanPeer(context.Background(), &pb.BanPeerRequest{Peer: "127.0.0.1"})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected FailedPrecondition, got %v", err)
+	}
+}
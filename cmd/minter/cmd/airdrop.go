@@ -0,0 +1,112 @@
+package cmd
+
+import (
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"strings"
+
+	"github.com/MinterTeam/minter-go-node/coreV2/state/airdrops"
+	"github.com/MinterTeam/minter-go-node/coreV2/types"
+	"github.com/spf13/cobra"
+)
+
+var (
+	AirdropCommand = &cobra.Command{
+		Use:   "airdrop",
+		Short: "Merkle airdrop helpers",
+	}
+
+	AirdropBuildCommand = &cobra.Command{
+		Use:   "build",
+		Short: "Build Merkle tree of airdrop from CSV file with lines \"address,value in pip\" and print its root and proofs",
+		RunE:  airdropBuild,
+	}
+)
+
+type airdropClaim struct {
+	Index   uint32        `json:"index"`
+	Address types.Address `json:"address"`
+	Value   string        `json:"value"`
+	Proof   []string      `json:"proof"`
+}
+
+type airdropTree struct {
+	Root   string         `json:"root"`
+	Total  string         `json:"total"`
+	Claims []airdropClaim `json:"claims"`
+}
+
+func airdropBuild(cmd *cobra.Command, args []string) error {
+	path, err := cmd.Flags().GetString("csv")
+	if err != nil {
+		return err
+	}
+	if path == "" {
+		return fmt.Errorf("flag --csv is required")
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	reader := csv.NewReader(file)
+	reader.FieldsPerRecord = 2
+	reader.TrimLeadingSpace = true
+
+	result := &airdropTree{Claims: []airdropClaim{}}
+	total := big.NewInt(0)
+	var leaves []types.Hash
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		address := strings.TrimSpace(record[0])
+		if !types.IsHexAddress(address) {
+			if line == 1 {
+				// header
+				continue
+			}
+			return fmt.Errorf("line %d: invalid address %s", line, address)
+		}
+		value, ok := big.NewInt(0).SetString(strings.TrimSpace(record[1]), 10)
+		if !ok || value.Sign() != 1 {
+			return fmt.Errorf("line %d: invalid value %s", line, record[1])
+		}
+
+		claim := airdropClaim{
+			Index:   uint32(len(result.Claims)),
+			Address: types.HexToAddress(address),
+			Value:   value.String(),
+		}
+		result.Claims = append(result.Claims, claim)
+		leaves = append(leaves, airdrops.Leaf(claim.Index, claim.Address, value))
+		total.Add(total, value)
+	}
+
+	tree := airdrops.NewTree(leaves)
+	root := tree.Root()
+	result.Root = hex.EncodeToString(root[:])
+	result.Total = total.String()
+	for i := range result.Claims {
+		result.Claims[i].Proof = []string{}
+		for _, node := range tree.Proof(i) {
+			result.Claims[i].Proof = append(result.Claims[i].Proof, hex.EncodeToString(node[:]))
+		}
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "    ")
+	return encoder.Encode(result)
+}
@@ -0,0 +1,115 @@
+package cmd
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/MinterTeam/minter-go-node/coreV2/types"
+	"github.com/MinterTeam/minter-go-node/crypto/bls"
+	"github.com/spf13/cobra"
+)
+
+var (
+	BLSCommand = &cobra.Command{
+		Use:   "bls",
+		Short: "BLS keys and aggregated signatures of multisig members",
+	}
+
+	BLSGenerateCommand = &cobra.Command{
+		Use:   "generate",
+		Short: "Generate BLS key and proof of possession for RegisterBLSKey transaction of the address",
+		RunE:  blsGenerate,
+	}
+
+	BLSSignCommand = &cobra.Command{
+		Use:   "sign",
+		Short: "Sign hash of transaction with BLS secret key",
+		RunE:  blsSign,
+	}
+
+	BLSAggregateCommand = &cobra.Command{
+		Use:   "aggregate",
+		Short: "Aggregate BLS signatures of multisig members into one signature",
+		RunE:  blsAggregate,
+	}
+)
+
+func blsGenerate(cmd *cobra.Command, args []string) error {
+	address, err := cmd.Flags().GetString("address")
+	if err != nil {
+		return err
+	}
+	if !strings.HasPrefix(address, "Mx") || len(address) != 42 {
+		return fmt.Errorf("invalid address: %s", address)
+	}
+	owner := types.HexToAddress(address)
+
+	secret, err := bls.GenerateKey(rand.Reader)
+	if err != nil {
+		return err
+	}
+
+	out, err := json.MarshalIndent(map[string]string{
+		"address":    owner.String(),
+		"secret":     hex.EncodeToString(secret.FillBytes(make([]byte, 32))),
+		"public_key": hex.EncodeToString(bls.PublicKey(secret)),
+		"proof":      hex.EncodeToString(bls.ProofOfPossession(secret, owner.Bytes())),
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(string(out))
+	return nil
+}
+
+func blsSign(cmd *cobra.Command, args []string) error {
+	secretHex, err := cmd.Flags().GetString("secret")
+	if err != nil {
+		return err
+	}
+	hashHex, err := cmd.Flags().GetString("hash")
+	if err != nil {
+		return err
+	}
+
+	secret, err := hex.DecodeString(strings.TrimPrefix(secretHex, "0x"))
+	if err != nil || len(secret) != 32 {
+		return fmt.Errorf("invalid secret key")
+	}
+	hash, err := hex.DecodeString(strings.TrimPrefix(hashHex, "0x"))
+	if err != nil || len(hash) != types.HashLength {
+		return fmt.Errorf("invalid hash of transaction")
+	}
+
+	fmt.Println(hex.EncodeToString(bls.Sign(new(big.Int).SetBytes(secret), hash)))
+	return nil
+}
+
+func blsAggregate(cmd *cobra.Command, args []string) error {
+	signaturesHex, err := cmd.Flags().GetStringSlice("signatures")
+	if err != nil {
+		return err
+	}
+
+	var signatures [][]byte
+	for _, signatureHex := range signaturesHex {
+		signature, err := hex.DecodeString(strings.TrimPrefix(signatureHex, "0x"))
+		if err != nil {
+			return fmt.Errorf("invalid signature %s: %s", signatureHex, err)
+		}
+		signatures = append(signatures, signature)
+	}
+
+	signature, err := bls.Aggregate(signatures)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(hex.EncodeToString(signature))
+	return nil
+}
@@ -56,6 +56,11 @@ func export(cmd *cobra.Command, args []string) error {
 		log.Panicf("Cannot parse indent: %s", err)
 	}
 
+	streamPath, err := cmd.Flags().GetString("stream")
+	if err != nil {
+		log.Panicf("Cannot parse stream: %s", err)
+	}
+
 	log.Println("Start exporting...")
 
 	homeDir, err := cmd.Flags().GetString("home-dir")
@@ -74,25 +79,39 @@ func export(cmd *cobra.Command, args []string) error {
 		log.Panicf("Cannot new state at given height: %s, last available height %d", err, appdb.NewAppDB(storages.GetMinterHome(), cfg).GetLastHeight())
 	}
 
-	exportTimeStart := time.Now()
-	appState := currentState.Export()
-	log.Printf("State has been exported. Took %s\n", time.Since(exportTimeStart))
-
-	if err := appState.Verify(); err != nil {
-		log.Fatalf("Failed to validate: %s\n", err)
-	}
-	log.Printf("Verify state OK\n")
-
 	var jsonBytes []byte
-	if indent {
-		jsonBytes, err = amino.NewCodec().MarshalJSONIndent(appState, "", "	")
+	if streamPath != "" {
+		exportTimeStart := time.Now()
+		err := currentState.ExportStreamFile(streamPath, func(module string, count uint64) {
+			log.Printf("Exported %d items of %s\n", count, module)
+		})
+		if err != nil {
+			log.Panicf("Cannot export state stream: %s", err)
+		}
+		log.Printf("State stream has been exported to %s. Took %s\n", streamPath, time.Since(exportTimeStart))
+
+		// the node imports state from the stream set by genesis_state_stream option of config
+		jsonBytes = []byte("{}")
 	} else {
-		jsonBytes, err = amino.NewCodec().MarshalJSON(appState)
-	}
-	if err != nil {
-		log.Panicf("Cannot marshal state to json: %s", err)
+		exportTimeStart := time.Now()
+		appState := currentState.Export()
+		log.Printf("State has been exported. Took %s\n", time.Since(exportTimeStart))
+
+		if err := appState.Verify(); err != nil {
+			log.Fatalf("Failed to validate: %s\n", err)
+		}
+		log.Printf("Verify state OK\n")
+
+		if indent {
+			jsonBytes, err = amino.NewCodec().MarshalJSONIndent(appState, "", "	")
+		} else {
+			jsonBytes, err = amino.NewCodec().MarshalJSON(appState)
+		}
+		if err != nil {
+			log.Panicf("Cannot marshal state to json: %s", err)
+		}
+		log.Printf("Marshal OK\n")
 	}
-	log.Printf("Marshal OK\n")
 
 	// compose genesis
 	genesis := types.GenesisDoc{
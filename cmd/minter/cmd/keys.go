@@ -0,0 +1,236 @@
+package cmd
+
+import (
+	"bufio"
+	"crypto/ecdsa"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/MinterTeam/minter-go-node/crypto"
+	"github.com/MinterTeam/minter-go-node/crypto/hd"
+	"github.com/MinterTeam/minter-go-node/crypto/keystore"
+	"github.com/spf13/cobra"
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+var (
+	KeysCommand = &cobra.Command{
+		Use:   "keys",
+		Short: "Manage keys of accounts stored in encrypted keystore of home dir",
+	}
+
+	KeysAddCommand = &cobra.Command{
+		Use:   "add <name>",
+		Short: "Generate new mnemonic or recover existing one and store derived key",
+		Args:  cobra.ExactArgs(1),
+		RunE:  keysAdd,
+	}
+
+	KeysListCommand = &cobra.Command{
+		Use:   "list",
+		Short: "List names and addresses of stored keys",
+		Args:  cobra.NoArgs,
+		RunE:  keysList,
+	}
+
+	KeysShowCommand = &cobra.Command{
+		Use:   "show <name>",
+		Short: "Show address of stored key and its public key for multisig setup",
+		Args:  cobra.ExactArgs(1),
+		RunE:  keysShow,
+	}
+)
+
+var keyNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9_-]{1,64}$`)
+
+func keysAdd(cmd *cobra.Command, args []string) error {
+	recoverKey, err := cmd.Flags().GetBool("recover")
+	if err != nil {
+		return err
+	}
+	index, err := cmd.Flags().GetUint32("index")
+	if err != nil {
+		return err
+	}
+	words, err := cmd.Flags().GetInt("words")
+	if err != nil {
+		return err
+	}
+
+	keyFile, err := keyFilePath(args[0])
+	if err != nil {
+		return err
+	}
+	if _, err := os.Stat(keyFile); err == nil {
+		return fmt.Errorf("key %s already exists", args[0])
+	}
+
+	reader := bufio.NewReader(os.Stdin)
+	var mnemonic string
+	if recoverKey {
+		if mnemonic, err = readSecret(reader, "Enter mnemonic: "); err != nil {
+			return err
+		}
+		if _, err := hd.MnemonicToEntropy(mnemonic); err != nil {
+			return err
+		}
+	} else {
+		entropy, err := hd.NewEntropy(words * 32 / 3)
+		if err != nil {
+			return err
+		}
+		if mnemonic, err = hd.NewMnemonic(entropy); err != nil {
+			return err
+		}
+	}
+
+	path := hd.AccountPath(index)
+	key, err := hd.DeriveKeyFromMnemonic(mnemonic, "", path)
+	if err != nil {
+		return err
+	}
+
+	passphrase, err := readSecret(reader, "Enter passphrase to encrypt key: ")
+	if err != nil {
+		return err
+	}
+	repeated, err := readSecret(reader, "Repeat passphrase: ")
+	if err != nil {
+		return err
+	}
+	if passphrase != repeated {
+		return fmt.Errorf("passphrases do not match")
+	}
+
+	keyJSON, err := keystore.EncryptKey(key, passphrase, keystore.StandardScryptN, keystore.StandardScryptP)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(keyFile), 0700); err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(keyFile, keyJSON, 0600); err != nil {
+		return err
+	}
+
+	fmt.Printf("Address: %s\nPath: %s\n", crypto.PubkeyToAddress(key.PublicKey).String(), path)
+	if !recoverKey {
+		fmt.Printf("\nWrite down the mnemonic and keep it safe, it is the only way to recover the key:\n\n%s\n", mnemonic)
+	}
+	return nil
+}
+
+func keysList(cmd *cobra.Command, args []string) error {
+	files, err := filepath.Glob(filepath.Join(keystoreDir(), "*.json"))
+	if err != nil {
+		return err
+	}
+
+	for _, file := range files {
+		k, err := readKeyFile(file)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("%s\t%s\n", strings.TrimSuffix(filepath.Base(file), ".json"), k.Address)
+	}
+	return nil
+}
+
+func keysShow(cmd *cobra.Command, args []string) error {
+	showPublicKey, err := cmd.Flags().GetBool("public-key")
+	if err != nil {
+		return err
+	}
+
+	keyFile, err := keyFilePath(args[0])
+	if err != nil {
+		return err
+	}
+	k, err := readKeyFile(keyFile)
+	if err != nil {
+		return err
+	}
+
+	result := map[string]string{
+		"name":    args[0],
+		"address": k.Address,
+	}
+	if showPublicKey {
+		key, err := decryptKeyFile(keyFile)
+		if err != nil {
+			return err
+		}
+		result["public_key"] = hex.EncodeToString(crypto.FromECDSAPub(&key.PublicKey))
+	}
+
+	out, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(string(out))
+	return nil
+}
+
+func keystoreDir() string {
+	return filepath.Join(cfg.RootDir, "keystore")
+}
+
+func keyFilePath(name string) (string, error) {
+	if !keyNameRegexp.MatchString(name) {
+		return "", fmt.Errorf("invalid key name %q, use letters, digits, dashes and underscores", name)
+	}
+	return filepath.Join(keystoreDir(), name+".json"), nil
+}
+
+func readKeyFile(file string) (*keystore.Key, error) {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	var k keystore.Key
+	if err := json.Unmarshal(data, &k); err != nil {
+		return nil, fmt.Errorf("invalid key file %s: %s", file, err)
+	}
+	return &k, nil
+}
+
+func decryptKeyFile(file string) (*ecdsa.PrivateKey, error) {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	passphrase, err := readSecret(bufio.NewReader(os.Stdin), "Enter passphrase: ")
+	if err != nil {
+		return nil, err
+	}
+	return keystore.DecryptKey(data, passphrase)
+}
+
+// readSecret reads the line without echo from terminal or as is from piped stdin
+func readSecret(reader *bufio.Reader, prompt string) (string, error) {
+	fd := int(os.Stdin.Fd())
+	if !terminal.IsTerminal(fd) {
+		line, err := reader.ReadString('\n')
+		if err != nil && line == "" {
+			return "", err
+		}
+		return strings.TrimRight(line, "\r\n"), nil
+	}
+
+	fmt.Fprint(os.Stderr, prompt)
+	secret, err := terminal.ReadPassword(fd)
+	fmt.Fprintln(os.Stderr)
+	if err != nil {
+		return "", err
+	}
+	return string(secret), nil
+}
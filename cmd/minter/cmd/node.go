@@ -8,8 +8,10 @@ import (
 	"github.com/MinterTeam/minter-go-node/cli/service"
 	"github.com/MinterTeam/minter-go-node/cmd/utils"
 	"github.com/MinterTeam/minter-go-node/config"
+	"github.com/MinterTeam/minter-go-node/coreV2/alerts"
 	"github.com/MinterTeam/minter-go-node/coreV2/minter"
 	"github.com/MinterTeam/minter-go-node/coreV2/rewards"
+	"github.com/MinterTeam/minter-go-node/coreV2/signguard"
 	"github.com/MinterTeam/minter-go-node/coreV2/statistics"
 	"github.com/MinterTeam/minter-go-node/log"
 	"github.com/MinterTeam/minter-go-node/version"
@@ -81,6 +83,16 @@ func runNode(cmd *cobra.Command) error {
 		if err != nil {
 			return err
 		}
+		_, err = storages.InitLPStatsLevelDB("data/lpstats", minter.GetDbOpts(256))
+		if err != nil {
+			return err
+		}
+		if cfg.SwapCandles {
+			_, err = storages.InitCandlesLevelDB("data/candles", minter.GetDbOpts(256))
+			if err != nil {
+				return err
+			}
+		}
 	}
 	_, err = storages.InitStateLevelDB("data/state", minter.GetDbOpts(cfg.StateMemAvailable))
 	if err != nil {
@@ -88,18 +100,47 @@ func runNode(cmd *cobra.Command) error {
 	}
 	app := minter.NewMinterBlockchain(storages, cfg, cmd.Context(), 0)
 
+	if len(cfg.MissedBlocksAlert.PubKeys) != 0 {
+		watcher, err := alerts.NewMissedBlocksWatcher(cfg.MissedBlocksAlert, logger.With("module", "alerts"))
+		if err != nil {
+			return err
+		}
+		go watcher.Run(cmd.Context(), app)
+	}
+
 	// update BlocksTimeDelta in case it was corrupted
 	// updateBlocksTimeDelta(app, tmConfig)
 
+	var privValidator tmTypes.PrivValidator
+	filePV := privval.LoadOrGenFilePV(tmConfig.PrivValidatorKeyFile(), tmConfig.PrivValidatorStateFile())
+	privValidator = filePV
+
+	var guard *signguard.Guard
+	if cfg.ValidatorMode {
+		guard, err = signguard.Open(storages.GetMinterHome(), filePV.GetAddress(), filePV.LastSignState.Height)
+		if err != nil {
+			return err
+		}
+		defer guard.Close()
+
+		if cfg.DoubleSignCheckBlocks > 0 {
+			privValidator = guard.PrivValidator(filePV)
+		}
+	}
+
 	// start TM node
-	node := startTendermintNode(app, tmConfig, logger, storages.GetMinterHome())
+	node := startTendermintNode(app, tmConfig, privValidator, logger, storages.GetMinterHome())
 	client := app.RpcClient()
 
+	if guard != nil && cfg.DoubleSignCheckBlocks > 0 {
+		go guard.Run(cmd.Context(), client, cfg.DoubleSignCheckBlocks, logger.With("module", "signguard"))
+	}
+
 	if !cfg.ValidatorMode {
 		runAPI(logger, app, client, node, app.RewardCounter())
 	}
 
-	runCLI(cmd.Context(), app, client, node, storages.GetMinterHome())
+	runCLI(cmd.Context(), app, client, node, storages.GetMinterHome(), logger)
 
 	if cfg.Instrumentation.Prometheus {
 		go app.SetStatisticData(statistics.New()).Statistic(cmd.Context())
@@ -108,9 +149,9 @@ func runNode(cmd *cobra.Command) error {
 	return app.WaitStop()
 }
 
-func runCLI(ctx context.Context, app *minter.Blockchain, client *rpc.Local, tmNode *tmNode.Node, home string) {
+func runCLI(ctx context.Context, app *minter.Blockchain, client *rpc.Local, tmNode *tmNode.Node, home string, logger *log.Logger) {
 	go func() {
-		err := service.StartCLIServer(home+"/manager.sock", service.NewManager(app, client, tmNode, cfg), ctx)
+		err := service.StartCLIServer(home+"/manager.sock", service.NewManager(app, client, tmNode, cfg, logger), ctx)
 		if err != nil {
 			panic(err)
 		}
@@ -182,7 +223,7 @@ func checkRlimits() error {
 	return nil
 }
 
-func startTendermintNode(app *minter.Blockchain, cfg *tmCfg.Config, logger tmLog.Logger, home string) *tmNode.Node {
+func startTendermintNode(app *minter.Blockchain, cfg *tmCfg.Config, privValidator tmTypes.PrivValidator, logger tmLog.Logger, home string) *tmNode.Node {
 	nodeKey, err := p2p.LoadOrGenNodeKey(cfg.NodeKeyFile())
 	if err != nil {
 		panic(err)
@@ -190,7 +231,7 @@ func startTendermintNode(app *minter.Blockchain, cfg *tmCfg.Config, logger tmLog
 
 	node, err := tmNode.NewNode(
 		cfg,
-		privval.LoadOrGenFilePV(cfg.PrivValidatorKeyFile(), cfg.PrivValidatorStateFile()),
+		privValidator,
 		nodeKey,
 		proxy.NewLocalClientCreator(app),
 		getGenesis(home+"/config/genesis.json"),
@@ -0,0 +1,99 @@
+package cmd
+
+import (
+	"crypto/ecdsa"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/MinterTeam/minter-go-node/crypto"
+	"github.com/MinterTeam/minter-go-node/crypto/envelope"
+	"github.com/spf13/cobra"
+)
+
+var (
+	PayloadCommand = &cobra.Command{
+		Use:   "payload",
+		Short: "Encrypted transaction payload helpers",
+	}
+
+	PayloadEncryptCommand = &cobra.Command{
+		Use:   "encrypt",
+		Short: "Encrypt message to public key of recipient and print hex payload of transaction",
+		RunE:  payloadEncrypt,
+	}
+
+	PayloadDecryptCommand = &cobra.Command{
+		Use:   "decrypt",
+		Short: "Decrypt hex payload of transaction with private key of recipient",
+		RunE:  payloadDecrypt,
+	}
+)
+
+func payloadEncrypt(cmd *cobra.Command, args []string) error {
+	publicKey, err := cmd.Flags().GetString("public-key")
+	if err != nil {
+		return err
+	}
+	message, err := cmd.Flags().GetString("message")
+	if err != nil {
+		return err
+	}
+
+	pub, err := decodePublicKey(publicKey)
+	if err != nil {
+		return err
+	}
+
+	payload, err := envelope.Seal(rand.Reader, pub, []byte(message))
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(hex.EncodeToString(payload))
+	return nil
+}
+
+func payloadDecrypt(cmd *cobra.Command, args []string) error {
+	keyFile, err := cmd.Flags().GetString("key-file")
+	if err != nil {
+		return err
+	}
+	payloadHex, err := cmd.Flags().GetString("payload")
+	if err != nil {
+		return err
+	}
+	if keyFile == "" {
+		return fmt.Errorf("flag --key-file is required")
+	}
+
+	prv, err := crypto.LoadECDSA(keyFile)
+	if err != nil {
+		return err
+	}
+	payload, err := hex.DecodeString(strings.TrimPrefix(payloadHex, "0x"))
+	if err != nil {
+		return fmt.Errorf("invalid payload: %s", err)
+	}
+
+	message, err := envelope.Open(prv, payload)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(string(message))
+	return nil
+}
+
+// decodePublicKey accepts compressed and uncompressed hex encoded secp256k1 public keys
+func decodePublicKey(publicKey string) (*ecdsa.PublicKey, error) {
+	b, err := hex.DecodeString(strings.TrimPrefix(publicKey, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid public key: %s", err)
+	}
+	if len(b) == 33 {
+		return crypto.DecompressPubkey(b)
+	}
+	return crypto.UnmarshalPubkey(b)
+}
@@ -0,0 +1,108 @@
+package cmd
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/MinterTeam/minter-go-node/cmd/utils"
+	"github.com/MinterTeam/minter-go-node/config"
+	"github.com/MinterTeam/minter-go-node/coreV2/appdb"
+	"github.com/MinterTeam/minter-go-node/coreV2/minter"
+	"github.com/spf13/cobra"
+	tmNode "github.com/tendermint/tendermint/node"
+	"github.com/tendermint/tendermint/store"
+)
+
+var RollbackCommand = &cobra.Command{
+	Use:   "rollback",
+	Short: "Rollback Minter state to the given height, Tendermint replays blocks after it on the next start",
+	RunE:  rollback,
+}
+
+func rollback(cmd *cobra.Command, args []string) error {
+	height, err := cmd.Flags().GetUint64("to")
+	if err != nil {
+		return err
+	}
+	if height == 0 {
+		return fmt.Errorf("flag --to is required")
+	}
+
+	homeDir, err := cmd.Flags().GetString("home-dir")
+	if err != nil {
+		return err
+	}
+	configDir, err := cmd.Flags().GetString("config")
+	if err != nil {
+		return err
+	}
+	storages := utils.NewStorage(homeDir, configDir)
+
+	blocksTime, err := getBlocksTime(height)
+	if err != nil {
+		return err
+	}
+
+	if !cfg.ValidatorMode {
+		_, err = storages.InitEventLevelDB("data/events", minter.GetDbOpts(1024))
+		if err != nil {
+			return err
+		}
+		_, err = storages.InitLPStatsLevelDB("data/lpstats", minter.GetDbOpts(256))
+		if err != nil {
+			return err
+		}
+		if cfg.SwapCandles {
+			_, err = storages.InitCandlesLevelDB("data/candles", minter.GetDbOpts(256))
+			if err != nil {
+				return err
+			}
+		}
+	}
+	_, err = storages.InitStateLevelDB("data/state", minter.GetDbOpts(cfg.StateMemAvailable))
+	if err != nil {
+		return err
+	}
+
+	app := minter.NewMinterBlockchain(storages, cfg, cmd.Context(), 0)
+	defer app.Close()
+
+	log.Printf("Rollback from height %d to %d...\n", app.Height(), height)
+	if err := app.Rollback(height, blocksTime); err != nil {
+		return err
+	}
+	log.Printf("Rollback OK, blocks after height %d will be replayed on the next start\n", height)
+
+	return nil
+}
+
+// getBlocksTime returns times of the latest blocks up to height from the Tendermint block store
+func getBlocksTime(height uint64) ([]time.Time, error) {
+	blockStoreDB, err := tmNode.DefaultDBProvider(&tmNode.DBContext{ID: "blockstore", Config: config.GetTmConfig(cfg)})
+	if err != nil {
+		return nil, err
+	}
+	defer blockStoreDB.Close()
+
+	blockStore := store.NewBlockStore(blockStoreDB)
+	if uint64(blockStore.Height()) < height {
+		return nil, fmt.Errorf("block store height %d is less than %d", blockStore.Height(), height)
+	}
+
+	var from uint64 = 1
+	if height > appdb.BlocksTimeCount {
+		from = height - appdb.BlocksTimeCount + 1
+	}
+
+	blocksTime := make([]time.Time, 0, appdb.BlocksTimeCount)
+	for h := from; h <= height; h++ {
+		meta := blockStore.LoadBlockMeta(int64(h))
+		if meta == nil {
+			continue
+		}
+		blocksTime = append(blocksTime, meta.Header.Time)
+	}
+
+	return blocksTime, nil
+}
@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/MinterTeam/minter-go-node/cmd/utils"
+	"github.com/MinterTeam/minter-go-node/coreV2/state"
+	"github.com/MinterTeam/minter-go-node/coreV2/statediff"
+	"github.com/spf13/cobra"
+)
+
+var (
+	StateCommand = &cobra.Command{
+		Use:   "state",
+		Short: "Minter state inspection commands",
+	}
+
+	StateDiffCommand = &cobra.Command{
+		Use:   "diff",
+		Short: "Show changes of balances, coins, stakes, pools and candidates between two heights",
+		RunE:  stateDiff,
+	}
+)
+
+func stateDiff(cmd *cobra.Command, args []string) error {
+	from, err := cmd.Flags().GetUint64("from")
+	if err != nil {
+		return err
+	}
+	to, err := cmd.Flags().GetUint64("to")
+	if err != nil {
+		return err
+	}
+	if from == 0 || from >= to {
+		return fmt.Errorf("flag --from should be greater than zero and less than --to")
+	}
+
+	homeDir, err := cmd.Flags().GetString("home-dir")
+	if err != nil {
+		return err
+	}
+	storages := utils.NewStorage(homeDir, "")
+
+	ldb, err := storages.InitStateLevelDB("data/state", nil)
+	if err != nil {
+		return err
+	}
+
+	fromState, err := state.NewCheckStateAtHeight(from, ldb)
+	if err != nil {
+		return fmt.Errorf("cannot load state at height %d: %s", from, err)
+	}
+	toState, err := state.NewCheckStateAtHeight(to, ldb)
+	if err != nil {
+		return fmt.Errorf("cannot load state at height %d: %s", to, err)
+	}
+
+	diff, err := statediff.Compare(context.Background(), from, fromState, to, toState, 0)
+	if err != nil {
+		return err
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "    ")
+	return encoder.Encode(diff)
+}
@@ -28,7 +28,18 @@ func main() {
 		cmd.VerifyGenesis,
 		cmd.Version,
 		cmd.ExportCommand,
+		cmd.RollbackCommand,
+		cmd.StateCommand,
+		cmd.AirdropCommand,
+		cmd.PayloadCommand,
+		cmd.BLSCommand,
+		cmd.KeysCommand,
 	)
+	cmd.StateCommand.AddCommand(cmd.StateDiffCommand)
+	cmd.AirdropCommand.AddCommand(cmd.AirdropBuildCommand)
+	cmd.PayloadCommand.AddCommand(cmd.PayloadEncryptCommand, cmd.PayloadDecryptCommand)
+	cmd.BLSCommand.AddCommand(cmd.BLSGenerateCommand, cmd.BLSSignCommand, cmd.BLSAggregateCommand)
+	cmd.KeysCommand.AddCommand(cmd.KeysAddCommand, cmd.KeysListCommand, cmd.KeysShowCommand)
 
 	rootCmd.PersistentFlags().String("home-dir", "", "base dir (default is $HOME/.minter)")
 	rootCmd.PersistentFlags().String("config", "", "path to config (default is $(home-dir)/config/config.toml)")
@@ -41,6 +52,29 @@ func main() {
 	cmd.ExportCommand.Flags().Bool("indent", false, "using indent")
 	cmd.ExportCommand.Flags().String("chain-id", "", "export chain id")
 	cmd.ExportCommand.Flags().Duration("genesis-time", 0, "export height")
+	cmd.ExportCommand.Flags().String("stream", "", "write state module by module as JSON lines to given file instead of genesis app state, an interrupted export continues from the last finished module")
+
+	cmd.RollbackCommand.Flags().Uint64("to", 0, "height to rollback state to")
+
+	cmd.StateDiffCommand.Flags().Uint64("from", 0, "height of the state to compare from")
+	cmd.StateDiffCommand.Flags().Uint64("to", 0, "height of the state to compare to")
+
+	cmd.AirdropBuildCommand.Flags().String("csv", "", "path to CSV file with addresses and values of airdrop")
+
+	cmd.PayloadEncryptCommand.Flags().String("public-key", "", "hex encoded public key of recipient, see /v2/public_key API")
+	cmd.PayloadEncryptCommand.Flags().String("message", "", "message to encrypt")
+	cmd.PayloadDecryptCommand.Flags().String("key-file", "", "path to file with hex encoded private key of recipient")
+	cmd.PayloadDecryptCommand.Flags().String("payload", "", "hex encoded payload of transaction")
+
+	cmd.BLSGenerateCommand.Flags().String("address", "", "address of multisig member which registers the key")
+	cmd.BLSSignCommand.Flags().String("secret", "", "hex encoded BLS secret key")
+	cmd.BLSSignCommand.Flags().String("hash", "", "hex encoded hash of transaction")
+	cmd.BLSAggregateCommand.Flags().StringSlice("signatures", nil, "comma separated hex encoded BLS signatures")
+
+	cmd.KeysAddCommand.Flags().Bool("recover", false, "recover key from existing mnemonic instead of generating new one")
+	cmd.KeysAddCommand.Flags().Uint32("index", 0, "index of key in BIP-44 path m/44'/60'/0'/0/<index>")
+	cmd.KeysAddCommand.Flags().Int("words", 24, "number of words of new mnemonic: 12, 15, 18, 21 or 24")
+	cmd.KeysShowCommand.Flags().Bool("public-key", false, "decrypt key and show its public key")
 
 	if err := rootCmd.ExecuteContext(ctx); err != nil {
 		panic(err)
@@ -12,6 +12,8 @@ type Storage struct {
 	minterConfig string
 	eventDB      db.DB
 	stateDB      db.DB
+	lpStatsDB    db.DB
+	candlesDB    db.DB
 }
 
 func (s *Storage) SetMinterConfig(minterConfig string) {
@@ -30,8 +32,16 @@ func (s *Storage) StateDB() db.DB {
 	return s.stateDB
 }
 
+func (s *Storage) LPStatsDB() db.DB {
+	return s.lpStatsDB
+}
+
+func (s *Storage) CandlesDB() db.DB {
+	return s.candlesDB
+}
+
 func NewStorage(home string, config string) *Storage {
-	return &Storage{eventDB: db.NewMemDB(), stateDB: db.NewMemDB(), minterConfig: config, minterHome: home}
+	return &Storage{eventDB: db.NewMemDB(), stateDB: db.NewMemDB(), lpStatsDB: db.NewMemDB(), candlesDB: db.NewMemDB(), minterConfig: config, minterHome: home}
 }
 
 func (s *Storage) InitEventLevelDB(name string, opts *opt.Options) (db.DB, error) {
@@ -52,6 +62,24 @@ func (s *Storage) InitStateLevelDB(name string, opts *opt.Options) (db.DB, error
 	return s.stateDB, nil
 }
 
+func (s *Storage) InitLPStatsLevelDB(name string, opts *opt.Options) (db.DB, error) {
+	levelDB, err := db.NewGoLevelDBWithOpts(name, s.GetMinterHome(), opts)
+	if err != nil {
+		return nil, err
+	}
+	s.lpStatsDB = levelDB
+	return s.lpStatsDB, nil
+}
+
+func (s *Storage) InitCandlesLevelDB(name string, opts *opt.Options) (db.DB, error) {
+	levelDB, err := db.NewGoLevelDBWithOpts(name, s.GetMinterHome(), opts)
+	if err != nil {
+		return nil, err
+	}
+	s.candlesDB = levelDB
+	return s.candlesDB, nil
+}
+
 func (s *Storage) GetMinterHome() string {
 	if s.minterHome != "" {
 		return s.minterHome
@@ -104,6 +104,8 @@ type Config struct {
 	Consensus       *tmConfig.ConsensusConfig       `mapstructure:"consensus"`
 	TxIndex         *tmConfig.TxIndexConfig         `mapstructure:"tx_index"`
 	Instrumentation *tmConfig.InstrumentationConfig `mapstructure:"instrumentation"`
+
+	MissedBlocksAlert *MissedBlocksAlertConfig `mapstructure:"missed_blocks_alert"`
 }
 
 // DefaultConfig returns a default configuration for a Tendermint node
@@ -116,6 +118,8 @@ func defaultConfig() *Config {
 		Consensus:       tmConfig.DefaultConsensusConfig(),
 		TxIndex:         tmConfig.DefaultTxIndexConfig(),
 		Instrumentation: tmConfig.DefaultInstrumentationConfig(),
+
+		MissedBlocksAlert: DefaultMissedBlocksAlertConfig(),
 	}
 }
 
@@ -169,6 +173,35 @@ func GetTmConfig(cfg *Config) *tmConfig.Config {
 	}
 }
 
+// -----------------------------------------------------------------------------
+// MissedBlocksAlertConfig
+
+// MissedBlocksAlertConfig defines the configuration of alerts about missed blocks of validators
+type MissedBlocksAlertConfig struct {
+	// Public keys of watched validators
+	PubKeys []string `mapstructure:"pub_keys"`
+
+	// Number of missed blocks in the absent window to send an alert
+	Threshold int `mapstructure:"threshold"`
+
+	// URL to POST JSON alerts to
+	URL string `mapstructure:"url"`
+
+	// Path to a local script executed on alert, JSON alert is passed to its stdin
+	Exec string `mapstructure:"exec"`
+
+	// Timeout of a request or a script execution
+	Timeout time.Duration `mapstructure:"timeout"`
+}
+
+// DefaultMissedBlocksAlertConfig returns a default configuration of missed blocks alerts
+func DefaultMissedBlocksAlertConfig() *MissedBlocksAlertConfig {
+	return &MissedBlocksAlertConfig{
+		Threshold: 6,
+		Timeout:   10 * time.Second,
+	}
+}
+
 // -----------------------------------------------------------------------------
 // BaseConfig
 
@@ -245,6 +278,21 @@ type BaseConfig struct {
 
 	ValidatorMode bool `mapstructure:"validator_mode"`
 
+	// Aggregate swaps of pools into candles served by API v2, ignored in validator mode
+	SwapCandles bool `mapstructure:"swap_candles"`
+
+	// Serve state diff by API v2, comparing states loads both of them into memory
+	StateDiffAPI bool `mapstructure:"state_diff_api"`
+
+	// Max count of blocks between heights of state diff requested by API v2
+	StateDiffMaxBlocks uint64 `mapstructure:"state_diff_max_blocks"`
+
+	// Max count of changes in state diff returned by API v2
+	StateDiffMaxChanges int `mapstructure:"state_diff_max_changes"`
+
+	// Max count of blocks between heights of rewards requested by API v2
+	RewardsMaxBlocks uint64 `mapstructure:"rewards_max_blocks"`
+
 	KeepLastStates int64 `mapstructure:"keep_last_states"`
 
 	APISimultaneousRequests int `mapstructure:"api_simultaneous_requests"`
@@ -256,6 +304,12 @@ type BaseConfig struct {
 	StateMemAvailable int `mapstructure:"state_mem_available"`
 
 	HaltHeight int `mapstructure:"halt_height"`
+
+	// Number of recent blocks checked for signatures of the validator made by another instance, 0 disables the check
+	DoubleSignCheckBlocks int64 `mapstructure:"double_sign_check_blocks"`
+
+	// Path to state stream written by export command, imported instead of app state of genesis
+	GenesisStateStream string `mapstructure:"genesis_state_stream"`
 }
 
 // DefaultBaseConfig returns a default base configuration for a Tendermint node
@@ -277,12 +331,17 @@ func DefaultBaseConfig() BaseConfig {
 		APIv2TimeoutDuration:    10 * time.Second,
 		WSConnectionDuration:    time.Minute,
 		ValidatorMode:           false,
+		StateDiffAPI:            false,
+		StateDiffMaxBlocks:      1000,
+		StateDiffMaxChanges:     10000,
+		RewardsMaxBlocks:        100000,
 		KeepLastStates:          120,
 		StateCacheSize:          1000000,
 		StateMemAvailable:       1024,
 		APISimultaneousRequests: 100,
 		LogPath:                 "stdout",
 		LogFormat:               LogFormatPlain,
+		DoubleSignCheckBlocks:   100,
 	}
 }
 
@@ -296,6 +355,14 @@ func (cfg BaseConfig) GenesisFile() string {
 	return rootify(cfg.Genesis, cfg.RootDir)
 }
 
+// GenesisStateStreamFile returns the full path to the state stream file or empty string if it is not set
+func (cfg BaseConfig) GenesisStateStreamFile() string {
+	if cfg.GenesisStateStream == "" {
+		return ""
+	}
+	return rootify(cfg.GenesisStateStream, cfg.RootDir)
+}
+
 // PrivValidatorStateFile returns the full path to the priv_validator_state.json file
 func (cfg BaseConfig) PrivValidatorStateFile() string {
 	return rootify(cfg.PrivValidatorState, cfg.RootDir)
@@ -81,6 +81,27 @@ ws_connection_duration = "{{ .BaseConfig.WSConnectionDuration }}"
 # Sets node to be in validator mode. Disables API, events, history of blocks, indexes, etc. 
 validator_mode = {{ .BaseConfig.ValidatorMode }}
 
+# Aggregate swaps of pools into OHLCV candles served by API v2. Ignored in validator mode
+swap_candles = {{ .BaseConfig.SwapCandles }}
+
+# Serve /v2/state_diff. Comparing states loads both of them into memory. Ignored in validator mode
+state_diff_api = {{ .BaseConfig.StateDiffAPI }}
+
+# Max count of blocks between heights and max count of changes of /v2/state_diff
+state_diff_max_blocks = {{ .BaseConfig.StateDiffMaxBlocks }}
+state_diff_max_changes = {{ .BaseConfig.StateDiffMaxChanges }}
+
+# Max count of blocks between heights of /v2/rewards
+rewards_max_blocks = {{ .BaseConfig.RewardsMaxBlocks }}
+
+# Number of recent blocks checked in validator mode for signatures of the validator made by another instance.
+# The node refuses to sign if such signatures are found. 0 disables the check
+double_sign_check_blocks = {{ .BaseConfig.DoubleSignCheckBlocks }}
+
+# Path to state stream written by "minter export --stream". If set, state of the chain is imported
+# from this file on the first start instead of app_state of genesis file
+genesis_state_stream = '{{ .BaseConfig.GenesisStateStream }}'
+
 # Sets number of last stated to be saved on disk.
 keep_last_states = {{ .BaseConfig.KeepLastStates }}
 
@@ -98,6 +119,11 @@ api_simultaneous_requests = {{ .BaseConfig.APISimultaneousRequests }}
 # and verifying their commits
 fast_sync = {{ .BaseConfig.FastSync }}
 
+# If true, query the ABCI app on connecting to a new peer
+# so the app can reject peers banned with the manager.
+# Banning peers requires it to be true
+filter_peers = {{ .BaseConfig.FilterPeers }}
+
 # Database backend: leveldb | memdb
 db_backend = "{{ .BaseConfig.DBBackend }}"
 
@@ -240,4 +266,23 @@ max_open_connections = {{ .Instrumentation.MaxOpenConnections }}
 
 # Instrumentation namespace
 namespace = "minter"
+
+##### missed blocks alert configuration options #####
+[missed_blocks_alert]
+
+# Public keys of validators to watch, e.g. ["Mp..."]
+pub_keys = [{{ range $i, $key := .MissedBlocksAlert.PubKeys }}{{ if $i }}, {{ end }}"{{ $key }}"{{ end }}]
+
+# Alert is sent when a validator misses this number of blocks in the last 24 blocks.
+# The validator is punished and turned off after more than 12 missed blocks
+threshold = {{ .MissedBlocksAlert.Threshold }}
+
+# URL to POST JSON alerts to
+url = "{{ .MissedBlocksAlert.URL }}"
+
+# Path to a local script executed on alert, JSON alert is passed to its stdin
+exec = '{{ .MissedBlocksAlert.Exec }}'
+
+# Timeout of a request or a script execution
+timeout = "{{ .MissedBlocksAlert.Timeout }}"
 `
@@ -0,0 +1,188 @@
+package alerts
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/MinterTeam/minter-go-node/config"
+	"github.com/MinterTeam/minter-go-node/coreV2/state"
+	"github.com/MinterTeam/minter-go-node/coreV2/state/candidates"
+	"github.com/MinterTeam/minter-go-node/coreV2/state/validators"
+	"github.com/MinterTeam/minter-go-node/coreV2/types"
+	tmLog "github.com/tendermint/tendermint/libs/log"
+)
+
+// Alert types
+const (
+	TypeMissedBlocks = "missed_blocks"
+	TypeTurnedOff    = "turned_off"
+)
+
+// Alert is a JSON message sent to the configured URL or script
+type Alert struct {
+	Type         string       `json:"type"`
+	PublicKey    types.Pubkey `json:"public_key"`
+	Height       uint64       `json:"height"`
+	MissedBlocks int          `json:"missed_blocks"`
+	Window       int          `json:"window"`
+	Threshold    int          `json:"threshold"`
+}
+
+// Blockchain is a source of states for the watcher
+type Blockchain interface {
+	Height() uint64
+	GetStateForHeight(height uint64) (*state.CheckState, error)
+}
+
+type watchedValidator struct {
+	isValidator bool
+	alerted     bool
+}
+
+// MissedBlocksWatcher checks absent times of configured validators on each block and sends alerts
+// when the number of missed blocks reaches the threshold or a validator is turned off
+type MissedBlocksWatcher struct {
+	cfg        *config.MissedBlocksAlertConfig
+	validators map[types.Pubkey]*watchedValidator
+	height     uint64
+	send       func(ctx context.Context, alert *Alert) error
+	logger     tmLog.Logger
+}
+
+// NewMissedBlocksWatcher creates a watcher for validators from the config
+func NewMissedBlocksWatcher(cfg *config.MissedBlocksAlertConfig, logger tmLog.Logger) (*MissedBlocksWatcher, error) {
+	w := &MissedBlocksWatcher{
+		cfg:        cfg,
+		validators: map[types.Pubkey]*watchedValidator{},
+		logger:     logger,
+	}
+	w.send = w.sendAlert
+
+	for _, key := range cfg.PubKeys {
+		decoded, err := hex.DecodeString(strings.TrimPrefix(key, "Mp"))
+		if err != nil || !strings.HasPrefix(key, "Mp") || len(decoded) != types.PubKeyLength {
+			return nil, fmt.Errorf("invalid public key %s", key)
+		}
+		w.validators[types.BytesToPubkey(decoded)] = &watchedValidator{}
+	}
+
+	return w, nil
+}
+
+// Run checks each new block of the blockchain until the context is done
+func (w *MissedBlocksWatcher) Run(ctx context.Context, blockchain Blockchain) {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		height := blockchain.Height()
+		if height == 0 || height == w.height {
+			continue
+		}
+
+		cState, err := blockchain.GetStateForHeight(height)
+		if err != nil {
+			w.logger.Error("Failed to load state for missed blocks check", "height", height, "err", err)
+			continue
+		}
+
+		w.Check(ctx, height, cState)
+	}
+}
+
+// Check looks through validators at given height and sends alerts
+func (w *MissedBlocksWatcher) Check(ctx context.Context, height uint64, cState *state.CheckState) {
+	w.height = height
+
+	cState.Validators().LoadValidators()
+	cState.Candidates().LoadCandidates()
+
+	for pubkey, watched := range w.validators {
+		validator := cState.Validators().GetByPublicKey(pubkey)
+
+		candidate := cState.Candidates().GetCandidate(pubkey)
+		isValidator := validator != nil && !validator.IsToDrop() && candidate != nil && candidate.GetStatus() == candidates.CandidateStatusOnline
+		if !isValidator {
+			if watched.isValidator {
+				w.notify(ctx, &Alert{Type: TypeTurnedOff, PublicKey: pubkey, Height: height})
+			}
+			watched.isValidator = false
+			watched.alerted = false
+			continue
+		}
+		watched.isValidator = true
+
+		missed := validator.CountAbsentTimes()
+		if missed < w.cfg.Threshold {
+			watched.alerted = false
+			continue
+		}
+
+		if watched.alerted {
+			continue
+		}
+		watched.alerted = true
+
+		w.notify(ctx, &Alert{Type: TypeMissedBlocks, PublicKey: pubkey, Height: height, MissedBlocks: missed})
+	}
+}
+
+func (w *MissedBlocksWatcher) notify(ctx context.Context, alert *Alert) {
+	alert.Window = validators.ValidatorMaxAbsentWindow
+	alert.Threshold = w.cfg.Threshold
+
+	w.logger.Info("Validator alert", "type", alert.Type, "pubkey", alert.PublicKey.String(), "height", alert.Height, "missed", alert.MissedBlocks)
+	if err := w.send(ctx, alert); err != nil {
+		w.logger.Error("Failed to send validator alert", "type", alert.Type, "pubkey", alert.PublicKey.String(), "err", err)
+	}
+}
+
+func (w *MissedBlocksWatcher) sendAlert(ctx context.Context, alert *Alert) error {
+	data, err := json.Marshal(alert)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, w.cfg.Timeout)
+	defer cancel()
+
+	if w.cfg.URL != "" {
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.cfg.URL, bytes.NewReader(data))
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return err
+		}
+		resp.Body.Close()
+		if resp.StatusCode/100 != 2 {
+			return fmt.Errorf("alert URL responded with status %s", resp.Status)
+		}
+	}
+
+	if w.cfg.Exec != "" {
+		cmd := exec.CommandContext(ctx, w.cfg.Exec, alert.Type, alert.PublicKey.String())
+		cmd.Stdin = bytes.NewReader(data)
+		if out, err := cmd.CombinedOutput(); err != nil {
+			return fmt.Errorf("alert script failed: %v: %s", err, out)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package alerts
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/MinterTeam/minter-go-node/config"
+	"github.com/MinterTeam/minter-go-node/coreV2/state"
+	"github.com/MinterTeam/minter-go-node/coreV2/types"
+	"github.com/tendermint/tendermint/libs/log"
+	db "github.com/tendermint/tm-db"
+)
+
+func TestMissedBlocksWatcher(t *testing.T) {
+	t.Parallel()
+	s, err := state.NewState(0, db.NewMemDB(), nil, 1, 1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pubkey := types.Pubkey{1}
+	s.Candidates.Create(types.Address{}, types.Address{}, types.Address{}, pubkey, 10, 0, 0)
+	s.Candidates.SetOnline(pubkey)
+	s.Validators.Create(pubkey, big.NewInt(1))
+
+	cfg := config.DefaultMissedBlocksAlertConfig()
+	cfg.PubKeys = []string{pubkey.String()}
+	cfg.Threshold = 2
+
+	w, err := NewMissedBlocksWatcher(cfg, log.NewNopLogger())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var alerts []*Alert
+	w.send = func(_ context.Context, alert *Alert) error {
+		alerts = append(alerts, alert)
+		return nil
+	}
+
+	cState := state.NewCheckState(s)
+	validator := s.Validators.GetByPublicKey(pubkey)
+
+	validator.SetAbsent(1)
+	w.Check(context.Background(), 1, cState)
+	if len(alerts) != 0 {
+		t.Fatalf("unexpected alerts: %d", len(alerts))
+	}
+
+	validator.SetAbsent(2)
+	w.Check(context.Background(), 2, cState)
+	validator.SetAbsent(3)
+	w.Check(context.Background(), 3, cState)
+	if len(alerts) != 1 || alerts[0].Type != TypeMissedBlocks || alerts[0].MissedBlocks != 2 || alerts[0].Height != 2 {
+		t.Fatalf("wrong alerts: %+v", alerts)
+	}
+
+	s.Candidates.SetOffline(pubkey)
+	w.Check(context.Background(), 4, cState)
+	w.Check(context.Background(), 5, cState)
+	if len(alerts) != 2 || alerts[1].Type != TypeTurnedOff || alerts[1].PublicKey != pubkey {
+		t.Fatalf("wrong alerts: %+v", alerts)
+	}
+}
+
+func TestNewMissedBlocksWatcherInvalidPubKey(t *testing.T) {
+	t.Parallel()
+	cfg := config.DefaultMissedBlocksAlertConfig()
+	cfg.PubKeys = []string{"Mp01"}
+
+	if _, err := NewMissedBlocksWatcher(cfg, log.NewNopLogger()); err == nil {
+		t.Fatal("error expected")
+	}
+}
@@ -232,6 +232,18 @@ func (appDB *AppDB) AddBlocksTime(time time.Time) {
 	}
 }
 
+// SetBlocksTime replaces times of latest blocks, used on rollback
+func (appDB *AppDB) SetBlocksTime(times []time.Time) {
+	appDB.lastTimeBlocks = make([]uint64, 0, BlocksTimeCount)
+	for _, t := range times {
+		appDB.lastTimeBlocks = append(appDB.lastTimeBlocks, uint64(t.Unix()))
+	}
+	count := len(appDB.lastTimeBlocks)
+	if count > BlocksTimeCount {
+		appDB.lastTimeBlocks = appDB.lastTimeBlocks[count-BlocksTimeCount:]
+	}
+}
+
 func (appDB *AppDB) SaveBlocksTime() {
 	data, err := tmjson.Marshal(appDB.lastTimeBlocks)
 	if err != nil {
@@ -302,6 +314,18 @@ func (appDB *AppDB) AddVersion(v string, height uint64) {
 	appDB.isDirtyVersions = true
 }
 
+// RemoveVersionsAfter removes versions applied after given height, used on rollback
+func (appDB *AppDB) RemoveVersionsAfter(height uint64) {
+	versions := appDB.GetVersions()
+	for i, version := range versions {
+		if version.Height > height {
+			appDB.versions = versions[:i]
+			appDB.isDirtyVersions = true
+			break
+		}
+	}
+}
+
 func (appDB *AppDB) SaveVersions() {
 	if !appDB.isDirtyVersions {
 		return
@@ -0,0 +1,155 @@
+package banlist
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"os"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Ban is a record of banned peer ID or IP address
+type Ban struct {
+	Value  string    `json:"value"`
+	Until  time.Time `json:"until"`
+	Reason string    `json:"reason,omitempty"`
+}
+
+// IsPermanent returns true if ban has no expiration time
+func (b *Ban) IsPermanent() bool {
+	return b.Until.IsZero()
+}
+
+func (b *Ban) isExpired(now time.Time) bool {
+	return !b.IsPermanent() && !now.Before(b.Until)
+}
+
+// BanList is a list of banned peers persisted on disk
+type BanList struct {
+	lock sync.RWMutex
+	path string
+	bans map[string]*Ban
+}
+
+// NewBanList creates BanList and loads bans stored in given file
+func NewBanList(path string) (*BanList, error) {
+	b := &BanList{path: path, bans: map[string]*Ban{}}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return b, nil
+		}
+		return nil, err
+	}
+	if len(data) == 0 {
+		return b, nil
+	}
+
+	var bans []*Ban
+	if err := json.Unmarshal(data, &bans); err != nil {
+		return nil, err
+	}
+	for _, ban := range bans {
+		b.bans[ban.Value] = ban
+	}
+
+	return b, nil
+}
+
+// Ban bans peer ID or IP for given duration, zero duration means permanent ban.
+// It returns a copy of the stored ban.
+func (b *BanList) Ban(value string, duration time.Duration, reason string) (*Ban, error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	ban := &Ban{Value: value, Reason: reason}
+	if duration > 0 {
+		ban.Until = time.Now().Add(duration).UTC()
+	}
+	b.bans[value] = ban
+
+	banCopy := *ban
+	return &banCopy, b.save()
+}
+
+// Unban removes peer ID or IP from the list, returns false if it was not banned
+func (b *BanList) Unban(value string) (bool, error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if _, ok := b.bans[value]; !ok {
+		return false, nil
+	}
+	delete(b.bans, value)
+
+	return true, b.save()
+}
+
+// List returns copies of active bans sorted by value
+func (b *BanList) List() []*Ban {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+
+	now := time.Now()
+	bans := make([]*Ban, 0, len(b.bans))
+	for _, ban := range b.bans {
+		if ban.isExpired(now) {
+			continue
+		}
+		banCopy := *ban
+		bans = append(bans, &banCopy)
+	}
+	sort.Slice(bans, func(i, j int) bool {
+		return bans[i].Value < bans[j].Value
+	})
+
+	return bans
+}
+
+// IsBanned checks peer ID or IP
+func (b *BanList) IsBanned(value string) bool {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+
+	ban, ok := b.bans[value]
+	if !ok {
+		return false
+	}
+
+	return !ban.isExpired(time.Now())
+}
+
+// IsBannedAddr checks IP of given host:port address
+func (b *BanList) IsBannedAddr(addr string) bool {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		host = addr
+	}
+
+	return b.IsBanned(host)
+}
+
+func (b *BanList) save() error {
+	now := time.Now()
+	bans := make([]*Ban, 0, len(b.bans))
+	for value, ban := range b.bans {
+		if ban.isExpired(now) {
+			delete(b.bans, value)
+			continue
+		}
+		bans = append(bans, ban)
+	}
+	sort.Slice(bans, func(i, j int) bool {
+		return bans[i].Value < bans[j].Value
+	})
+
+	data, err := json.MarshalIndent(bans, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(b.path, data, 0644)
+}
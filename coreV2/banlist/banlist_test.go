@@ -0,0 +1,71 @@
+package banlist
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestBanList(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "banlist.json")
+	b, err := NewBanList(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const id = "25104d4b173d1047e9d1a70cdefde9e30707beb1"
+	if _, err := b.Ban(id, 0, "spam"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := b.Ban("84.201.143.192", time.Hour, ""); err != nil {
+		t.Fatal(err)
+	}
+	expired, err := b.Ban("138.201.28.219", time.Hour, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expired.Until = time.Time{}
+	if b.List()[0].IsPermanent() {
+		t.Fatal("stored ban is changed through the returned one")
+	}
+	b.bans[expired.Value].Until = time.Now().Add(-time.Minute)
+	if !b.IsBanned(id) || b.IsBanned(expired.Value) {
+		t.Fatal("unexpected ban status")
+	}
+	if _, err := b.Ban(id, 0, "spam"); err != nil {
+		t.Fatal(err)
+	}
+
+	loaded, err := NewBanList(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !loaded.IsBanned(id) {
+		t.Fatal("peer id is not banned")
+	}
+	if !loaded.IsBannedAddr("84.201.143.192:26656") {
+		t.Fatal("peer address is not banned")
+	}
+	if loaded.IsBannedAddr("138.201.28.219:26656") {
+		t.Fatal("expired ban is active")
+	}
+
+	list := loaded.List()
+	if len(list) != 2 {
+		t.Fatalf("expected 2 bans, got %d", len(list))
+	}
+	if !list[0].IsPermanent() || list[0].Reason != "spam" {
+		t.Fatalf("unexpected ban %+v", list[0])
+	}
+
+	ok, err := loaded.Unban(id)
+	if err != nil || !ok {
+		t.Fatal("unban failed", err)
+	}
+	if loaded.IsBanned(id) {
+		t.Fatal("peer id is still banned")
+	}
+	if ok, _ := loaded.Unban(id); ok {
+		t.Fatal("peer id unbanned twice")
+	}
+}
@@ -0,0 +1,358 @@
+package candles
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/MinterTeam/minter-go-node/coreV2/types"
+	"github.com/MinterTeam/minter-go-node/rlp"
+	db "github.com/tendermint/tm-db"
+)
+
+// PricePrecision is a multiplier of prices in candles
+var PricePrecision = big.NewInt(1e18)
+
+const (
+	candlePrefix  = 'c'
+	journalPrefix = 'j'
+)
+
+// Interval is a duration of a candle in seconds of block time
+type Interval uint32
+
+// Supported intervals of candles
+const (
+	Minute Interval = 60
+	Hour   Interval = 60 * Minute
+	Day    Interval = 24 * Hour
+)
+
+// Intervals is a list of intervals tracked by Store
+var Intervals = []Interval{Minute, Hour, Day}
+
+// ParseInterval parses interval in form of 1m, 1h or 1d
+func ParseInterval(s string) (Interval, error) {
+	for _, interval := range Intervals {
+		if interval.String() == s {
+			return interval, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown interval %q", s)
+}
+
+func (i Interval) String() string {
+	switch i {
+	case Minute:
+		return "1m"
+	case Hour:
+		return "1h"
+	case Day:
+		return "1d"
+	}
+	return "unknown"
+}
+
+// Start returns the start of the interval containing given unix time
+func (i Interval) Start(unix uint64) uint64 {
+	return unix - unix%uint64(i)
+}
+
+// ICandles is an interface of Store used by the swap module
+type ICandles interface {
+	AddTrade(poolID uint32, coinIn, coinOut types.CoinID, amountIn, amountOut *big.Int)
+}
+
+// Candle is open, high, low and close prices and volumes of swaps in the pool during an interval.
+// Coins are sorted by ID, prices are amounts of Coin1 for one Coin0 multiplied by PricePrecision.
+type Candle struct {
+	Start       uint64
+	Coin0       types.CoinID
+	Coin1       types.CoinID
+	Open        *big.Int
+	High        *big.Int
+	Low         *big.Int
+	Close       *big.Int
+	Volume0     *big.Int
+	Volume1     *big.Int
+	Trades      uint64
+	FirstHeight uint64
+	LastHeight  uint64
+}
+
+func (c *Candle) add(height uint64, t *trade) {
+	price := t.price()
+	if c.Trades == 0 {
+		c.Open = new(big.Int).Set(price)
+		c.High = new(big.Int).Set(price)
+		c.Low = new(big.Int).Set(price)
+		c.FirstHeight = height
+	}
+	if price.Cmp(c.High) == 1 {
+		c.High.Set(price)
+	}
+	if price.Cmp(c.Low) == -1 {
+		c.Low.Set(price)
+	}
+	c.Close = new(big.Int).Set(price)
+	c.Volume0.Add(c.Volume0, t.amount0)
+	c.Volume1.Add(c.Volume1, t.amount1)
+	c.Trades++
+	c.LastHeight = height
+}
+
+type trade struct {
+	poolID  uint32
+	coin0   types.CoinID
+	coin1   types.CoinID
+	amount0 *big.Int
+	amount1 *big.Int
+}
+
+// price is the execution price of the trade in coin1 for one coin0
+func (t *trade) price() *big.Int {
+	return new(big.Int).Quo(new(big.Int).Mul(t.amount1, PricePrecision), t.amount0)
+}
+
+// journalEntry is a previous value of a candle changed in a block, empty if the candle was created
+type journalEntry struct {
+	Key   []byte
+	Value []byte
+}
+
+// Store aggregates swaps of pools into candles by block time.
+// Trades are kept in memory until Commit, previous values of changed candles are journaled for rollbacks.
+type Store struct {
+	lock      sync.RWMutex
+	db        db.DB
+	keepLast  uint64
+	blockTime time.Time
+	pending   []*trade
+}
+
+// NewStore creates new Store in given DB, journal of changes is kept for keepLast blocks
+func NewStore(db db.DB, keepLast uint64) *Store {
+	return &Store{
+		db:       db,
+		keepLast: keepLast,
+	}
+}
+
+// BeginBlock sets time of the block which trades are added to
+func (s *Store) BeginBlock(blockTime time.Time) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.blockTime = blockTime
+}
+
+// AddTrade adds a swap of amountIn of coinIn for amountOut of coinOut in the pool
+func (s *Store) AddTrade(poolID uint32, coinIn, coinOut types.CoinID, amountIn, amountOut *big.Int) {
+	if amountIn.Sign() != 1 || amountOut.Sign() != 1 {
+		return
+	}
+	if coinIn > coinOut {
+		coinIn, coinOut = coinOut, coinIn
+		amountIn, amountOut = amountOut, amountIn
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.pending = append(s.pending, &trade{
+		poolID:  poolID,
+		coin0:   coinIn,
+		coin1:   coinOut,
+		amount0: new(big.Int).Set(amountIn),
+		amount1: new(big.Int).Set(amountOut),
+	})
+}
+
+// Commit adds trades of the block at given height to candles
+func (s *Store) Commit(height uint64) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	batch := s.db.NewBatch()
+	defer batch.Close()
+
+	if height > s.keepLast {
+		if err := batch.Delete(journalKey(height - s.keepLast)); err != nil {
+			return err
+		}
+	}
+
+	if len(s.pending) != 0 {
+		unix := uint64(s.blockTime.Unix())
+		changed := map[string]*Candle{}
+		var journal []*journalEntry
+		for _, interval := range Intervals {
+			for _, t := range s.pending {
+				key := candleKey(interval, t.poolID, interval.Start(unix))
+				candle, ok := changed[string(key)]
+				if !ok {
+					previous, err := s.db.Get(key)
+					if err != nil {
+						return err
+					}
+					journal = append(journal, &journalEntry{Key: key, Value: previous})
+
+					candle, err = decodeCandle(previous)
+					if err != nil {
+						return err
+					}
+					if candle == nil {
+						candle = &Candle{
+							Start:   interval.Start(unix),
+							Coin0:   t.coin0,
+							Coin1:   t.coin1,
+							Volume0: big.NewInt(0),
+							Volume1: big.NewInt(0),
+						}
+					}
+					changed[string(key)] = candle
+				}
+				candle.add(height, t)
+			}
+		}
+
+		for _, entry := range journal {
+			bytes, err := rlp.EncodeToBytes(changed[string(entry.Key)])
+			if err != nil {
+				return err
+			}
+			if err := batch.Set(entry.Key, bytes); err != nil {
+				return err
+			}
+		}
+
+		bytes, err := rlp.EncodeToBytes(journal)
+		if err != nil {
+			return err
+		}
+		if err := batch.Set(journalKey(height), bytes); err != nil {
+			return err
+		}
+	}
+
+	if err := batch.Write(); err != nil {
+		return err
+	}
+
+	s.pending = nil
+	return nil
+}
+
+// DeleteFrom restores candles to the state before the block at given height
+func (s *Store) DeleteFrom(height uint64) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	iterator, err := s.db.Iterator(journalKey(height), journalKey(^uint64(0)))
+	if err != nil {
+		return err
+	}
+	var journals [][]byte
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		journals = append(journals, iterator.Value())
+		keys = append(keys, iterator.Key())
+	}
+	if err := iterator.Close(); err != nil {
+		return err
+	}
+
+	batch := s.db.NewBatch()
+	defer batch.Close()
+	restored := map[string]struct{}{}
+	// the earliest previous value of each candle is the value before given height
+	for _, bytes := range journals {
+		var journal []*journalEntry
+		if err := rlp.DecodeBytes(bytes, &journal); err != nil {
+			return err
+		}
+		for _, entry := range journal {
+			if _, ok := restored[string(entry.Key)]; ok {
+				continue
+			}
+			restored[string(entry.Key)] = struct{}{}
+			if len(entry.Value) == 0 {
+				err = batch.Delete(entry.Key)
+			} else {
+				err = batch.Set(entry.Key, entry.Value)
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
+	for _, key := range keys {
+		if err := batch.Delete(key); err != nil {
+			return err
+		}
+	}
+	if err := batch.WriteSync(); err != nil {
+		return err
+	}
+
+	s.pending = nil
+	return nil
+}
+
+// Candles returns candles of the pool with starts in given range of unix time, at most limit candles
+func (s *Store) Candles(poolID uint32, interval Interval, from, to uint64, limit int) ([]*Candle, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	end := candleKey(interval, poolID+1, 0)
+	if to != ^uint64(0) {
+		end = candleKey(interval, poolID, to+1)
+	}
+	iterator, err := s.db.Iterator(candleKey(interval, poolID, interval.Start(from)), end)
+	if err != nil {
+		return nil, err
+	}
+	defer iterator.Close()
+
+	var candles []*Candle
+	for ; iterator.Valid() && len(candles) < limit; iterator.Next() {
+		candle, err := decodeCandle(iterator.Value())
+		if err != nil {
+			return nil, err
+		}
+		candles = append(candles, candle)
+	}
+
+	return candles, nil
+}
+
+func decodeCandle(bytes []byte) (*Candle, error) {
+	if len(bytes) == 0 {
+		return nil, nil
+	}
+	candle := new(Candle)
+	if err := rlp.DecodeBytes(bytes, candle); err != nil {
+		return nil, err
+	}
+	return candle, nil
+}
+
+// candleKey is 'c' + interval + pool ID + start of the candle
+func candleKey(interval Interval, poolID uint32, start uint64) []byte {
+	key := make([]byte, 17)
+	key[0] = candlePrefix
+	binary.BigEndian.PutUint32(key[1:5], uint32(interval))
+	binary.BigEndian.PutUint32(key[5:9], poolID)
+	binary.BigEndian.PutUint64(key[9:17], start)
+	return key
+}
+
+// journalKey is 'j' + height
+func journalKey(height uint64) []byte {
+	key := make([]byte, 9)
+	key[0] = journalPrefix
+	binary.BigEndian.PutUint64(key[1:9], height)
+	return key
+}
@@ -0,0 +1,75 @@
+package candles
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	db "github.com/tendermint/tm-db"
+)
+
+func TestStore(t *testing.T) {
+	store := NewStore(db.NewMemDB(), 100)
+	start := time.Unix(1600000020, 0)
+
+	store.BeginBlock(start)
+	store.AddTrade(1, 0, 1, big.NewInt(10), big.NewInt(20))
+	store.AddTrade(1, 1, 0, big.NewInt(50), big.NewInt(10))
+	if err := store.Commit(1); err != nil {
+		t.Fatal(err)
+	}
+
+	store.BeginBlock(start.Add(30 * time.Second))
+	store.AddTrade(1, 0, 1, big.NewInt(10), big.NewInt(30))
+	if err := store.Commit(2); err != nil {
+		t.Fatal(err)
+	}
+
+	store.BeginBlock(start.Add(time.Minute))
+	store.AddTrade(1, 0, 1, big.NewInt(10), big.NewInt(40))
+	store.AddTrade(2, 0, 1, big.NewInt(10), big.NewInt(40))
+	if err := store.Commit(3); err != nil {
+		t.Fatal(err)
+	}
+
+	minutes, err := store.Candles(1, Minute, 0, ^uint64(0), 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(minutes) != 2 {
+		t.Fatalf("%d minute candles", len(minutes))
+	}
+	first := minutes[0]
+	if first.Start != 1600000020 || first.Trades != 3 || first.FirstHeight != 1 || first.LastHeight != 2 {
+		t.Fatalf("invalid first candle %+v", first)
+	}
+	if first.Open.String() != "2000000000000000000" || first.Low.String() != "2000000000000000000" ||
+		first.High.String() != "5000000000000000000" || first.Close.String() != "3000000000000000000" {
+		t.Fatalf("invalid prices of first candle %s %s %s %s", first.Open, first.High, first.Low, first.Close)
+	}
+	if first.Volume0.String() != "30" || first.Volume1.String() != "100" {
+		t.Fatalf("invalid volumes of first candle %s %s", first.Volume0, first.Volume1)
+	}
+
+	hours, err := store.Candles(1, Hour, 1600000020, 1600000020, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(hours) != 1 || hours[0].Trades != 4 || hours[0].Close.String() != "4000000000000000000" {
+		t.Fatalf("invalid hour candles %+v", hours)
+	}
+
+	if err := store.DeleteFrom(2); err != nil {
+		t.Fatal(err)
+	}
+	minutes, err = store.Candles(1, Minute, 0, ^uint64(0), 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(minutes) != 1 || minutes[0].Trades != 2 || minutes[0].Close.String() != "5000000000000000000" {
+		t.Fatalf("invalid minute candles after delete %+v", minutes)
+	}
+	if candles, _ := store.Candles(2, Day, 0, ^uint64(0), 100); len(candles) != 0 {
+		t.Fatalf("candles of pool 2 should be deleted")
+	}
+}
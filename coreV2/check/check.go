@@ -38,6 +38,7 @@ type Check struct {
 	V        *big.Int
 	R        *big.Int
 	S        *big.Int
+	Account  []types.Address `rlp:"tail"` // the issuer whose authorised key signed the check since v250 update, at most one item
 }
 
 // Sender returns sender's address of a Check, recovered from signature
@@ -45,6 +46,15 @@ func (check *Check) Sender() (types.Address, error) {
 	return recoverPlain(check.Hash(), check.R, check.S, check.V)
 }
 
+// Issuer returns the address of the account which pays the check,
+// it is the sender unless the check is signed by the authorised key of the account
+func (check *Check) Issuer() (types.Address, error) {
+	if len(check.Account) != 0 {
+		return check.Account[0], nil
+	}
+	return check.Sender()
+}
+
 // LockPubKey returns bytes of public key, which is used for proving check's recipient rights
 func (check *Check) LockPubKey() ([]byte, error) {
 	sig := check.Lock.Bytes()
@@ -80,7 +90,7 @@ func (check *Check) HashWithoutLock() types.Hash {
 
 // Hash returns a types.Hash to be used in process of signing a Check by sender
 func (check *Check) Hash() types.Hash {
-	return rlpHash([]interface{}{
+	fields := []interface{}{
 		check.Nonce,
 		check.ChainID,
 		check.DueBlock,
@@ -88,7 +98,13 @@ func (check *Check) Hash() types.Hash {
 		check.Value,
 		check.GasCoin,
 		check.Lock,
-	})
+	}
+	// the issuer is signed along with the check, so the check is valid only for this account
+	if len(check.Account) != 0 {
+		fields = append(fields, check.Account)
+	}
+
+	return rlpHash(fields)
 }
 
 // Sign signs the check with given private key, returns error
@@ -104,6 +120,12 @@ func (check *Check) Sign(prv *ecdsa.PrivateKey) error {
 	return nil
 }
 
+// SignAccount signs the check of the account with the key authorised by SetAccountKey transaction
+func (check *Check) SignAccount(account types.Address, prv *ecdsa.PrivateKey) error {
+	check.Account = []types.Address{account}
+	return check.Sign(prv)
+}
+
 func (check *Check) setSignature(sig []byte) {
 	check.R = new(big.Int).SetBytes(sig[:32])
 	check.S = new(big.Int).SetBytes(sig[32:64])
@@ -111,7 +133,7 @@ func (check *Check) setSignature(sig []byte) {
 }
 
 func (check *Check) String() string {
-	sender, _ := check.Sender()
+	sender, _ := check.Issuer()
 
 	return fmt.Sprintf("Check sender: %s nonce: %x, dueBlock: %d, value: %s %s", sender.String(), check.Nonce,
 		check.DueBlock, check.Value.String(), check.Coin.String())
@@ -129,6 +151,10 @@ func DecodeFromBytes(buf []byte) (*Check, error) {
 		return nil, errors.New("incorrect tx signature")
 	}
 
+	if len(check.Account) > 1 {
+		return nil, errors.New("incorrect check issuer")
+	}
+
 	return &check, nil
 }
 
@@ -28,15 +28,17 @@ const (
 	VoteExpired                  uint32 = 120
 	VoteAlreadyExists            uint32 = 121
 	WrongUpdateVersionName       uint32 = 122
+	WrongSlotsCount              uint32 = 123
 
 	// coin creation
-	CoinHasNotReserve uint32 = 200
-	CoinAlreadyExists uint32 = 201
-	WrongCrr          uint32 = 202
-	InvalidCoinSymbol uint32 = 203
-	InvalidCoinName   uint32 = 204
-	WrongCoinSupply   uint32 = 205
-	WrongCoinEmission uint32 = 206
+	CoinHasNotReserve   uint32 = 200
+	CoinAlreadyExists   uint32 = 201
+	WrongCrr            uint32 = 202
+	InvalidCoinSymbol   uint32 = 203
+	InvalidCoinName     uint32 = 204
+	WrongCoinSupply     uint32 = 205
+	WrongCoinEmission   uint32 = 206
+	InvalidCoinMetadata uint32 = 207
 
 	// recreate coin
 	IsNotOwnerOfCoin uint32 = 206
@@ -61,6 +63,7 @@ const (
 	InsufficientWaitList  uint32 = 412
 	PeriodLimitReached    uint32 = 413
 	CandidateJailed       uint32 = 414
+	CandidateNotJailed    uint32 = 415
 
 	// check
 	CheckInvalidLock uint32 = 501
@@ -80,6 +83,8 @@ const (
 	DifferentCountAddressesAndWeights uint32 = 607
 	IncorrectTotalWeights             uint32 = 608
 	NotEnoughMultisigVotes            uint32 = 609
+	InvalidBLSKey                     uint32 = 610
+	BLSKeyNotRegistered               uint32 = 611
 
 	// swap pool
 	SwapPoolUnknown              uint32 = 700
@@ -93,11 +98,48 @@ const (
 	PairAlreadyExists            uint32 = 708
 	TooLongSwapRoute             uint32 = 709
 	DuplicatePoolInRoute         uint32 = 710
+	WrongSwapPoolFee             uint32 = 711
 
 	// emission coin
-	CoinIsNotToken  uint32 = 800
-	CoinNotMintable uint32 = 801
-	CoinNotBurnable uint32 = 802
+	CoinIsNotToken     uint32 = 800
+	CoinNotMintable    uint32 = 801
+	CoinNotBurnable    uint32 = 802
+	CoinNotControlled  uint32 = 803
+	AddressFrozen      uint32 = 804
+	AddressBlocklisted uint32 = 805
+
+	// allowance
+	InsufficientAllowance uint32 = 900
+
+	// airdrop
+	AirdropNotExists         uint32 = 1000
+	AirdropExpired           uint32 = 1001
+	AirdropNotExpired        uint32 = 1002
+	AirdropAlreadyClaimed    uint32 = 1003
+	InvalidAirdropProof      uint32 = 1004
+	IsNotCreatorOfAirdrop    uint32 = 1005
+	WrongAirdropExpireHeight uint32 = 1006
+
+	// account key
+	AccountKeyRotated     uint32 = 1100
+	SignerIsNotAuthorised uint32 = 1101
+	WrongAccountSigner    uint32 = 1102
+
+	// schedule
+	ScheduleNotExists        uint32 = 1200
+	IsNotOwnerOfSchedule     uint32 = 1201
+	WrongScheduleStartHeight uint32 = 1202
+	WrongScheduleCount       uint32 = 1203
+	WrongScheduleInterval    uint32 = 1204
+
+	// htlc
+	HTLCNotExists        uint32 = 1300
+	HTLCExpired          uint32 = 1301
+	HTLCNotExpired       uint32 = 1302
+	WrongHTLCPreimage    uint32 = 1303
+	IsNotRecipientOfHTLC uint32 = 1304
+	IsNotSenderOfHTLC    uint32 = 1305
+	WrongHTLCTimeout     uint32 = 1306
 )
 
 func NewInsufficientLiquidityBalance(liquidity, amount0, coin0, amount1, coin1, requestedLiquidity string) *insufficientLiquidityBalance {
@@ -215,6 +257,16 @@ func NewVoteExpired(block string, current string) *voteExpired {
 	return &voteExpired{Code: strconv.Itoa(int(VoteExpired)), Block: block, CurrentBlock: current}
 }
 
+type wrongSlotsCount struct {
+	Code       string `json:"code,omitempty"`
+	Validators string `json:"validators,omitempty"`
+	Candidates string `json:"candidates,omitempty"`
+}
+
+func NewWrongSlotsCount(validators string, candidates string) *wrongSlotsCount {
+	return &wrongSlotsCount{Code: strconv.Itoa(int(WrongSlotsCount)), Validators: validators, Candidates: candidates}
+}
+
 type commissionCoinNotSufficient struct {
 	Code   string `json:"code,omitempty"`
 	Pool   string `json:"pool,omitempty"`
@@ -257,6 +309,23 @@ func NewCoinIsNotMintable(coinSymbol string, coinId string) *coinIsNotMintableOr
 func NewCoinIsNotBurnable(coinSymbol string, coinId string) *coinIsNotMintableOrBurnable {
 	return &coinIsNotMintableOrBurnable{Code: strconv.Itoa(int(CoinNotBurnable)), CoinSymbol: coinSymbol, CoinId: coinId}
 }
+func NewCoinIsNotControlled(coinSymbol string, coinId string) *coinIsNotMintableOrBurnable {
+	return &coinIsNotMintableOrBurnable{Code: strconv.Itoa(int(CoinNotControlled)), CoinSymbol: coinSymbol, CoinId: coinId}
+}
+
+type addressRestricted struct {
+	Code       string `json:"code,omitempty"`
+	Address    string `json:"address,omitempty"`
+	CoinSymbol string `json:"coin_symbol,omitempty"`
+	CoinId     string `json:"coin_id,omitempty"`
+}
+
+func NewAddressFrozen(address string, coinSymbol string, coinId string) *addressRestricted {
+	return &addressRestricted{Code: strconv.Itoa(int(AddressFrozen)), Address: address, CoinSymbol: coinSymbol, CoinId: coinId}
+}
+func NewAddressBlocklisted(address string, coinSymbol string, coinId string) *addressRestricted {
+	return &addressRestricted{Code: strconv.Itoa(int(AddressBlocklisted)), Address: address, CoinSymbol: coinSymbol, CoinId: coinId}
+}
 
 type wrongGasCoin struct {
 	Code               string `json:"code,omitempty"`
@@ -320,6 +389,242 @@ func NewDecodeError() *decodeError {
 	return &decodeError{Code: strconv.Itoa(int(DecodeError))}
 }
 
+type insufficientAllowance struct {
+	Code        string `json:"code,omitempty"`
+	Owner       string `json:"owner,omitempty"`
+	Spender     string `json:"spender,omitempty"`
+	NeededValue string `json:"needed_value,omitempty"`
+	Allowance   string `json:"allowance,omitempty"`
+	CoinId      string `json:"coin_id,omitempty"`
+}
+
+func NewInsufficientAllowance(owner string, spender string, neededValue string, allowance string, coinId string) *insufficientAllowance {
+	return &insufficientAllowance{Code: strconv.Itoa(int(InsufficientAllowance)), Owner: owner, Spender: spender, NeededValue: neededValue, Allowance: allowance, CoinId: coinId}
+}
+
+type airdropNotExists struct {
+	Code      string `json:"code,omitempty"`
+	AirdropId string `json:"airdrop_id,omitempty"`
+}
+
+func NewAirdropNotExists(airdropId string) *airdropNotExists {
+	return &airdropNotExists{Code: strconv.Itoa(int(AirdropNotExists)), AirdropId: airdropId}
+}
+
+type airdropExpired struct {
+	Code         string `json:"code,omitempty"`
+	AirdropId    string `json:"airdrop_id,omitempty"`
+	ExpireHeight string `json:"expire_height,omitempty"`
+	BlockHeight  string `json:"block_height,omitempty"`
+}
+
+func NewAirdropExpired(airdropId string, expireHeight string, blockHeight string) *airdropExpired {
+	return &airdropExpired{Code: strconv.Itoa(int(AirdropExpired)), AirdropId: airdropId, ExpireHeight: expireHeight, BlockHeight: blockHeight}
+}
+
+type airdropNotExpired struct {
+	Code         string `json:"code,omitempty"`
+	AirdropId    string `json:"airdrop_id,omitempty"`
+	ExpireHeight string `json:"expire_height,omitempty"`
+	BlockHeight  string `json:"block_height,omitempty"`
+}
+
+func NewAirdropNotExpired(airdropId string, expireHeight string, blockHeight string) *airdropNotExpired {
+	return &airdropNotExpired{Code: strconv.Itoa(int(AirdropNotExpired)), AirdropId: airdropId, ExpireHeight: expireHeight, BlockHeight: blockHeight}
+}
+
+type airdropAlreadyClaimed struct {
+	Code      string `json:"code,omitempty"`
+	AirdropId string `json:"airdrop_id,omitempty"`
+	Index     string `json:"index,omitempty"`
+}
+
+func NewAirdropAlreadyClaimed(airdropId string, index string) *airdropAlreadyClaimed {
+	return &airdropAlreadyClaimed{Code: strconv.Itoa(int(AirdropAlreadyClaimed)), AirdropId: airdropId, Index: index}
+}
+
+type invalidAirdropProof struct {
+	Code      string `json:"code,omitempty"`
+	AirdropId string `json:"airdrop_id,omitempty"`
+	Index     string `json:"index,omitempty"`
+	Address   string `json:"address,omitempty"`
+	Value     string `json:"value,omitempty"`
+}
+
+func NewInvalidAirdropProof(airdropId string, index string, address string, value string) *invalidAirdropProof {
+	return &invalidAirdropProof{Code: strconv.Itoa(int(InvalidAirdropProof)), AirdropId: airdropId, Index: index, Address: address, Value: value}
+}
+
+type isNotCreatorOfAirdrop struct {
+	Code      string `json:"code,omitempty"`
+	AirdropId string `json:"airdrop_id,omitempty"`
+	Creator   string `json:"creator,omitempty"`
+}
+
+func NewIsNotCreatorOfAirdrop(airdropId string, creator string) *isNotCreatorOfAirdrop {
+	return &isNotCreatorOfAirdrop{Code: strconv.Itoa(int(IsNotCreatorOfAirdrop)), AirdropId: airdropId, Creator: creator}
+}
+
+type wrongAirdropExpireHeight struct {
+	Code         string `json:"code,omitempty"`
+	ExpireHeight string `json:"expire_height,omitempty"`
+	BlockHeight  string `json:"block_height,omitempty"`
+}
+
+func NewWrongAirdropExpireHeight(expireHeight string, blockHeight string) *wrongAirdropExpireHeight {
+	return &wrongAirdropExpireHeight{Code: strconv.Itoa(int(WrongAirdropExpireHeight)), ExpireHeight: expireHeight, BlockHeight: blockHeight}
+}
+
+type accountKeyRotated struct {
+	Code    string `json:"code,omitempty"`
+	Address string `json:"address,omitempty"`
+	Signer  string `json:"signer,omitempty"`
+}
+
+func NewAccountKeyRotated(address string, signer string) *accountKeyRotated {
+	return &accountKeyRotated{Code: strconv.Itoa(int(AccountKeyRotated)), Address: address, Signer: signer}
+}
+
+type signerIsNotAuthorised struct {
+	Code    string `json:"code,omitempty"`
+	Address string `json:"address,omitempty"`
+	Signer  string `json:"signer,omitempty"`
+}
+
+func NewSignerIsNotAuthorised(address string, signer string) *signerIsNotAuthorised {
+	return &signerIsNotAuthorised{Code: strconv.Itoa(int(SignerIsNotAuthorised)), Address: address, Signer: signer}
+}
+
+type wrongAccountSigner struct {
+	Code    string `json:"code,omitempty"`
+	Address string `json:"address,omitempty"`
+	Signer  string `json:"signer,omitempty"`
+}
+
+func NewWrongAccountSigner(address string, signer string) *wrongAccountSigner {
+	return &wrongAccountSigner{Code: strconv.Itoa(int(WrongAccountSigner)), Address: address, Signer: signer}
+}
+
+type scheduleNotExists struct {
+	Code       string `json:"code,omitempty"`
+	ScheduleId string `json:"schedule_id,omitempty"`
+}
+
+func NewScheduleNotExists(scheduleId string) *scheduleNotExists {
+	return &scheduleNotExists{Code: strconv.Itoa(int(ScheduleNotExists)), ScheduleId: scheduleId}
+}
+
+type isNotOwnerOfSchedule struct {
+	Code       string `json:"code,omitempty"`
+	ScheduleId string `json:"schedule_id,omitempty"`
+	Owner      string `json:"owner,omitempty"`
+}
+
+func NewIsNotOwnerOfSchedule(scheduleId string, owner string) *isNotOwnerOfSchedule {
+	return &isNotOwnerOfSchedule{Code: strconv.Itoa(int(IsNotOwnerOfSchedule)), ScheduleId: scheduleId, Owner: owner}
+}
+
+type wrongScheduleStartHeight struct {
+	Code        string `json:"code,omitempty"`
+	StartHeight string `json:"start_height,omitempty"`
+	BlockHeight string `json:"block_height,omitempty"`
+}
+
+func NewWrongScheduleStartHeight(startHeight string, blockHeight string) *wrongScheduleStartHeight {
+	return &wrongScheduleStartHeight{Code: strconv.Itoa(int(WrongScheduleStartHeight)), StartHeight: startHeight, BlockHeight: blockHeight}
+}
+
+type wrongScheduleCount struct {
+	Code     string `json:"code,omitempty"`
+	Count    string `json:"count,omitempty"`
+	MaxCount string `json:"max_count,omitempty"`
+}
+
+func NewWrongScheduleCount(count string, maxCount string) *wrongScheduleCount {
+	return &wrongScheduleCount{Code: strconv.Itoa(int(WrongScheduleCount)), Count: count, MaxCount: maxCount}
+}
+
+type wrongScheduleInterval struct {
+	Code     string `json:"code,omitempty"`
+	Interval string `json:"interval,omitempty"`
+	Count    string `json:"count,omitempty"`
+}
+
+func NewWrongScheduleInterval(interval string, count string) *wrongScheduleInterval {
+	return &wrongScheduleInterval{Code: strconv.Itoa(int(WrongScheduleInterval)), Interval: interval, Count: count}
+}
+
+type htlcNotExists struct {
+	Code   string `json:"code,omitempty"`
+	HTLCId string `json:"htlc_id,omitempty"`
+}
+
+func NewHTLCNotExists(htlcId string) *htlcNotExists {
+	return &htlcNotExists{Code: strconv.Itoa(int(HTLCNotExists)), HTLCId: htlcId}
+}
+
+type htlcExpired struct {
+	Code        string `json:"code,omitempty"`
+	HTLCId      string `json:"htlc_id,omitempty"`
+	Timeout     string `json:"timeout,omitempty"`
+	BlockHeight string `json:"block_height,omitempty"`
+}
+
+func NewHTLCExpired(htlcId string, timeout string, blockHeight string) *htlcExpired {
+	return &htlcExpired{Code: strconv.Itoa(int(HTLCExpired)), HTLCId: htlcId, Timeout: timeout, BlockHeight: blockHeight}
+}
+
+type htlcNotExpired struct {
+	Code        string `json:"code,omitempty"`
+	HTLCId      string `json:"htlc_id,omitempty"`
+	Timeout     string `json:"timeout,omitempty"`
+	BlockHeight string `json:"block_height,omitempty"`
+}
+
+func NewHTLCNotExpired(htlcId string, timeout string, blockHeight string) *htlcNotExpired {
+	return &htlcNotExpired{Code: strconv.Itoa(int(HTLCNotExpired)), HTLCId: htlcId, Timeout: timeout, BlockHeight: blockHeight}
+}
+
+type wrongHTLCPreimage struct {
+	Code     string `json:"code,omitempty"`
+	HTLCId   string `json:"htlc_id,omitempty"`
+	HashLock string `json:"hash_lock,omitempty"`
+}
+
+func NewWrongHTLCPreimage(htlcId string, hashLock string) *wrongHTLCPreimage {
+	return &wrongHTLCPreimage{Code: strconv.Itoa(int(WrongHTLCPreimage)), HTLCId: htlcId, HashLock: hashLock}
+}
+
+type isNotRecipientOfHTLC struct {
+	Code      string `json:"code,omitempty"`
+	HTLCId    string `json:"htlc_id,omitempty"`
+	Recipient string `json:"recipient,omitempty"`
+}
+
+func NewIsNotRecipientOfHTLC(htlcId string, recipient string) *isNotRecipientOfHTLC {
+	return &isNotRecipientOfHTLC{Code: strconv.Itoa(int(IsNotRecipientOfHTLC)), HTLCId: htlcId, Recipient: recipient}
+}
+
+type isNotSenderOfHTLC struct {
+	Code   string `json:"code,omitempty"`
+	HTLCId string `json:"htlc_id,omitempty"`
+	Sender string `json:"sender,omitempty"`
+}
+
+func NewIsNotSenderOfHTLC(htlcId string, sender string) *isNotSenderOfHTLC {
+	return &isNotSenderOfHTLC{Code: strconv.Itoa(int(IsNotSenderOfHTLC)), HTLCId: htlcId, Sender: sender}
+}
+
+type wrongHTLCTimeout struct {
+	Code        string `json:"code,omitempty"`
+	Timeout     string `json:"timeout,omitempty"`
+	BlockHeight string `json:"block_height,omitempty"`
+}
+
+func NewWrongHTLCTimeout(timeout string, blockHeight string) *wrongHTLCTimeout {
+	return &wrongHTLCTimeout{Code: strconv.Itoa(int(WrongHTLCTimeout)), Timeout: timeout, BlockHeight: blockHeight}
+}
+
 type insufficientFunds struct {
 	Code        string `json:"code,omitempty"`
 	Sender      string `json:"sender,omitempty"`
@@ -451,6 +756,17 @@ func NewInvalidCoinName(maxBytes string, gotBytes string) *invalidCoinName {
 	return &invalidCoinName{Code: strconv.Itoa(int(InvalidCoinName)), MaxBytes: maxBytes, GotBytes: gotBytes}
 }
 
+type invalidCoinMetadata struct {
+	Code     string `json:"code,omitempty"`
+	Field    string `json:"field,omitempty"`
+	MaxValue string `json:"max_value,omitempty"`
+	GotValue string `json:"got_value,omitempty"`
+}
+
+func NewInvalidCoinMetadata(field string, maxValue string, gotValue string) *invalidCoinMetadata {
+	return &invalidCoinMetadata{Code: strconv.Itoa(int(InvalidCoinMetadata)), Field: field, MaxValue: maxValue, GotValue: gotValue}
+}
+
 type tooHighGasPrice struct {
 	Code             string `json:"code,omitempty"`
 	MaxCheckGasPrice string `json:"max_check_gas_price,omitempty"`
@@ -692,6 +1008,24 @@ func NewNotEnoughMultisigVotes(neededVotes, gotVotes string) *notEnoughMultisigV
 	return &notEnoughMultisigVotes{Code: strconv.Itoa(int(NotEnoughMultisigVotes)), NeededVotes: neededVotes, GotVotes: gotVotes}
 }
 
+type invalidBLSKey struct {
+	Code      string `json:"code,omitempty"`
+	PublicKey string `json:"public_key,omitempty"`
+}
+
+func NewInvalidBLSKey(publicKey string) *invalidBLSKey {
+	return &invalidBLSKey{Code: strconv.Itoa(int(InvalidBLSKey)), PublicKey: publicKey}
+}
+
+type blsKeyNotRegistered struct {
+	Code    string `json:"code,omitempty"`
+	Address string `json:"address,omitempty"`
+}
+
+func NewBLSKeyNotRegistered(address string) *blsKeyNotRegistered {
+	return &blsKeyNotRegistered{Code: strconv.Itoa(int(BLSKeyNotRegistered)), Address: address}
+}
+
 type incorrectMultiSignature struct {
 	Code string `json:"code,omitempty"`
 }
@@ -783,6 +1117,25 @@ func NewPeriodLimitReached(next string, last string) *periodLimitReached {
 	return &periodLimitReached{Code: strconv.Itoa(int(PeriodLimitReached)), NextTime: next, PreviousTime: last}
 }
 
+type candidateJailed struct {
+	Code        string `json:"code,omitempty"`
+	PublicKey   string `json:"public_key,omitempty"`
+	JailedUntil string `json:"jailed_until,omitempty"`
+}
+
+func NewCandidateJailed(pubKey string, jailedUntil string) *candidateJailed {
+	return &candidateJailed{Code: strconv.Itoa(int(CandidateJailed)), PublicKey: pubKey, JailedUntil: jailedUntil}
+}
+
+type candidateNotJailed struct {
+	Code      string `json:"code,omitempty"`
+	PublicKey string `json:"public_key,omitempty"`
+}
+
+func NewCandidateNotJailed(pubKey string) *candidateNotJailed {
+	return &candidateNotJailed{Code: strconv.Itoa(int(CandidateNotJailed)), PublicKey: pubKey}
+}
+
 type multisigExists struct {
 	Code    string `json:"code,omitempty"`
 	Address string `json:"address,omitempty"`
@@ -839,3 +1192,13 @@ type duplicatePoolInRouteCode struct {
 func NewDuplicatePoolInRouteCode(pool uint32) *duplicatePoolInRouteCode {
 	return &duplicatePoolInRouteCode{Code: strconv.Itoa(int(DuplicatePoolInRoute)), PoolID: pool}
 }
+
+type wrongSwapPoolFee struct {
+	Code     string `json:"code,omitempty"`
+	Fee      string `json:"fee,omitempty"`
+	FeeTiers string `json:"fee_tiers,omitempty"`
+}
+
+func NewWrongSwapPoolFee(fee string, feeTiers string) *wrongSwapPoolFee {
+	return &wrongSwapPoolFee{Code: strconv.Itoa(int(WrongSwapPoolFee)), Fee: fee, FeeTiers: feeTiers}
+}
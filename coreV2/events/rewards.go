@@ -0,0 +1,50 @@
+package events
+
+import (
+	"math/big"
+
+	"github.com/MinterTeam/minter-go-node/coreV2/types"
+)
+
+// RewardsByRole is a sum of rewards grouped by role
+type RewardsByRole map[Role]*big.Int
+
+// Total returns a sum of rewards of all roles
+func (r RewardsByRole) Total() *big.Int {
+	total := big.NewInt(0)
+	for _, value := range r {
+		total.Add(total, value)
+	}
+	return total
+}
+
+// SumRewards adds rewards of given address from events to the result grouped by validator public key and role.
+// If pubKey is not nil, only rewards for this validator are counted.
+func SumRewards(result map[types.Pubkey]RewardsByRole, events Events, address types.Address, pubKey *types.Pubkey) {
+	for _, event := range events {
+		reward, ok := event.(*RewardEvent)
+		if !ok || reward.Address != address {
+			continue
+		}
+		if pubKey != nil && reward.ValidatorPubKey != *pubKey {
+			continue
+		}
+
+		amount, ok := big.NewInt(0).SetString(reward.Amount, 10)
+		if !ok {
+			continue
+		}
+
+		byRole, ok := result[reward.ValidatorPubKey]
+		if !ok {
+			byRole = RewardsByRole{}
+			result[reward.ValidatorPubKey] = byRole
+		}
+
+		role := NewRole(reward.Role)
+		if byRole[role] == nil {
+			byRole[role] = big.NewInt(0)
+		}
+		byRole[role].Add(byRole[role], amount)
+	}
+}
@@ -0,0 +1,40 @@
+package events
+
+import (
+	"testing"
+
+	"github.com/MinterTeam/minter-go-node/coreV2/types"
+)
+
+func TestSumRewards(t *testing.T) {
+	address := types.Address{1}
+	pubkey1, pubkey2 := types.Pubkey{1}, types.Pubkey{2}
+
+	events := Events{
+		&RewardEvent{Role: RoleDelegator.String(), Address: address, Amount: "100", ValidatorPubKey: pubkey1},
+		&RewardEvent{Role: RoleDelegator.String(), Address: address, Amount: "50", ValidatorPubKey: pubkey1},
+		&RewardEvent{Role: RoleValidator.String(), Address: address, Amount: "10", ValidatorPubKey: pubkey1},
+		&RewardEvent{Role: RoleDelegator.String(), Address: address, Amount: "7", ValidatorPubKey: pubkey2},
+		&RewardEvent{Role: RoleDelegator.String(), Address: types.Address{2}, Amount: "1000", ValidatorPubKey: pubkey1},
+		&SlashEvent{Address: address, Amount: "5", ValidatorPubKey: pubkey1},
+	}
+
+	result := map[types.Pubkey]RewardsByRole{}
+	SumRewards(result, events, address, nil)
+
+	if len(result) != 2 {
+		t.Fatalf("wrong candidates count %d", len(result))
+	}
+	if result[pubkey1][RoleDelegator].String() != "150" || result[pubkey1][RoleValidator].String() != "10" {
+		t.Fatalf("wrong rewards %v", result[pubkey1])
+	}
+	if result[pubkey1].Total().String() != "160" {
+		t.Fatalf("wrong total %s", result[pubkey1].Total())
+	}
+
+	result = map[types.Pubkey]RewardsByRole{}
+	SumRewards(result, events, address, &pubkey2)
+	if len(result) != 1 || result[pubkey2].Total().String() != "7" {
+		t.Fatalf("wrong rewards %v", result)
+	}
+}
@@ -17,10 +17,17 @@ func init() {
 	tmjson.RegisterType(&RewardEvent{}, TypeRewardEvent)
 	tmjson.RegisterType(&SlashEvent{}, TypeSlashEvent)
 	tmjson.RegisterType(&JailEvent{}, TypeJailEvent)
+	tmjson.RegisterType(&UnjailEvent{}, TypeUnjailEvent)
 	tmjson.RegisterType(&UnbondEvent{}, TypeUnbondEvent)
 	tmjson.RegisterType(&StakeKickEvent{}, TypeStakeKickEvent)
 	tmjson.RegisterType(&UpdateNetworkEvent{}, TypeUpdateNetworkEvent)
 	tmjson.RegisterType(&UpdateCommissionsEvent{}, TypeUpdateCommissionsEvent)
+	tmjson.RegisterType(&SwapEvent{}, TypeSwapEvent)
+	tmjson.RegisterType(&CreateSwapPoolEvent{}, TypeCreateSwapPoolEvent)
+	tmjson.RegisterType(&AddLiquidityEvent{}, TypeAddLiquidityEvent)
+	tmjson.RegisterType(&RemoveLiquidityEvent{}, TypeRemoveLiquidityEvent)
+	tmjson.RegisterType(&BancorTradeEvent{}, TypeBancorTradeEvent)
+	tmjson.RegisterType(&ScheduledSendEvent{}, TypeScheduledSendEvent)
 }
 
 // IEventsDB is an interface of Events
@@ -28,6 +35,7 @@ type IEventsDB interface {
 	AddEvent(event Event)
 	LoadEvents(height uint32) Events
 	CommitEvents(uint32) error
+	DeleteEventsFrom(height uint32) error
 	Close() error
 }
 
@@ -36,6 +44,7 @@ type MockEvents struct{}
 func (e MockEvents) AddEvent(event Event)            {}
 func (e MockEvents) LoadEvents(height uint32) Events { return nil }
 func (e MockEvents) CommitEvents(uint32) error       { return nil }
+func (e MockEvents) DeleteEventsFrom(uint32) error   { return nil }
 func (e MockEvents) Close() error                    { return nil }
 
 type eventsStore struct {
@@ -162,6 +171,38 @@ func (store *eventsStore) CommitEvents(height uint32) error {
 	return nil
 }
 
+// DeleteEventsFrom deletes events of blocks starting with given height
+func (store *eventsStore) DeleteEventsFrom(height uint32) error {
+	store.Lock()
+	defer store.Unlock()
+
+	iterator, err := store.db.Iterator(uint32ToBytes(height), nil)
+	if err != nil {
+		return err
+	}
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		// skip pubKey and address keys, only heights have 4 bytes
+		if len(iterator.Key()) != 4 {
+			continue
+		}
+		keys = append(keys, iterator.Key())
+	}
+	if err := iterator.Close(); err != nil {
+		return err
+	}
+
+	batch := store.db.NewBatch()
+	defer batch.Close()
+	for _, key := range keys {
+		if err := batch.Delete(key); err != nil {
+			return err
+		}
+	}
+
+	return batch.WriteSync()
+}
+
 func (store *eventsStore) loadCache() {
 	store.Lock()
 	if len(store.idPubKey) == 0 {
@@ -259,3 +259,38 @@ func TestIEventsNil(t *testing.T) {
 		t.Fatalf("not nil")
 	}
 }
+
+func TestIEventsDB_swap(t *testing.T) {
+	store := NewEventsStore(db.NewMemDB())
+
+	address := types.HexToAddress("Mx04bea23efb744dc93b4fda4c20bf4a21c6e195f1")
+	store.AddEvent(&CreateSwapPoolEvent{Address: address, PoolID: 1, Fee: 20, Coin0: 1, Coin1: 0, Volume0: "100", Volume1: "200", Liquidity: "141", Reserve0: "100", Reserve1: "200"})
+	store.AddEvent(&SwapEvent{PoolID: 1, CoinIn: 0, ValueIn: "10", CoinOut: 1, ValueOut: "4", ReserveIn: "210", ReserveOut: "96"})
+	store.AddEvent(&RemoveLiquidityEvent{Address: address, PoolID: 1, Fee: 20, Coin0: 0, Coin1: 1, Volume0: "21", Volume1: "9", Liquidity: "14", Reserve0: "189", Reserve1: "87"})
+	store.AddEvent(&BancorTradeEvent{Address: address, Coin: 2, Sell: true, Value: "5", ValueBase: "1", Volume: "95", Reserve: "19"})
+	if err := store.CommitEvents(12); err != nil {
+		t.Fatal(err)
+	}
+
+	loadEvents := store.LoadEvents(12)
+	if len(loadEvents) != 4 {
+		t.Fatalf("count of events not equal 4, got %d", len(loadEvents))
+	}
+
+	create, ok := loadEvents[0].(*CreateSwapPoolEvent)
+	if !ok || create.Address != address || create.Fee != 20 || create.Reserve1 != "200" {
+		t.Fatalf("invalid create event %#v", loadEvents[0])
+	}
+	swap, ok := loadEvents[1].(*SwapEvent)
+	if !ok || swap.PoolID != 1 || swap.ValueOut != "4" || swap.ReserveIn != "210" {
+		t.Fatalf("invalid swap event %#v", loadEvents[1])
+	}
+	remove, ok := loadEvents[2].(*RemoveLiquidityEvent)
+	if !ok || remove.Liquidity != "14" || remove.Reserve0 != "189" {
+		t.Fatalf("invalid remove event %#v", loadEvents[2])
+	}
+	trade, ok := loadEvents[3].(*BancorTradeEvent)
+	if !ok || !trade.Sell || trade.Coin != 2 || trade.Reserve != "19" {
+		t.Fatalf("invalid bancor event %#v", loadEvents[3])
+	}
+}
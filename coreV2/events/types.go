@@ -11,10 +11,17 @@ const (
 	TypeRewardEvent            = "minter/RewardEvent"
 	TypeSlashEvent             = "minter/SlashEvent"
 	TypeJailEvent              = "minter/JailEvent"
+	TypeUnjailEvent            = "minter/UnjailEvent"
 	TypeUnbondEvent            = "minter/UnbondEvent"
 	TypeStakeKickEvent         = "minter/StakeKickEvent"
 	TypeUpdateNetworkEvent     = "minter/UpdateNetworkEvent"
 	TypeUpdateCommissionsEvent = "minter/UpdateCommissionsEvent"
+	TypeSwapEvent              = "minter/SwapEvent"
+	TypeCreateSwapPoolEvent    = "minter/CreateSwapPoolEvent"
+	TypeAddLiquidityEvent      = "minter/AddLiquidityEvent"
+	TypeRemoveLiquidityEvent   = "minter/RemoveLiquidityEvent"
+	TypeBancorTradeEvent       = "minter/BancorTradeEvent"
+	TypeScheduledSendEvent     = "minter/ScheduledSendEvent"
 )
 
 type Stake interface {
@@ -242,6 +249,14 @@ func (je *JailEvent) convert(pubKeyID uint16) compact {
 	return result
 }
 
+type UnjailEvent struct {
+	ValidatorPubKey types.Pubkey `json:"validator_pub_key"`
+}
+
+func (ue *UnjailEvent) Type() string {
+	return TypeUnjailEvent
+}
+
 type unbond struct {
 	AddressID uint32
 	Amount    []byte
@@ -424,3 +439,110 @@ type UpdateNetworkEvent struct {
 func (un *UpdateNetworkEvent) Type() string {
 	return TypeUpdateNetworkEvent
 }
+
+// SwapEvent is a swap in a pool, including swaps of commissions paid in coins without reserve.
+// ReserveIn and ReserveOut are reserves of the pool after the swap.
+type SwapEvent struct {
+	PoolID     uint32 `json:"pool_id"`
+	CoinIn     uint64 `json:"coin_in"`
+	ValueIn    string `json:"value_in"`
+	CoinOut    uint64 `json:"coin_out"`
+	ValueOut   string `json:"value_out"`
+	ReserveIn  string `json:"reserve_in"`
+	ReserveOut string `json:"reserve_out"`
+}
+
+func (se *SwapEvent) Type() string {
+	return TypeSwapEvent
+}
+
+// CreateSwapPoolEvent is a creation of a pool with fee tier Fee by a provider.
+// Volume0 and Volume1 are initial reserves of the pool, Liquidity is the amount of pool tokens received by the provider.
+type CreateSwapPoolEvent struct {
+	Address   types.Address `json:"address"`
+	PoolID    uint32        `json:"pool_id"`
+	Fee       uint32        `json:"fee"`
+	Coin0     uint64        `json:"coin0"`
+	Coin1     uint64        `json:"coin1"`
+	Volume0   string        `json:"volume0"`
+	Volume1   string        `json:"volume1"`
+	Liquidity string        `json:"liquidity"`
+	Reserve0  string        `json:"reserve0"`
+	Reserve1  string        `json:"reserve1"`
+}
+
+func (ce *CreateSwapPoolEvent) Type() string {
+	return TypeCreateSwapPoolEvent
+}
+
+// AddLiquidityEvent is an addition of liquidity to a pool by a provider.
+// Volume0 and Volume1 are amounts of coins added to the pool, Reserve0 and Reserve1 are reserves after the change.
+type AddLiquidityEvent struct {
+	Address   types.Address `json:"address"`
+	PoolID    uint32        `json:"pool_id"`
+	Fee       uint32        `json:"fee"`
+	Coin0     uint64        `json:"coin0"`
+	Coin1     uint64        `json:"coin1"`
+	Volume0   string        `json:"volume0"`
+	Volume1   string        `json:"volume1"`
+	Liquidity string        `json:"liquidity"`
+	Reserve0  string        `json:"reserve0"`
+	Reserve1  string        `json:"reserve1"`
+}
+
+func (ae *AddLiquidityEvent) Type() string {
+	return TypeAddLiquidityEvent
+}
+
+// RemoveLiquidityEvent is a removal of liquidity from a pool by a provider.
+// Volume0 and Volume1 are amounts of coins returned to the provider, Reserve0 and Reserve1 are reserves after the change.
+type RemoveLiquidityEvent struct {
+	Address   types.Address `json:"address"`
+	PoolID    uint32        `json:"pool_id"`
+	Fee       uint32        `json:"fee"`
+	Coin0     uint64        `json:"coin0"`
+	Coin1     uint64        `json:"coin1"`
+	Volume0   string        `json:"volume0"`
+	Volume1   string        `json:"volume1"`
+	Liquidity string        `json:"liquidity"`
+	Reserve0  string        `json:"reserve0"`
+	Reserve1  string        `json:"reserve1"`
+}
+
+func (re *RemoveLiquidityEvent) Type() string {
+	return TypeRemoveLiquidityEvent
+}
+
+// BancorTradeEvent is a buy or a sell of a coin with reserve by the bancor formula.
+// Value is an amount of the coin, ValueBase is an amount of the base coin,
+// Volume and Reserve are the supply and the reserve of the coin after the trade.
+type BancorTradeEvent struct {
+	Address   types.Address `json:"address"`
+	Coin      uint64        `json:"coin"`
+	Sell      bool          `json:"sell"`
+	Value     string        `json:"value"`
+	ValueBase string        `json:"value_base"`
+	Volume    string        `json:"volume"`
+	Reserve   string        `json:"reserve"`
+}
+
+func (be *BancorTradeEvent) Type() string {
+	return TypeBancorTradeEvent
+}
+
+// ScheduledSendEvent is an execution of a send of the schedule created by CreateSchedule transaction.
+// Remaining is a number of sends of the schedule left after this one. Refunded is true if the value
+// is returned to the owner because the owner is frozen or the recipient is blocklisted in the controlled coin.
+type ScheduledSendEvent struct {
+	ScheduleID uint32        `json:"schedule_id"`
+	Owner      types.Address `json:"owner"`
+	Recipient  types.Address `json:"recipient"`
+	Coin       uint64        `json:"coin"`
+	Value      string        `json:"value"`
+	Remaining  uint32        `json:"remaining"`
+	Refunded   bool          `json:"refunded"`
+}
+
+func (se *ScheduledSendEvent) Type() string {
+	return TypeScheduledSendEvent
+}
@@ -0,0 +1,361 @@
+package lpstats
+
+import (
+	"encoding/binary"
+	"math/big"
+	"sync"
+
+	"github.com/MinterTeam/minter-go-node/coreV2/types"
+	"github.com/MinterTeam/minter-go-node/rlp"
+	db "github.com/tendermint/tm-db"
+)
+
+// GrowthPrecision is a multiplier of fee growth per unit of liquidity
+var GrowthPrecision = big.NewInt(1e18)
+
+const (
+	poolPrefix     = 'p'
+	providerPrefix = 'l'
+)
+
+// EntryType is a type of a change of provider liquidity
+type EntryType uint8
+
+// Types of provider entries
+const (
+	EntryAdd EntryType = iota + 1
+	EntryRemove
+)
+
+func (t EntryType) String() string {
+	switch t {
+	case EntryAdd:
+		return "add"
+	case EntryRemove:
+		return "remove"
+	}
+	return "unknown"
+}
+
+// ILPStats is an interface of Store
+type ILPStats interface {
+	AddSwapFee(poolID uint32, coinIn, coinOut types.CoinID, amount, totalLiquidity *big.Int)
+	AddLiquidity(address types.Address, poolID uint32, coin0, coin1 types.CoinID, liquidity, amount0, amount1 *big.Int)
+	RemoveLiquidity(address types.Address, poolID uint32, coin0, coin1 types.CoinID, liquidity, amount0, amount1 *big.Int)
+	Commit(height uint64) error
+	DeleteFrom(height uint64) error
+	PoolFees(poolID uint32, height uint64) (*PoolFees, error)
+	History(address types.Address, height uint64) ([]*Entry, error)
+}
+
+// PoolFees is a sum of fees collected by the pool since the node started to track them
+type PoolFees struct {
+	Coin0   types.CoinID
+	Coin1   types.CoinID
+	Amount0 *big.Int
+	Amount1 *big.Int
+	// Growth0 and Growth1 are sums of fees per unit of liquidity multiplied by GrowthPrecision
+	Growth0 *big.Int
+	Growth1 *big.Int
+}
+
+func newPoolFees(coin0, coin1 types.CoinID) *PoolFees {
+	if coin0 > coin1 {
+		coin0, coin1 = coin1, coin0
+	}
+	return &PoolFees{
+		Coin0:   coin0,
+		Coin1:   coin1,
+		Amount0: big.NewInt(0),
+		Amount1: big.NewInt(0),
+		Growth0: big.NewInt(0),
+		Growth1: big.NewInt(0),
+	}
+}
+
+// Entry is a change of liquidity of a provider in a pool.
+// Coins and amounts are sorted by coin ID, Growth0 and Growth1 are fee growth of the pool at the moment of the change.
+type Entry struct {
+	Height    uint64
+	PoolID    uint32
+	Type      EntryType
+	Coin0     types.CoinID
+	Coin1     types.CoinID
+	Liquidity *big.Int
+	Amount0   *big.Int
+	Amount1   *big.Int
+	Growth0   *big.Int
+	Growth1   *big.Int
+}
+
+type pendingEntry struct {
+	address types.Address
+	entry   *Entry
+}
+
+// Store tracks fees collected by swap pools and liquidity history of providers.
+// Changes are kept in memory until Commit. History starts at the height the node started to track it,
+// earlier blocks are not indexed.
+type Store struct {
+	lock    sync.RWMutex
+	db      db.DB
+	pools   map[uint32]*PoolFees
+	dirty   map[uint32]struct{}
+	pending []*pendingEntry
+}
+
+// NewStore creates new Store in given DB
+func NewStore(db db.DB) *Store {
+	return &Store{
+		db:    db,
+		pools: map[uint32]*PoolFees{},
+		dirty: map[uint32]struct{}{},
+	}
+}
+
+// AddSwapFee adds a fee paid in coinIn by a swap in the pool with totalLiquidity of pool tokens
+func (s *Store) AddSwapFee(poolID uint32, coinIn, coinOut types.CoinID, amount, totalLiquidity *big.Int) {
+	if amount.Sign() != 1 {
+		return
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	pool := s.pool(poolID, coinIn, coinOut)
+	growth := big.NewInt(0)
+	if totalLiquidity.Sign() == 1 {
+		growth.Mul(amount, GrowthPrecision).Quo(growth, totalLiquidity)
+	}
+	if coinIn == pool.Coin0 {
+		pool.Amount0.Add(pool.Amount0, amount)
+		pool.Growth0.Add(pool.Growth0, growth)
+	} else {
+		pool.Amount1.Add(pool.Amount1, amount)
+		pool.Growth1.Add(pool.Growth1, growth)
+	}
+	s.dirty[poolID] = struct{}{}
+}
+
+// AddLiquidity records liquidity received by the provider for amounts of coins
+func (s *Store) AddLiquidity(address types.Address, poolID uint32, coin0, coin1 types.CoinID, liquidity, amount0, amount1 *big.Int) {
+	s.addEntry(address, poolID, EntryAdd, coin0, coin1, liquidity, amount0, amount1)
+}
+
+// RemoveLiquidity records liquidity burned by the provider for amounts of coins
+func (s *Store) RemoveLiquidity(address types.Address, poolID uint32, coin0, coin1 types.CoinID, liquidity, amount0, amount1 *big.Int) {
+	s.addEntry(address, poolID, EntryRemove, coin0, coin1, liquidity, amount0, amount1)
+}
+
+func (s *Store) addEntry(address types.Address, poolID uint32, entryType EntryType, coin0, coin1 types.CoinID, liquidity, amount0, amount1 *big.Int) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if coin0 > coin1 {
+		coin0, coin1 = coin1, coin0
+		amount0, amount1 = amount1, amount0
+	}
+
+	pool := s.pool(poolID, coin0, coin1)
+	s.pending = append(s.pending, &pendingEntry{
+		address: address,
+		entry: &Entry{
+			PoolID:    poolID,
+			Type:      entryType,
+			Coin0:     coin0,
+			Coin1:     coin1,
+			Liquidity: new(big.Int).Set(liquidity),
+			Amount0:   new(big.Int).Set(amount0),
+			Amount1:   new(big.Int).Set(amount1),
+			Growth0:   new(big.Int).Set(pool.Growth0),
+			Growth1:   new(big.Int).Set(pool.Growth1),
+		},
+	})
+}
+
+// pool returns the latest fees of the pool, should be called under lock
+func (s *Store) pool(poolID uint32, coin0, coin1 types.CoinID) *PoolFees {
+	if pool, ok := s.pools[poolID]; ok {
+		return pool
+	}
+
+	pool, err := s.loadPoolFees(poolID, ^uint64(0))
+	if err != nil {
+		panic(err)
+	}
+	if pool == nil {
+		pool = newPoolFees(coin0, coin1)
+	}
+	s.pools[poolID] = pool
+	return pool
+}
+
+// Commit saves fees of changed pools and new provider entries at given height
+func (s *Store) Commit(height uint64) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if len(s.dirty) == 0 && len(s.pending) == 0 {
+		return nil
+	}
+
+	batch := s.db.NewBatch()
+	defer batch.Close()
+
+	for poolID := range s.dirty {
+		bytes, err := rlp.EncodeToBytes(s.pools[poolID])
+		if err != nil {
+			return err
+		}
+		if err := batch.Set(poolKey(poolID, height), bytes); err != nil {
+			return err
+		}
+	}
+
+	for i, item := range s.pending {
+		item.entry.Height = height
+		bytes, err := rlp.EncodeToBytes(item.entry)
+		if err != nil {
+			return err
+		}
+		if err := batch.Set(providerKey(item.address, height, uint32(i)), bytes); err != nil {
+			return err
+		}
+	}
+
+	if err := batch.Write(); err != nil {
+		return err
+	}
+
+	s.dirty = map[uint32]struct{}{}
+	s.pending = nil
+	return nil
+}
+
+// DeleteFrom deletes data of blocks starting with given height
+func (s *Store) DeleteFrom(height uint64) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	iterator, err := s.db.Iterator(nil, nil)
+	if err != nil {
+		return err
+	}
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		if keyHeight(key) >= height {
+			keys = append(keys, key)
+		}
+	}
+	if err := iterator.Close(); err != nil {
+		return err
+	}
+
+	batch := s.db.NewBatch()
+	defer batch.Close()
+	for _, key := range keys {
+		if err := batch.Delete(key); err != nil {
+			return err
+		}
+	}
+	if err := batch.WriteSync(); err != nil {
+		return err
+	}
+
+	s.pools = map[uint32]*PoolFees{}
+	s.dirty = map[uint32]struct{}{}
+	s.pending = nil
+	return nil
+}
+
+// PoolFees returns fees of the pool at given height or nil if there were no swaps in the pool
+func (s *Store) PoolFees(poolID uint32, height uint64) (*PoolFees, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return s.loadPoolFees(poolID, height)
+}
+
+func (s *Store) loadPoolFees(poolID uint32, height uint64) (*PoolFees, error) {
+	end := poolKey(poolID+1, 0)
+	if height != ^uint64(0) {
+		end = poolKey(poolID, height+1)
+	}
+	iterator, err := s.db.ReverseIterator(poolKey(poolID, 0), end)
+	if err != nil {
+		return nil, err
+	}
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return nil, nil
+	}
+
+	pool := new(PoolFees)
+	if err := rlp.DecodeBytes(iterator.Value(), pool); err != nil {
+		return nil, err
+	}
+	return pool, nil
+}
+
+// History returns liquidity entries of the provider in all pools up to given height.
+// Only adding and removing of liquidity is recorded, transfers of pool tokens are not.
+func (s *Store) History(address types.Address, height uint64) ([]*Entry, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	start := providerKey(address, 0, 0)
+	end := providerKey(address, height+1, 0)
+	if height == ^uint64(0) {
+		end = nil
+	}
+	iterator, err := s.db.Iterator(start, end)
+	if err != nil {
+		return nil, err
+	}
+	defer iterator.Close()
+
+	var entries []*Entry
+	for ; iterator.Valid(); iterator.Next() {
+		if len(iterator.Key()) != len(start) || string(iterator.Key()[1:21]) != string(address[:]) {
+			break
+		}
+		entry := new(Entry)
+		if err := rlp.DecodeBytes(iterator.Value(), entry); err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+// poolKey is 'p' + pool ID + height
+func poolKey(poolID uint32, height uint64) []byte {
+	key := make([]byte, 13)
+	key[0] = poolPrefix
+	binary.BigEndian.PutUint32(key[1:5], poolID)
+	binary.BigEndian.PutUint64(key[5:13], height)
+	return key
+}
+
+// providerKey is 'l' + address + height + index of the entry in the block
+func providerKey(address types.Address, height uint64, index uint32) []byte {
+	key := make([]byte, 33)
+	key[0] = providerPrefix
+	copy(key[1:21], address[:])
+	binary.BigEndian.PutUint64(key[21:29], height)
+	binary.BigEndian.PutUint32(key[29:33], index)
+	return key
+}
+
+func keyHeight(key []byte) uint64 {
+	switch {
+	case len(key) == 13 && key[0] == poolPrefix:
+		return binary.BigEndian.Uint64(key[5:13])
+	case len(key) == 33 && key[0] == providerPrefix:
+		return binary.BigEndian.Uint64(key[21:29])
+	}
+	return 0
+}
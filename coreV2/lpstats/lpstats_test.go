@@ -0,0 +1,102 @@
+package lpstats
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/MinterTeam/minter-go-node/coreV2/types"
+	db "github.com/tendermint/tm-db"
+)
+
+func TestStore(t *testing.T) {
+	store := NewStore(db.NewMemDB())
+	provider := types.HexToAddress("Mx04bea23efb744dc93b4fda4c20bf4a21c6e195f1")
+
+	store.AddLiquidity(provider, 1, 1, 0, big.NewInt(100), big.NewInt(60), big.NewInt(50))
+	if err := store.Commit(1); err != nil {
+		t.Fatal(err)
+	}
+
+	store.AddSwapFee(1, 0, 1, big.NewInt(10), big.NewInt(1000))
+	if err := store.Commit(2); err != nil {
+		t.Fatal(err)
+	}
+
+	store.RemoveLiquidity(provider, 1, 0, 1, big.NewInt(50), big.NewInt(30), big.NewInt(25))
+	if err := store.Commit(3); err != nil {
+		t.Fatal(err)
+	}
+
+	store.AddSwapFee(1, 1, 0, big.NewInt(20), big.NewInt(500))
+	if err := store.Commit(4); err != nil {
+		t.Fatal(err)
+	}
+
+	if fees, err := store.PoolFees(1, 1); err != nil || fees != nil {
+		t.Fatalf("fees at height 1 should be empty: %v, %v", fees, err)
+	}
+	fees, err := store.PoolFees(1, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fees.Amount0.String() != "10" || fees.Amount1.String() != "0" {
+		t.Fatalf("fees at height 3 are %s and %s", fees.Amount0, fees.Amount1)
+	}
+
+	entries, err := store.History(provider, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("history has %d entries", len(entries))
+	}
+	if entries[0].Coin0 != 0 || entries[0].Amount0.String() != "50" || entries[0].Amount1.String() != "60" {
+		t.Fatalf("coins of entry should be sorted, got %d %s %s", entries[0].Coin0, entries[0].Amount0, entries[0].Amount1)
+	}
+
+	fees, err = store.PoolFees(1, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	positions := Positions(entries)
+	if len(positions) != 1 {
+		t.Fatalf("%d positions", len(positions))
+	}
+	position := positions[0]
+	position.Earn(fees)
+	if position.Liquidity.String() != "50" {
+		t.Errorf("liquidity is %s", position.Liquidity)
+	}
+	if position.CostBasis0.String() != "25" || position.CostBasis1.String() != "30" {
+		t.Errorf("cost basis is %s and %s", position.CostBasis0, position.CostBasis1)
+	}
+	if position.Earned0.String() != "1" || position.Earned1.String() != "2" {
+		t.Errorf("earned fees are %s and %s", position.Earned0, position.Earned1)
+	}
+
+	if err := store.DeleteFrom(3); err != nil {
+		t.Fatal(err)
+	}
+	entries, err = store.History(provider, ^uint64(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 {
+		t.Fatalf("history has %d entries after delete", len(entries))
+	}
+	fees, err = store.PoolFees(1, ^uint64(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fees.Amount1.Sign() != 0 {
+		t.Fatalf("fees after delete are %s", fees.Amount1)
+	}
+
+	store.AddSwapFee(1, 0, 1, big.NewInt(10), big.NewInt(1000))
+	if err := store.Commit(3); err != nil {
+		t.Fatal(err)
+	}
+	if fees, _ = store.PoolFees(1, 3); fees.Amount0.String() != "20" {
+		t.Fatalf("fees after delete and new swap are %s", fees.Amount0)
+	}
+}
@@ -0,0 +1,105 @@
+package lpstats
+
+import (
+	"math/big"
+	"sort"
+
+	"github.com/MinterTeam/minter-go-node/coreV2/types"
+)
+
+// Position is a liquidity of a provider in a pool calculated from its history.
+// Cost basis is the amounts of coins added to the pool reduced proportionally to removed liquidity.
+// Pool tokens sent or received by Send, Multisend or other transfers are not followed,
+// so Liquidity may differ from the balance of pool tokens of the provider.
+type Position struct {
+	PoolID     uint32
+	Coin0      types.CoinID
+	Coin1      types.CoinID
+	Liquidity  *big.Int
+	CostBasis0 *big.Int
+	CostBasis1 *big.Int
+	Earned0    *big.Int
+	Earned1    *big.Int
+	History    []*Entry
+}
+
+// Positions groups entries of the provider by pools sorted by pool ID
+func Positions(entries []*Entry) []*Position {
+	byPool := map[uint32]*Position{}
+	var positions []*Position
+	for _, entry := range entries {
+		position, ok := byPool[entry.PoolID]
+		if !ok {
+			position = &Position{
+				PoolID:     entry.PoolID,
+				Coin0:      entry.Coin0,
+				Coin1:      entry.Coin1,
+				Liquidity:  big.NewInt(0),
+				CostBasis0: big.NewInt(0),
+				CostBasis1: big.NewInt(0),
+				Earned0:    big.NewInt(0),
+				Earned1:    big.NewInt(0),
+			}
+			byPool[entry.PoolID] = position
+			positions = append(positions, position)
+		}
+		position.apply(entry)
+	}
+
+	sort.Slice(positions, func(i, j int) bool {
+		return positions[i].PoolID < positions[j].PoolID
+	})
+	return positions
+}
+
+// apply changes the position by the entry, removing more than the tracked liquidity
+// (e.g. pool tokens received by a transfer) only closes the tracked part
+func (p *Position) apply(entry *Entry) {
+	p.earn(entry.Growth0, entry.Growth1)
+	p.History = append(p.History, entry)
+
+	switch entry.Type {
+	case EntryAdd:
+		p.Liquidity.Add(p.Liquidity, entry.Liquidity)
+		p.CostBasis0.Add(p.CostBasis0, entry.Amount0)
+		p.CostBasis1.Add(p.CostBasis1, entry.Amount1)
+	case EntryRemove:
+		if p.Liquidity.Sign() != 1 {
+			return
+		}
+		liquidity := entry.Liquidity
+		if liquidity.Cmp(p.Liquidity) == 1 {
+			liquidity = p.Liquidity
+		}
+		p.CostBasis0.Sub(p.CostBasis0, new(big.Int).Quo(new(big.Int).Mul(p.CostBasis0, liquidity), p.Liquidity))
+		p.CostBasis1.Sub(p.CostBasis1, new(big.Int).Quo(new(big.Int).Mul(p.CostBasis1, liquidity), p.Liquidity))
+		p.Liquidity.Sub(p.Liquidity, liquidity)
+	}
+}
+
+// Earn adds fees earned by the position since the last entry till the moment the pool had given fees,
+// it should be called once after all entries are applied
+func (p *Position) Earn(fees *PoolFees) {
+	if fees == nil {
+		return
+	}
+	p.earn(fees.Growth0, fees.Growth1)
+}
+
+func (p *Position) earn(growth0, growth1 *big.Int) {
+	if len(p.History) == 0 {
+		return
+	}
+	last := p.History[len(p.History)-1]
+	p.Earned0.Add(p.Earned0, earned(p.Liquidity, last.Growth0, growth0))
+	p.Earned1.Add(p.Earned1, earned(p.Liquidity, last.Growth1, growth1))
+}
+
+func earned(liquidity, from, to *big.Int) *big.Int {
+	result := new(big.Int).Sub(to, from)
+	if result.Sign() != 1 {
+		return big.NewInt(0)
+	}
+	result.Mul(result, liquidity)
+	return result.Quo(result, GrowthPrecision)
+}
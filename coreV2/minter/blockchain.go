@@ -2,9 +2,11 @@ package minter
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"math/big"
 	"os"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -12,7 +14,10 @@ import (
 	"github.com/MinterTeam/minter-go-node/cmd/utils"
 	"github.com/MinterTeam/minter-go-node/config"
 	"github.com/MinterTeam/minter-go-node/coreV2/appdb"
+	"github.com/MinterTeam/minter-go-node/coreV2/banlist"
+	"github.com/MinterTeam/minter-go-node/coreV2/candles"
 	eventsdb "github.com/MinterTeam/minter-go-node/coreV2/events"
+	"github.com/MinterTeam/minter-go-node/coreV2/lpstats"
 	"github.com/MinterTeam/minter-go-node/coreV2/rewards"
 	"github.com/MinterTeam/minter-go-node/coreV2/state"
 	"github.com/MinterTeam/minter-go-node/coreV2/state/candidates"
@@ -42,6 +47,14 @@ const (
 
 const votingPowerConsensus = 2. / 3.
 
+// Peer filter queries, see filter_peers option
+const (
+	filterPeerAddrPath = "/p2p/filter/addr/"
+	filterPeerIDPath   = "/p2p/filter/id/"
+
+	codePeerBanned uint32 = 1
+)
+
 // Blockchain is a main structure of Minter
 type Blockchain struct {
 	abciTypes.BaseApplication
@@ -51,6 +64,8 @@ type Blockchain struct {
 
 	appDB                           *appdb.AppDB
 	eventsDB                        eventsdb.IEventsDB
+	lpStats                         *lpstats.Store
+	candles                         *candles.Store
 	stateDeliver                    *state.State
 	stateCheck                      *state.CheckState
 	height                          uint64   // current Blockchain height
@@ -65,6 +80,9 @@ type Blockchain struct {
 
 	tmNode *tmNode.Node
 
+	// banList contains peers rejected by p2p filters
+	banList *banlist.BanList
+
 	// currentMempool is responsive for prevent sending multiple transactions from one address in one block
 	currentMempool *sync.Map
 
@@ -87,8 +105,14 @@ func NewMinterBlockchain(storages *utils.Storage, cfg *config.Config, ctx contex
 		ctx = context.Background()
 	}
 	var eventsDB eventsdb.IEventsDB
+	var lpStats *lpstats.Store
+	var swapCandles *candles.Store
 	if !cfg.ValidatorMode {
 		eventsDB = eventsdb.NewEventsStore(storages.EventDB())
+		lpStats = lpstats.NewStore(storages.LPStatsDB())
+		if cfg.SwapCandles {
+			swapCandles = candles.NewStore(storages.CandlesDB(), uint64(cfg.KeepLastStates))
+		}
 	} else {
 		eventsDB = &eventsdb.MockEvents{}
 	}
@@ -96,11 +120,18 @@ func NewMinterBlockchain(storages *utils.Storage, cfg *config.Config, ctx contex
 	if period == 0 {
 		period = updateStakesAndPayRewards
 	}
+	banList, err := banlist.NewBanList(storages.GetMinterHome() + "/config/banlist.json")
+	if err != nil {
+		panic(err)
+	}
 	app := &Blockchain{
 		rewardsCounter:                  rewards.NewReward(),
 		appDB:                           applicationDB,
 		storages:                        storages,
 		eventsDB:                        eventsDB,
+		lpStats:                         lpStats,
+		candles:                         swapCandles,
+		banList:                         banList,
 		currentMempool:                  &sync.Map{},
 		cfg:                             cfg,
 		stopChan:                        ctx,
@@ -121,6 +152,7 @@ func graceForUpdate(height uint64) *upgrades.GracePeriod {
 
 const haltBlockV210 = 3431238
 const v230 = "v230"
+const v250 = "v250"
 
 func (blockchain *Blockchain) initState() {
 	initialHeight := blockchain.appDB.GetStartHeight()
@@ -135,6 +167,13 @@ func (blockchain *Blockchain) initState() {
 		panic(err)
 	}
 
+	if blockchain.lpStats != nil {
+		stateDeliver.SetLPStats(blockchain.lpStats)
+	}
+	if blockchain.candles != nil {
+		stateDeliver.SetCandles(blockchain.candles)
+	}
+
 	atomic.StoreUint64(&blockchain.height, currentHeight)
 	blockchain.rewards = big.NewInt(0)
 	blockchain.stateDeliver = stateDeliver
@@ -147,31 +186,46 @@ func (blockchain *Blockchain) initState() {
 		upgrades.NewGracePeriod(3612653, 3612653+120, true))
 	blockchain.knownUpdates = map[string]struct{}{
 		"":     {}, // default version
-		"v230": {}, // add more for update
+		"v230": {},
+		"v250": {}, // add more for update
 	}
 	for _, v := range blockchain.UpdateVersions() {
 		grace.AddGracePeriods(graceForUpdate(v.Height))
-		if v.Name == v230 {
-			blockchain.executor = transaction.NewExecutor(transaction.GetData)
-		}
+		blockchain.applyUpdate(v.Name)
 	}
 	blockchain.grace = grace
 }
 
-// InitChain initialize blockchain with validators and other info. Only called once.
-func (blockchain *Blockchain) InitChain(req abciTypes.RequestInitChain) abciTypes.ResponseInitChain {
-	var genesisState types.AppState
-	if err := tmjson.Unmarshal(req.AppStateBytes, &genesisState); err != nil {
-		panic(err)
+// applyUpdate switches transactions and state rules to the given network version
+func (blockchain *Blockchain) applyUpdate(version string) {
+	switch version {
+	case v230:
+		blockchain.executor = transaction.NewExecutor(transaction.GetData)
+	case v250:
+		blockchain.executor = transaction.NewExecutor(transaction.GetDataV250)
+		blockchain.stateDeliver.Candidates.EnableJailReasons()
 	}
+}
 
+// InitChain initialize blockchain with validators and other info. Only called once.
+func (blockchain *Blockchain) InitChain(req abciTypes.RequestInitChain) abciTypes.ResponseInitChain {
 	initialHeight := uint64(req.InitialHeight) - 1
 
 	blockchain.appDB.SetStartHeight(initialHeight)
 	blockchain.initState()
 
-	if err := blockchain.stateDeliver.Import(genesisState); err != nil {
-		panic(err)
+	if path := blockchain.cfg.GenesisStateStreamFile(); path != "" {
+		if err := blockchain.importStateStream(path, uint64(req.InitialHeight)); err != nil {
+			panic(err)
+		}
+	} else {
+		var genesisState types.AppState
+		if err := tmjson.Unmarshal(req.AppStateBytes, &genesisState); err != nil {
+			panic(err)
+		}
+		if err := blockchain.stateDeliver.Import(genesisState); err != nil {
+			panic(err)
+		}
 	}
 	if err := blockchain.stateDeliver.Check(); err != nil {
 		panic(err)
@@ -192,6 +246,25 @@ func (blockchain *Blockchain) InitChain(req abciTypes.RequestInitChain) abciType
 	}
 }
 
+// importStateStream imports genesis state from the stream file written by export command
+func (blockchain *Blockchain) importStateStream(path string, initialHeight uint64) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	height, err := blockchain.stateDeliver.ImportStream(f)
+	if err != nil {
+		return fmt.Errorf("import state stream %s: %s", path, err)
+	}
+	if height != initialHeight {
+		return fmt.Errorf("state stream %s is exported at height %d, initial height of genesis is %d", path, height, initialHeight)
+	}
+
+	return nil
+}
+
 // BeginBlock signals the beginning of a block.
 func (blockchain *Blockchain) BeginBlock(req abciTypes.RequestBeginBlock) abciTypes.ResponseBeginBlock {
 	height := uint64(req.Header.Height)
@@ -201,6 +274,9 @@ func (blockchain *Blockchain) BeginBlock(req abciTypes.RequestBeginBlock) abciTy
 	maxGas := blockchain.calcMaxGas()
 	blockchain.stateDeliver.App.SetMaxGas(maxGas)
 	blockchain.appDB.AddBlocksTime(req.Header.Time)
+	if blockchain.candles != nil {
+		blockchain.candles.BeginBlock(req.Header.Time)
+	}
 
 	blockchain.rewards.SetInt64(0)
 
@@ -271,6 +347,35 @@ func (blockchain *Blockchain) BeginBlock(req abciTypes.RequestBeginBlock) abciTy
 		blockchain.stateDeliver.FrozenFunds.Delete(frozenFunds.Height())
 	}
 
+	// apply scheduled sends
+	if due := blockchain.stateDeliver.Schedules.GetDue(height); len(due) != 0 {
+		for _, id := range due {
+			schedule := blockchain.stateDeliver.Schedules.Pay(id)
+
+			// restrictions of controlled coins apply to scheduled sends, the value is returned to the owner
+			recipient := schedule.Recipient
+			refunded := blockchain.stateDeliver.Controls.IsFrozen(schedule.Coin, schedule.Owner) ||
+				blockchain.stateDeliver.Controls.IsBlocklisted(schedule.Coin, schedule.Recipient)
+			if refunded {
+				recipient = schedule.Owner
+			}
+
+			blockchain.eventsDB.AddEvent(&eventsdb.ScheduledSendEvent{
+				ScheduleID: id,
+				Owner:      schedule.Owner,
+				Recipient:  schedule.Recipient,
+				Coin:       uint64(schedule.Coin),
+				Value:      schedule.Value.String(),
+				Remaining:  schedule.GetCount(),
+				Refunded:   refunded,
+			})
+			blockchain.stateDeliver.Accounts.AddBalance(recipient, schedule.Coin, schedule.Value)
+		}
+
+		// delete from db
+		blockchain.stateDeliver.Schedules.DeleteDue(height)
+	}
+
 	blockchain.stateDeliver.Halts.Delete(height)
 
 	return abciTypes.ResponseBeginBlock{}
@@ -392,13 +497,20 @@ func (blockchain *Blockchain) EndBlock(req abciTypes.RequestEndBlock) abciTypes.
 				Version: v,
 			})
 			blockchain.grace.AddGracePeriods(graceForUpdate(height))
-			if v == v230 {
-				blockchain.executor = transaction.NewExecutor(transaction.GetData)
-			}
+			blockchain.applyUpdate(v)
 		}
 		blockchain.stateDeliver.Updates.Delete(height)
 	}
 
+	hasChangedSlots := false
+	{
+		if validatorsCount, candidatesCount, ok := blockchain.isUpdateSlotsBlock(height); ok {
+			blockchain.stateDeliver.Slots.SetSlots(height, validatorsCount, candidatesCount)
+			hasChangedSlots = true
+		}
+		blockchain.stateDeliver.Slots.Delete(height)
+	}
+
 	hasChangedPublicKeys := false
 	if blockchain.stateDeliver.Candidates.IsChangedPublicKeys() {
 		blockchain.stateDeliver.Candidates.ResetIsChangedPublicKeys()
@@ -407,7 +519,7 @@ func (blockchain *Blockchain) EndBlock(req abciTypes.RequestEndBlock) abciTypes.
 
 	// update validators
 	var updates []abciTypes.ValidatorUpdate
-	if height%blockchain.updateStakesAndPayRewardsPeriod == 0 || hasDroppedValidators || hasChangedPublicKeys {
+	if height%blockchain.updateStakesAndPayRewardsPeriod == 0 || hasDroppedValidators || hasChangedPublicKeys || hasChangedSlots {
 		updates = blockchain.updateValidators()
 	}
 
@@ -499,6 +611,18 @@ func (blockchain *Blockchain) Commit() abciTypes.ResponseCommit {
 		panic(err)
 	}
 
+	if blockchain.lpStats != nil {
+		if err := blockchain.lpStats.Commit(blockchain.Height()); err != nil {
+			panic(err)
+		}
+	}
+
+	if blockchain.candles != nil {
+		if err := blockchain.candles.Commit(blockchain.Height()); err != nil {
+			panic(err)
+		}
+	}
+
 	// Committing Minter Blockchain state
 	hash, err := blockchain.stateDeliver.Commit()
 	if err != nil {
@@ -526,8 +650,18 @@ func (blockchain *Blockchain) Commit() abciTypes.ResponseCommit {
 	}
 }
 
-// Query Unused method, required by Tendermint
-func (blockchain *Blockchain) Query(_ abciTypes.RequestQuery) abciTypes.ResponseQuery {
+// Query is used by Tendermint to filter peers by the ban list
+func (blockchain *Blockchain) Query(req abciTypes.RequestQuery) abciTypes.ResponseQuery {
+	switch {
+	case strings.HasPrefix(req.Path, filterPeerAddrPath):
+		if blockchain.banList.IsBannedAddr(strings.TrimPrefix(req.Path, filterPeerAddrPath)) {
+			return abciTypes.ResponseQuery{Code: codePeerBanned, Log: "peer address is banned"}
+		}
+	case strings.HasPrefix(req.Path, filterPeerIDPath):
+		if blockchain.banList.IsBanned(strings.TrimPrefix(req.Path, filterPeerIDPath)) {
+			return abciTypes.ResponseQuery{Code: codePeerBanned, Log: "peer id is banned"}
+		}
+	}
 	return abciTypes.ResponseQuery{}
 }
 
@@ -547,5 +681,11 @@ func (blockchain *Blockchain) Close() error {
 	if err := blockchain.storages.EventDB().Close(); err != nil {
 		return err
 	}
+	if err := blockchain.storages.LPStatsDB().Close(); err != nil {
+		return err
+	}
+	if err := blockchain.storages.CandlesDB().Close(); err != nil {
+		return err
+	}
 	return nil
 }
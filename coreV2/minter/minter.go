@@ -3,13 +3,15 @@ package minter
 import (
 	"fmt"
 	"github.com/MinterTeam/minter-go-node/coreV2/appdb"
+	"github.com/MinterTeam/minter-go-node/coreV2/banlist"
+	"github.com/MinterTeam/minter-go-node/coreV2/candles"
 	eventsdb "github.com/MinterTeam/minter-go-node/coreV2/events"
+	"github.com/MinterTeam/minter-go-node/coreV2/lpstats"
 	"github.com/MinterTeam/minter-go-node/coreV2/rewards"
 	"github.com/MinterTeam/minter-go-node/coreV2/state"
 	validators2 "github.com/MinterTeam/minter-go-node/coreV2/state/validators"
 	"github.com/MinterTeam/minter-go-node/coreV2/statistics"
 	"github.com/MinterTeam/minter-go-node/coreV2/types"
-	"github.com/MinterTeam/minter-go-node/coreV2/validators"
 	"github.com/syndtr/goleveldb/leveldb/filter"
 	"github.com/syndtr/goleveldb/leveldb/opt"
 	abciTypes "github.com/tendermint/tendermint/abci/types"
@@ -29,6 +31,16 @@ func (blockchain *Blockchain) RewardCounter() *rewards.Reward {
 	return blockchain.rewardsCounter
 }
 
+// PayRewardsPeriod returns the period in blocks between payments of rewards
+func (blockchain *Blockchain) PayRewardsPeriod() uint64 {
+	return blockchain.updateStakesAndPayRewardsPeriod
+}
+
+// BanList returns list of banned peers
+func (blockchain *Blockchain) BanList() *banlist.BanList {
+	return blockchain.banList
+}
+
 func (blockchain *Blockchain) InitialHeight() uint64 {
 	return blockchain.appDB.GetStartHeight()
 }
@@ -87,30 +99,19 @@ func (blockchain *Blockchain) updateValidators() []abciTypes.ValidatorUpdate {
 	height := blockchain.Height()
 	blockchain.stateDeliver.Candidates.RecalculateStakes(height)
 
-	valsCount := validators.GetValidatorsCountForBlock(height)
+	valsCount := blockchain.stateDeliver.Slots.GetValidatorsCount(height)
 	newCandidates := blockchain.stateDeliver.Candidates.GetNewCandidates(valsCount)
 	if len(newCandidates) < valsCount {
 		valsCount = len(newCandidates)
 	}
 
-	newValidators := make([]abciTypes.ValidatorUpdate, 0, valsCount)
-
-	// calculate total power
-	totalPower := big.NewInt(0)
+	pubKeys := make([]types.Pubkey, 0, len(newCandidates))
+	stakes := make([]*big.Int, 0, len(newCandidates))
 	for _, candidate := range newCandidates {
-		totalPower.Add(totalPower, blockchain.stateDeliver.Candidates.GetTotalStake(candidate.PubKey))
-	}
-
-	for _, newCandidate := range newCandidates {
-		power := big.NewInt(0).Div(big.NewInt(0).Mul(blockchain.stateDeliver.Candidates.GetTotalStake(newCandidate.PubKey),
-			big.NewInt(100000000)), totalPower).Int64()
-
-		if power == 0 {
-			power = 1
-		}
-
-		newValidators = append(newValidators, abciTypes.Ed25519ValidatorUpdate(newCandidate.PubKey.Bytes(), power))
+		pubKeys = append(pubKeys, candidate.PubKey)
+		stakes = append(stakes, blockchain.stateDeliver.Candidates.GetTotalStake(candidate.PubKey))
 	}
+	newValidators := validatorUpdates(pubKeys, stakes)
 
 	// update validators in state
 	blockchain.stateDeliver.Validators.SetNewValidators(newCandidates)
@@ -141,6 +142,31 @@ func (blockchain *Blockchain) updateValidators() []abciTypes.ValidatorUpdate {
 	return updates
 }
 
+// validatorUpdates returns updates with powers of validators proportional to their stakes
+func validatorUpdates(pubKeys []types.Pubkey, stakes []*big.Int) []abciTypes.ValidatorUpdate {
+	// calculate total power
+	totalPower := big.NewInt(0)
+	for _, stake := range stakes {
+		totalPower.Add(totalPower, stake)
+	}
+	if totalPower.Sign() == 0 {
+		totalPower = big.NewInt(1)
+	}
+
+	updates := make([]abciTypes.ValidatorUpdate, 0, len(pubKeys))
+	for i, pubKey := range pubKeys {
+		power := big.NewInt(0).Div(big.NewInt(0).Mul(stakes[i], big.NewInt(100000000)), totalPower).Int64()
+
+		if power == 0 {
+			power = 1
+		}
+
+		updates = append(updates, abciTypes.Ed25519ValidatorUpdate(pubKey.Bytes(), power))
+	}
+
+	return updates
+}
+
 // CurrentState returns immutable state of Minter Blockchain
 func (blockchain *Blockchain) CurrentState() *state.CheckState {
 	blockchain.lock.RLock()
@@ -245,6 +271,16 @@ func (blockchain *Blockchain) GetEventsDB() eventsdb.IEventsDB {
 	return blockchain.eventsDB
 }
 
+// LPStats returns statistics of swap pools and liquidity providers, nil in validator mode
+func (blockchain *Blockchain) LPStats() *lpstats.Store {
+	return blockchain.lpStats
+}
+
+// Candles returns candles of swap pools, nil if they are disabled
+func (blockchain *Blockchain) Candles() *candles.Store {
+	return blockchain.candles
+}
+
 // SetStatisticData used for collection statistics about blockchain operations
 func (blockchain *Blockchain) SetStatisticData(statisticData *statistics.Data) *statistics.Data {
 	blockchain.statisticData = statisticData
@@ -399,6 +435,37 @@ func (blockchain *Blockchain) isUpdateNetworkBlockV2(height uint64) (string, boo
 	return "", false
 }
 
+func (blockchain *Blockchain) isUpdateSlotsBlock(height uint64) (validators uint32, candidates uint32, ok bool) {
+	votes := blockchain.stateDeliver.Slots.GetVotes(height)
+	if len(votes) == 0 {
+		return 0, 0, false
+	}
+	// calculate total power of validators
+	maxVotingResult := big.NewFloat(0)
+	for _, v := range votes {
+		totalVotedPower := big.NewInt(0)
+		for _, vote := range v.Votes {
+			if power, ok := blockchain.validatorsPowers[vote]; ok {
+				totalVotedPower.Add(totalVotedPower, power)
+			}
+		}
+		votingResult := new(big.Float).Quo(
+			new(big.Float).SetInt(totalVotedPower),
+			new(big.Float).SetInt(blockchain.totalPower),
+		)
+
+		if maxVotingResult.Cmp(votingResult) == -1 {
+			maxVotingResult = votingResult
+			validators, candidates = v.Validators, v.Candidates
+		}
+	}
+	if maxVotingResult.Cmp(big.NewFloat(votingPowerConsensus)) == 1 {
+		return validators, candidates, true
+	}
+
+	return 0, 0, false
+}
+
 func GetDbOpts(memLimit int) *opt.Options {
 	if memLimit < 1024 {
 		panic(fmt.Sprintf("Not enough memory given to StateDB. Expected >1024M, given %d", memLimit))
@@ -7,6 +7,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/big"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -22,6 +24,7 @@ import (
 	"github.com/MinterTeam/minter-go-node/helpers"
 	"github.com/MinterTeam/minter-go-node/log"
 	"github.com/MinterTeam/minter-go-node/rlp"
+	abciTypes "github.com/tendermint/tendermint/abci/types"
 	tmjson "github.com/tendermint/tendermint/libs/json"
 	tmnet "github.com/tendermint/tendermint/libs/net"
 	tmNode "github.com/tendermint/tendermint/node"
@@ -680,6 +683,112 @@ func TestBlockchain_FrozenFunds(t *testing.T) {
 
 }
 
+func TestBlockchain_ScheduledSends(t *testing.T) {
+	blockchain, tmCli, pv, cancel := initTestNode(t, 0)
+	defer cancel()
+
+	targetHeight := uint64(10)
+	value := helpers.BipToPip(big.NewInt(500))
+	recipient := types.Address{1}
+	pubkey := types.BytesToPubkey(pv.Key.PubKey.Bytes()[:])
+	blockchain.stateDeliver.RLock()
+	blockchain.stateDeliver.Candidates.SubStake(developers.Address, pubkey, 0, big.NewInt(0).Mul(value, big.NewInt(2)))
+	blockchain.stateDeliver.Schedules.CreateSchedule(developers.Address, recipient, 0, value, targetHeight, 1, 2)
+	blockchain.stateDeliver.RUnlock()
+
+	blocks, err := tmCli.Subscribe(context.Background(), "test-client", "tm.event = 'NewBlock'")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for block := range blocks {
+		if block.Data.(types2.EventDataNewBlock).Block.Height < int64(targetHeight+1) {
+			continue
+		}
+		break
+	}
+
+	cState := blockchain.CurrentState()
+	exportedState := cState.Export()
+	if err := exportedState.Verify(); err != nil {
+		t.Fatal(err)
+	}
+
+	if balance := cState.Accounts().GetBalance(recipient, 0); balance.Cmp(big.NewInt(0).Mul(value, big.NewInt(2))) != 0 {
+		t.Errorf("recipient balance is %s", balance)
+	}
+	if cState.Schedules().GetSchedule(1) != nil {
+		t.Error("schedule is not finished")
+	}
+
+	for i, height := range []uint64{targetHeight, targetHeight + 1} {
+		var event *eventsdb.ScheduledSendEvent
+		for _, e := range blockchain.GetEventsDB().LoadEvents(uint32(height)) {
+			if scheduled, ok := e.(*eventsdb.ScheduledSendEvent); ok {
+				event = scheduled
+			}
+		}
+		if event == nil {
+			t.Fatalf("no scheduled send event for %d block", height)
+		}
+		if event.Recipient != recipient || event.Value != value.String() || event.Remaining != uint32(1-i) {
+			t.Errorf("wrong scheduled send event %v", event)
+		}
+	}
+}
+
+func TestBlockchain_ScheduledSendsRefund(t *testing.T) {
+	blockchain, tmCli, pv, cancel := initTestNode(t, 0)
+	defer cancel()
+
+	targetHeight := uint64(10)
+	value := helpers.BipToPip(big.NewInt(500))
+	owner := types.Address{2}
+	recipient := types.Address{1}
+	pubkey := types.BytesToPubkey(pv.Key.PubKey.Bytes()[:])
+	blockchain.stateDeliver.RLock()
+	blockchain.stateDeliver.Candidates.SubStake(developers.Address, pubkey, 0, value)
+	blockchain.stateDeliver.Schedules.CreateSchedule(owner, recipient, 0, value, targetHeight, 0, 1)
+	blockchain.stateDeliver.Controls.SetRestriction(0, recipient, false, true)
+	blockchain.stateDeliver.RUnlock()
+
+	blocks, err := tmCli.Subscribe(context.Background(), "test-client", "tm.event = 'NewBlock'")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for block := range blocks {
+		if block.Data.(types2.EventDataNewBlock).Block.Height < int64(targetHeight+1) {
+			continue
+		}
+		break
+	}
+
+	cState := blockchain.CurrentState()
+	if balance := cState.Accounts().GetBalance(recipient, 0); balance.Sign() != 0 {
+		t.Errorf("recipient balance is %s", balance)
+	}
+	if balance := cState.Accounts().GetBalance(owner, 0); balance.Cmp(value) != 0 {
+		t.Errorf("owner balance is %s", balance)
+	}
+	if cState.Schedules().GetSchedule(1) != nil {
+		t.Error("schedule is not finished")
+	}
+
+	var event *eventsdb.ScheduledSendEvent
+	for _, e := range blockchain.GetEventsDB().LoadEvents(uint32(targetHeight)) {
+		if scheduled, ok := e.(*eventsdb.ScheduledSendEvent); ok {
+			event = scheduled
+		}
+	}
+	if event == nil {
+		t.Fatalf("no scheduled send event for %d block", targetHeight)
+	}
+	if event.Recipient != recipient || !event.Refunded {
+		t.Errorf("wrong scheduled send event %v", event)
+	}
+}
+
 func TestBlockchain_RecalculateStakes_andRemoveValidator(t *testing.T) {
 	blockchain, tmCli, _, cancel := initTestNode(t, 0)
 	defer cancel()
@@ -967,6 +1076,55 @@ func TestStopNetworkByHaltBlocks(t *testing.T) {
 	}
 }
 
+func TestBlockchain_InitChainFromStateStream(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	newBlockchain := func(stream string) *Blockchain {
+		storage := utils.NewStorage(t.TempDir(), "")
+		cfg := config.GetConfig(storage.GetMinterHome())
+		cfg.GenesisStateStream = stream
+		return NewMinterBlockchain(storage, cfg, ctx, 120)
+	}
+
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "config"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	pv := privval.GenFilePV(filepath.Join(dir, "priv_validator_key.json"), filepath.Join(dir, "priv_validator_state.json"))
+	genesis, err := getTestGenesis(pv, dir, 100)()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	source := newBlockchain("")
+	source.InitChain(abciTypes.RequestInitChain{AppStateBytes: genesis.AppState, InitialHeight: genesis.InitialHeight})
+
+	streamPath := filepath.Join(dir, "state.jsonl")
+	if err := source.CurrentState().ExportStreamFile(streamPath, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// export command uses height of exported state as initial height of the new genesis
+	target := newBlockchain(streamPath)
+	response := target.InitChain(abciTypes.RequestInitChain{AppStateBytes: []byte("{}"), InitialHeight: int64(source.appDB.GetLastHeight())})
+	if len(response.Validators) != 1 {
+		t.Fatalf("validators count is %d, want 1", len(response.Validators))
+	}
+
+	want, err := json.Marshal(source.CurrentState().Export())
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := json.Marshal(target.CurrentState().Export())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != string(want) {
+		t.Errorf("imported state differs from exported one\n got: %s\nwant: %s", got, want)
+	}
+}
+
 func getPrivateKey() *ecdsa.PrivateKey {
 	b, _ := hex.DecodeString("825ca965c34ef1c8343e8e377959108370c23ba6194d858452b63432456403f9")
 	privateKey, _ := crypto.ToECDSA(b)
@@ -0,0 +1,98 @@
+package minter
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/MinterTeam/minter-go-node/coreV2/state"
+	validators2 "github.com/MinterTeam/minter-go-node/coreV2/state/validators"
+	"github.com/MinterTeam/minter-go-node/coreV2/types"
+	abciTypes "github.com/tendermint/tendermint/abci/types"
+)
+
+// Rollback resets state, application, events and pool statistics databases to the given height,
+// so Tendermint replays blocks after it on the next start.
+// blocksTime are times of the latest blocks up to the given height, see appdb.BlocksTimeCount.
+// Should not be called on the running node.
+func (blockchain *Blockchain) Rollback(height uint64, blocksTime []time.Time) error {
+	lastHeight := blockchain.appDB.GetLastHeight()
+	if height >= lastHeight {
+		return fmt.Errorf("height %d should be less than the last height %d", height, lastHeight)
+	}
+
+	stateTree := blockchain.stateDeliver.Tree()
+	if !isVersionAvailable(stateTree.AvailableVersions(), height) {
+		return fmt.Errorf("state at height %d has been pruned, keep_last_states is %d", height, blockchain.cfg.KeepLastStates)
+	}
+
+	immutableTree, err := stateTree.GetImmutableAtHeight(int64(height))
+	if err != nil {
+		return err
+	}
+	hash := immutableTree.Hash()
+
+	checkState, err := state.NewCheckStateAtHeight(height, blockchain.storages.StateDB())
+	if err != nil {
+		return err
+	}
+	checkState.Validators().LoadValidators()
+	validatorUpdates := getValidatorUpdates(checkState.Validators().GetValidators())
+
+	if err := stateTree.LoadVersionForOverwriting(int64(height)); err != nil {
+		return err
+	}
+
+	if err := blockchain.eventsDB.DeleteEventsFrom(uint32(height) + 1); err != nil {
+		return err
+	}
+
+	if blockchain.lpStats != nil {
+		if err := blockchain.lpStats.DeleteFrom(height + 1); err != nil {
+			return err
+		}
+	}
+
+	if blockchain.candles != nil {
+		if err := blockchain.candles.DeleteFrom(height + 1); err != nil {
+			return err
+		}
+	}
+
+	blockchain.appDB.SetLastBlockHash(hash)
+	blockchain.appDB.SetLastHeight(height)
+
+	blockchain.appDB.SetValidators(validatorUpdates)
+	blockchain.appDB.FlushValidators()
+
+	blockchain.appDB.SetBlocksTime(blocksTime)
+	blockchain.appDB.SaveBlocksTime()
+
+	blockchain.appDB.RemoveVersionsAfter(height)
+	blockchain.appDB.SaveVersions()
+
+	blockchain.initState()
+
+	return nil
+}
+
+func isVersionAvailable(versions []int, height uint64) bool {
+	for _, version := range versions {
+		if uint64(version) == height {
+			return true
+		}
+	}
+	return false
+}
+
+// getValidatorUpdates calculates powers of validators the same way as updateValidators
+func getValidatorUpdates(vals []*validators2.Validator) []abciTypes.ValidatorUpdate {
+	pubKeys := make([]types.Pubkey, 0, len(vals))
+	stakes := make([]*big.Int, 0, len(vals))
+	for _, val := range vals {
+		pubKeys = append(pubKeys, val.PubKey)
+		stakes = append(stakes, val.GetTotalBipStake())
+	}
+
+	return validatorUpdates(pubKeys, stakes)
+}
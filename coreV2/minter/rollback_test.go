@@ -0,0 +1,73 @@
+package minter
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/MinterTeam/minter-go-node/cmd/utils"
+	"github.com/MinterTeam/minter-go-node/config"
+	abciTypes "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/privval"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+func TestBlockchain_Rollback(t *testing.T) {
+	storage := utils.NewStorage(t.TempDir(), "")
+	minterCfg := config.GetConfig(storage.GetMinterHome())
+	pv := privval.GenFilePV(filepath.Join(storage.GetMinterHome(), "key.json"), filepath.Join(storage.GetMinterHome(), "state.json"))
+
+	app := NewMinterBlockchain(storage, minterCfg, context.Background(), 120)
+	genesis, err := getTestGenesis(pv, storage.GetMinterHome(), 100)()
+	if err != nil {
+		t.Fatal(err)
+	}
+	app.InitChain(abciTypes.RequestInitChain{AppStateBytes: genesis.AppState, InitialHeight: genesis.InitialHeight})
+
+	start := time.Now()
+	hashes := map[int64][]byte{}
+	var blocksTime []time.Time
+	runBlock := func(height int64) {
+		blockTime := start.Add(time.Duration(height) * 5 * time.Second)
+		app.BeginBlock(abciTypes.RequestBeginBlock{Header: tmproto.Header{Height: height, Time: blockTime}})
+		app.EndBlock(abciTypes.RequestEndBlock{Height: height})
+		hash := app.Commit().Data
+		if h, ok := hashes[height]; ok && !bytes.Equal(h, hash) {
+			t.Fatalf("replayed block %d has different hash", height)
+		}
+		hashes[height] = hash
+		blocksTime = append(blocksTime, blockTime)
+	}
+	for height := int64(100); height <= 110; height++ {
+		runBlock(height)
+	}
+
+	if err := app.Rollback(111, nil); err == nil {
+		t.Fatal("rollback to the future height should fail")
+	}
+
+	if err := app.Rollback(105, blocksTime[2:6]); err != nil {
+		t.Fatal(err)
+	}
+	info := app.Info(abciTypes.RequestInfo{})
+	if info.LastBlockHeight != 105 || !bytes.Equal(info.LastBlockAppHash, hashes[105]) {
+		t.Fatalf("unexpected app info after rollback: %d %X", info.LastBlockHeight, info.LastBlockAppHash)
+	}
+	versions := app.AvailableVersions()
+	if versions[len(versions)-1] != 105 {
+		t.Fatalf("state versions after 105 are not deleted: %v", versions)
+	}
+
+	for height := int64(106); height <= 110; height++ {
+		runBlock(height)
+	}
+
+	if err := app.DeleteStateVersions(100, 103); err != nil {
+		t.Fatal(err)
+	}
+	if err := app.Rollback(101, nil); err == nil {
+		t.Fatal("rollback to the pruned height should fail")
+	}
+}
@@ -0,0 +1,299 @@
+package signguard
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/tendermint/tendermint/crypto"
+	tmLog "github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/libs/tempfile"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	"github.com/tendermint/tendermint/types"
+)
+
+const (
+	// LockFile is the name of the lock file in the home dir held while the node is running
+	LockFile = "signer.lock"
+	// StateFile is the name of the file in the home dir with the last signed height
+	StateFile = "signer_state.json"
+)
+
+var (
+	// ErrNotChecked is returned on signing before recent blocks were checked
+	ErrNotChecked = errors.New("signing is paused until recent blocks are checked for double signing")
+	// ErrConflict is returned on signing after a signature produced by another instance was found
+	ErrConflict = errors.New("signing is disabled: validator key is used by another instance")
+)
+
+type status byte
+
+const (
+	statusNotChecked status = iota
+	statusChecked
+	statusConflict
+)
+
+// Client is a part of the node RPC used to check recent blocks
+type Client interface {
+	Status(ctx context.Context) (*ctypes.ResultStatus, error)
+	Commit(ctx context.Context, height *int64) (*ctypes.ResultCommit, error)
+}
+
+type signerState struct {
+	LastSignedHeight int64 `json:"last_signed_height,string"`
+}
+
+// Guard protects a validator key from being used by several instances of the node.
+// It holds a lock file in the home dir, keeps the last height signed by the node
+// and refuses to sign if recent blocks contain signatures the node did not produce.
+type Guard struct {
+	lock sync.RWMutex
+
+	lockFile  *os.File
+	statePath string
+
+	address          crypto.Address
+	lastSignedHeight int64
+	checkedHeight    int64
+	status           status
+	conflictHeight   int64
+}
+
+// Open locks the home dir and loads the last signed height.
+// The height is the greater of the one from the state file and initialHeight, usually the height of the privval state.
+func Open(home string, address crypto.Address, initialHeight int64) (*Guard, error) {
+	lockPath := home + "/" + LockFile
+	file, err := os.OpenFile(lockPath, os.O_RDWR|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, err
+	}
+	if err := lockFile(file); err != nil {
+		file.Close()
+		return nil, fmt.Errorf("home dir %s is locked by another instance of the node: %v", home, err)
+	}
+	if err := file.Truncate(0); err == nil {
+		_, _ = file.WriteAt([]byte(strconv.Itoa(os.Getpid())), 0)
+	}
+
+	g := &Guard{
+		lockFile:         file,
+		statePath:        home + "/" + StateFile,
+		address:          address,
+		lastSignedHeight: initialHeight,
+	}
+
+	data, err := ioutil.ReadFile(g.statePath)
+	if err != nil && !os.IsNotExist(err) {
+		g.Close()
+		return nil, err
+	}
+	if len(data) != 0 {
+		var state signerState
+		if err := json.Unmarshal(data, &state); err != nil {
+			g.Close()
+			return nil, fmt.Errorf("can't read %s: %v", g.statePath, err)
+		}
+		// the file is stale if the node signed blocks while the check was disabled
+		if state.LastSignedHeight > g.lastSignedHeight {
+			g.lastSignedHeight = state.LastSignedHeight
+		}
+	}
+
+	return g, nil
+}
+
+// Close releases the lock file
+func (g *Guard) Close() error {
+	_ = unlockFile(g.lockFile)
+	return g.lockFile.Close()
+}
+
+// LastSignedHeight returns the last height signed by the node
+func (g *Guard) LastSignedHeight() int64 {
+	g.lock.RLock()
+	defer g.lock.RUnlock()
+
+	return g.lastSignedHeight
+}
+
+// Err returns the reason signing is refused or nil if signing is allowed
+func (g *Guard) Err() error {
+	g.lock.RLock()
+	defer g.lock.RUnlock()
+
+	switch g.status {
+	case statusChecked:
+		return nil
+	case statusConflict:
+		return fmt.Errorf("%w, found its signature at height %d", ErrConflict, g.conflictHeight)
+	default:
+		return ErrNotChecked
+	}
+}
+
+// Check looks through commits of recent blocks, at most given count, for signatures by the validator
+// the node did not produce. It returns false if the node is still catching up and nothing was checked.
+func (g *Guard) Check(ctx context.Context, client Client, blocks int64) (bool, error) {
+	result, err := client.Status(ctx)
+	if err != nil {
+		return false, err
+	}
+	if result.SyncInfo.CatchingUp {
+		return false, nil
+	}
+
+	latest := result.SyncInfo.LatestBlockHeight
+
+	g.lock.RLock()
+	from := g.lastSignedHeight + 1
+	if g.checkedHeight >= from {
+		from = g.checkedHeight + 1
+	}
+	g.lock.RUnlock()
+
+	if from < latest-blocks+1 {
+		from = latest - blocks + 1
+	}
+	if from < 1 {
+		from = 1
+	}
+
+	for height := from; height <= latest; height++ {
+		h := height
+		commit, err := client.Commit(ctx, &h)
+		if err != nil {
+			return false, err
+		}
+
+		if g.isSignedByOther(height, commit.Commit) {
+			g.lock.Lock()
+			g.status = statusConflict
+			g.conflictHeight = height
+			g.lock.Unlock()
+			return true, g.Err()
+		}
+
+		g.lock.Lock()
+		g.checkedHeight = height
+		g.lock.Unlock()
+	}
+
+	g.lock.Lock()
+	if g.status == statusNotChecked {
+		g.status = statusChecked
+	}
+	g.lock.Unlock()
+
+	return true, g.Err()
+}
+
+func (g *Guard) isSignedByOther(height int64, commit *types.Commit) bool {
+	if commit == nil {
+		return false
+	}
+
+	g.lock.RLock()
+	defer g.lock.RUnlock()
+
+	if height <= g.lastSignedHeight {
+		return false
+	}
+
+	for _, sig := range commit.Signatures {
+		if !sig.Absent() && g.address.String() == sig.ValidatorAddress.String() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Run checks recent blocks once the node has caught up and then keeps checking every new block
+func (g *Guard) Run(ctx context.Context, client Client, blocks int64, logger tmLog.Logger) {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	var notified bool
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		checked, err := g.Check(ctx, client, blocks)
+		if errors.Is(err, ErrConflict) {
+			logger.Error("Double signing protection: signing is disabled", "err", err)
+			return
+		}
+		if err != nil {
+			logger.Error("Double signing protection: failed to check recent blocks", "err", err)
+			continue
+		}
+		if checked && !notified {
+			notified = true
+			logger.Info("Double signing protection: recent blocks are checked, signing is enabled")
+		}
+	}
+}
+
+func (g *Guard) markSigned(height int64) error {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	if height <= g.lastSignedHeight {
+		return nil
+	}
+
+	data, err := json.Marshal(signerState{LastSignedHeight: height})
+	if err != nil {
+		return err
+	}
+	if err := tempfile.WriteFileAtomic(g.statePath, data, 0600); err != nil {
+		return err
+	}
+
+	g.lastSignedHeight = height
+
+	return nil
+}
+
+// PrivValidator wraps given validator and refuses to sign while the guard does not allow it
+func (g *Guard) PrivValidator(pv types.PrivValidator) types.PrivValidator {
+	return &privValidator{PrivValidator: pv, guard: g}
+}
+
+type privValidator struct {
+	types.PrivValidator
+	guard *Guard
+}
+
+func (pv *privValidator) SignVote(chainID string, vote *tmproto.Vote) error {
+	if err := pv.guard.Err(); err != nil {
+		return err
+	}
+	if err := pv.guard.markSigned(vote.Height); err != nil {
+		return err
+	}
+
+	return pv.PrivValidator.SignVote(chainID, vote)
+}
+
+func (pv *privValidator) SignProposal(chainID string, proposal *tmproto.Proposal) error {
+	if err := pv.guard.Err(); err != nil {
+		return err
+	}
+	if err := pv.guard.markSigned(proposal.Height); err != nil {
+		return err
+	}
+
+	return pv.PrivValidator.SignProposal(chainID, proposal)
+}
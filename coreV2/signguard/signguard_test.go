@@ -0,0 +1,156 @@
+package signguard
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"testing"
+
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/privval"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	"github.com/tendermint/tendermint/types"
+)
+
+type testClient struct {
+	latest     int64
+	catchingUp bool
+	signers    map[int64]crypto.Address
+}
+
+func (c *testClient) Status(context.Context) (*ctypes.ResultStatus, error) {
+	return &ctypes.ResultStatus{SyncInfo: ctypes.SyncInfo{LatestBlockHeight: c.latest, CatchingUp: c.catchingUp}}, nil
+}
+
+func (c *testClient) Commit(_ context.Context, height *int64) (*ctypes.ResultCommit, error) {
+	commit := &types.Commit{Height: *height}
+	if address, ok := c.signers[*height]; ok {
+		commit.Signatures = append(commit.Signatures, types.CommitSig{
+			BlockIDFlag:      types.BlockIDFlagCommit,
+			ValidatorAddress: address,
+			Signature:        []byte{1},
+		})
+	}
+	return &ctypes.ResultCommit{SignedHeader: types.SignedHeader{Commit: commit}}, nil
+}
+
+func TestGuardLock(t *testing.T) {
+	t.Parallel()
+	home := t.TempDir()
+
+	g, err := Open(home, crypto.Address{1}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := Open(home, crypto.Address{1}, 0); err == nil {
+		t.Fatal("home dir should be locked")
+	}
+
+	if err := g.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	g, err = Open(home, crypto.Address{1}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	g.Close()
+}
+
+func TestGuardSign(t *testing.T) {
+	t.Parallel()
+	home := t.TempDir()
+	filePV := privval.GenFilePV(home+"/key.json", home+"/state.json")
+
+	g, err := Open(home, filePV.GetAddress(), 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pv := g.PrivValidator(filePV)
+
+	vote := &tmproto.Vote{Type: tmproto.PrevoteType, Height: 10}
+	if err := pv.SignVote("test", vote); !errors.Is(err, ErrNotChecked) {
+		t.Fatalf("signing should be paused, got %v", err)
+	}
+
+	client := &testClient{latest: 8, signers: map[int64]crypto.Address{3: filePV.GetAddress()}}
+	if checked, err := g.Check(context.Background(), client, 100); !checked || err != nil {
+		t.Fatalf("check failed: %v", err)
+	}
+
+	if err := pv.SignVote("test", vote); err != nil {
+		t.Fatal(err)
+	}
+	g.Close()
+
+	g, err = Open(home, filePV.GetAddress(), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer g.Close()
+
+	if g.LastSignedHeight() != 10 {
+		t.Fatalf("last signed height is %d, want 10", g.LastSignedHeight())
+	}
+}
+
+func TestGuardStaleState(t *testing.T) {
+	t.Parallel()
+	home := t.TempDir()
+	address := crypto.Address{1}
+
+	// the state file was left at height 3, then the node signed up to height 10 with the check disabled
+	if err := ioutil.WriteFile(home+"/"+StateFile, []byte(`{"last_signed_height":"3"}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	g, err := Open(home, address, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer g.Close()
+
+	if g.LastSignedHeight() != 10 {
+		t.Fatalf("last signed height is %d, want 10", g.LastSignedHeight())
+	}
+
+	client := &testClient{latest: 10, signers: map[int64]crypto.Address{7: address, 10: address}}
+	if checked, err := g.Check(context.Background(), client, 100); !checked || err != nil {
+		t.Fatalf("own signatures should not be a conflict: %v", err)
+	}
+}
+
+func TestGuardConflict(t *testing.T) {
+	t.Parallel()
+	home := t.TempDir()
+	address := crypto.Address{1}
+
+	g, err := Open(home, address, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer g.Close()
+
+	client := &testClient{latest: 10, catchingUp: true, signers: map[int64]crypto.Address{7: address}}
+	if checked, err := g.Check(context.Background(), client, 100); checked || err != nil {
+		t.Fatalf("nothing should be checked while catching up: %v", err)
+	}
+
+	client.catchingUp = false
+	if _, err := g.Check(context.Background(), client, 2); err != nil {
+		t.Fatalf("signature out of checked range should be ignored: %v", err)
+	}
+
+	client.latest = 12
+	client.signers[12] = address
+	if _, err := g.Check(context.Background(), client, 2); !errors.Is(err, ErrConflict) {
+		t.Fatalf("conflict expected, got %v", err)
+	}
+
+	vote := &tmproto.Vote{Type: tmproto.PrevoteType, Height: 13}
+	if err := g.PrivValidator(privval.GenFilePV(home+"/key.json", home+"/state.json")).SignVote("test", vote); !errors.Is(err, ErrConflict) {
+		t.Fatalf("signing should be disabled, got %v", err)
+	}
+}
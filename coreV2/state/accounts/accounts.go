@@ -25,6 +25,7 @@ type RAccounts interface {
 
 	Export(state *types.AppState)
 	GetAccount(address types.Address) *Model
+	GetSigner(address types.Address) *types.Address
 	GetNonce(address types.Address) uint64
 	GetBalance(address types.Address, coin types.CoinID) *big.Int
 	GetBalances(address types.Address) []Balance
@@ -224,6 +225,24 @@ func (a *Accounts) SetNonce(address types.Address, nonce uint64) {
 	account.setNonce(nonce)
 }
 
+// SetSigner authorises the key of the signer to sign transactions of the account instead of its own key.
+// The own address of the account as the signer restores its own key.
+func (a *Accounts) SetSigner(address types.Address, signer types.Address) {
+	account := a.getOrNew(address)
+	account.setSigner(signer)
+}
+
+// GetSigner returns the address of the key authorised to sign transactions of the account
+// or nil if the key is not rotated. Unlike GetAccount it does not cache an empty account.
+func (a *Accounts) GetSigner(address types.Address) *types.Address {
+	account := a.get(address)
+	if account == nil {
+		return nil
+	}
+
+	return account.GetSigner()
+}
+
 func (a *Accounts) ExistsMultisig(msigAddress types.Address) bool {
 	acc := a.get(msigAddress)
 	if acc == nil {
@@ -381,6 +400,14 @@ func (a *Accounts) markDirty(addr types.Address) {
 }
 
 func (a *Accounts) Export(state *types.AppState) {
+	a.ExportEach(func(account types.Account) {
+		state.Accounts = append(state.Accounts, account)
+	})
+}
+
+// ExportEach calls fn for every non-empty account in order of addresses.
+// Accounts loaded only for the export are not kept in memory.
+func (a *Accounts) ExportEach(fn func(account types.Account)) {
 	a.immutableTree().IterateRange([]byte{mainPrefix}, []byte{mainPrefix + 1}, true, func(key []byte, value []byte) bool {
 		addressPath := key[1:]
 		if len(addressPath) > types.AddressLength {
@@ -388,6 +415,7 @@ func (a *Accounts) Export(state *types.AppState) {
 		}
 
 		address := types.BytesToAddress(addressPath)
+		cached := a.getFromMap(address) != nil
 		account := a.get(address)
 
 		var balance []types.Balance
@@ -409,6 +437,7 @@ func (a *Accounts) Export(state *types.AppState) {
 			Address: account.address,
 			Balance: balance,
 			Nonce:   account.Nonce,
+			Signer:  account.GetSigner(),
 		}
 
 		if account.IsMultisig() {
@@ -423,11 +452,17 @@ func (a *Accounts) Export(state *types.AppState) {
 			}
 		}
 
-		if len(acc.Balance) == 0 && acc.Nonce == 0 && acc.MultisigData == nil {
+		if !cached {
+			a.lock.Lock()
+			delete(a.list, address)
+			a.lock.Unlock()
+		}
+
+		if len(acc.Balance) == 0 && acc.Nonce == 0 && acc.MultisigData == nil && acc.Signer == nil {
 			return false
 		}
 
-		state.Accounts = append(state.Accounts, acc)
+		fn(acc)
 
 		return false
 	})
@@ -62,6 +62,47 @@ func TestAccounts_SetNonce(t *testing.T) {
 	}
 }
 
+func TestAccounts_SetSigner(t *testing.T) {
+	t.Parallel()
+	mutableTree, _ := tree.NewMutableTree(0, db.NewMemDB(), 1024, 0)
+	b := bus.NewBus()
+	b.SetChecker(checker.NewChecker(b))
+	accounts := NewAccounts(b, mutableTree.GetLastImmutable())
+
+	accounts.SetNonce([20]byte{4}, 5)
+	if _, _, err := mutableTree.Commit(accounts); err != nil {
+		t.Fatal(err)
+	}
+	accounts = NewAccounts(b, mutableTree.GetLastImmutable())
+	if accounts.GetAccount([20]byte{4}).GetSigner() != nil {
+		t.Fatal("signer of account without rotated key is not nil")
+	}
+
+	accounts.SetSigner([20]byte{4}, [20]byte{5})
+	if _, _, err := mutableTree.Commit(accounts); err != nil {
+		t.Fatal(err)
+	}
+	accounts = NewAccounts(b, mutableTree.GetLastImmutable())
+	if signer := accounts.GetAccount([20]byte{4}).GetSigner(); signer == nil || *signer != [20]byte{5} {
+		t.Fatalf("wrong signer %v", signer)
+	}
+	if accounts.GetNonce([20]byte{4}) != 5 {
+		t.Fatal("nonce not equal 5")
+	}
+
+	accounts.SetSigner([20]byte{4}, [20]byte{4})
+	if accounts.GetAccount([20]byte{4}).GetSigner() != nil {
+		t.Fatal("own key of account is not restored")
+	}
+
+	if accounts.GetSigner([20]byte{6}) != nil {
+		t.Fatal("signer of unknown account is not nil")
+	}
+	if accounts.getFromMap([20]byte{6}) != nil {
+		t.Fatal("unknown account is cached")
+	}
+}
+
 func TestAccounts_SetBalance(t *testing.T) {
 	t.Parallel()
 	mutableTree, _ := tree.NewMutableTree(0, db.NewMemDB(), 1024, 0)
@@ -12,6 +12,7 @@ import (
 type Model struct {
 	Nonce        uint64
 	MultisigData Multisig
+	Signer       []types.Address `rlp:"tail"` // the authorised signer since v250 update, at most one item
 
 	address  types.Address
 	coins    []types.CoinID
@@ -19,7 +20,7 @@ type Model struct {
 
 	hasDirtyCoins bool
 	dirtyBalances map[types.CoinID]struct{}
-	isDirty       bool // nonce or multisig data
+	isDirty       bool // nonce, multisig data or signer
 
 	isNew bool
 
@@ -173,6 +174,33 @@ func (model *Model) IsMultisig() bool {
 	return len(model.MultisigData.Weights) > 0
 }
 
+// GetSigner returns the address of the key authorised to sign transactions of the account
+// or nil if transactions are signed by the own key of the account
+func (model *Model) GetSigner() *types.Address {
+	model.lock.RLock()
+	defer model.lock.RUnlock()
+
+	if len(model.Signer) == 0 {
+		return nil
+	}
+
+	signer := model.Signer[0]
+	return &signer
+}
+
+func (model *Model) setSigner(signer types.Address) {
+	model.lock.Lock()
+	if signer == model.address {
+		model.Signer = nil
+	} else {
+		model.Signer = []types.Address{signer}
+	}
+	model.isDirty = true
+	model.lock.Unlock()
+
+	model.markDirty(model.address)
+}
+
 func (model *Model) Multisig() Multisig {
 	model.lock.RLock()
 	defer model.lock.RUnlock()
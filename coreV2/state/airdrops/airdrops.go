@@ -0,0 +1,321 @@
+package airdrops
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"math/bits"
+	"sort"
+	"sync"
+	"sync/atomic"
+
+	"github.com/MinterTeam/minter-go-node/coreV2/state/bus"
+	"github.com/MinterTeam/minter-go-node/coreV2/types"
+	"github.com/MinterTeam/minter-go-node/rlp"
+	"github.com/cosmos/iavl"
+)
+
+const (
+	mainPrefix = byte('g')
+	idLength   = 4
+	wordBits   = 256
+)
+
+type RAirdrops interface {
+	Export(state *types.AppState)
+	GetAirdrop(id uint32) *Model
+	IsClaimed(id uint32, index uint32) bool
+}
+
+// Airdrops keeps coins locked for Merkle airdrops and bitmaps of claimed entries
+type Airdrops struct {
+	list  map[uint32]*Model
+	dirty map[uint32]struct{}
+
+	words      map[string][]byte
+	dirtyWords map[string]struct{}
+
+	nextID      uint32
+	dirtyNextID bool
+
+	bus  *bus.Bus
+	db   atomic.Value
+	lock sync.RWMutex
+}
+
+func New(stateBus *bus.Bus, db *iavl.ImmutableTree) *Airdrops {
+	immutableTree := atomic.Value{}
+	if db != nil {
+		immutableTree.Store(db)
+	}
+	return &Airdrops{
+		bus:        stateBus,
+		db:         immutableTree,
+		list:       map[uint32]*Model{},
+		dirty:      map[uint32]struct{}{},
+		words:      map[string][]byte{},
+		dirtyWords: map[string]struct{}{},
+	}
+}
+
+func (a *Airdrops) immutableTree() *iavl.ImmutableTree {
+	db := a.db.Load()
+	if db == nil {
+		return nil
+	}
+	return db.(*iavl.ImmutableTree)
+}
+
+func (a *Airdrops) SetImmutableTree(immutableTree *iavl.ImmutableTree) {
+	a.db.Store(immutableTree)
+}
+
+func (a *Airdrops) Commit(db *iavl.MutableTree) error {
+	a.lock.Lock()
+	if a.dirtyNextID {
+		a.dirtyNextID = false
+		db.Set([]byte{mainPrefix}, encodeID(a.nextID))
+	}
+
+	ids := make([]uint32, 0, len(a.dirty))
+	for id := range a.dirty {
+		ids = append(ids, id)
+	}
+	a.dirty = map[uint32]struct{}{}
+
+	paths := make([]string, 0, len(a.dirtyWords))
+	for path := range a.dirtyWords {
+		paths = append(paths, path)
+	}
+	a.dirtyWords = map[string]struct{}{}
+	a.lock.Unlock()
+
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i] < ids[j]
+	})
+	for _, id := range ids {
+		model := a.getFromMap(id)
+		model.lock.RLock()
+		data, err := rlp.EncodeToBytes(model)
+		model.lock.RUnlock()
+		if err != nil {
+			return fmt.Errorf("can't encode airdrop %d: %v", id, err)
+		}
+		db.Set(getPath(id), data)
+	}
+
+	sort.Strings(paths)
+	for _, path := range paths {
+		a.lock.RLock()
+		word := a.words[path]
+		a.lock.RUnlock()
+		db.Set([]byte(path), word)
+	}
+
+	return nil
+}
+
+// GetAirdrop returns the airdrop by its ID or nil
+func (a *Airdrops) GetAirdrop(id uint32) *Model {
+	return a.get(id)
+}
+
+// IsClaimed returns true if the entry of the airdrop with the index is already paid out
+func (a *Airdrops) IsClaimed(id uint32, index uint32) bool {
+	word := a.getWord(id, index/wordBits)
+	bit := index % wordBits
+	return word[bit/8]&(1<<(bit%8)) != 0
+}
+
+// CreateAirdrop locks the value of the coin under the root and returns ID of the new airdrop
+func (a *Airdrops) CreateAirdrop(creator types.Address, coin types.CoinID, root types.Hash, value *big.Int, expireHeight uint64) uint32 {
+	id := a.getNextID()
+	a.SetAirdrop(id, creator, coin, root, value, expireHeight)
+	return id
+}
+
+// SetAirdrop locks the value of the coin under the root with the given ID
+func (a *Airdrops) SetAirdrop(id uint32, creator types.Address, coin types.CoinID, root types.Hash, value *big.Int, expireHeight uint64) {
+	model := &Model{
+		Creator:      creator,
+		Coin:         coin,
+		Root:         root,
+		Value:        new(big.Int).Set(value),
+		ExpireHeight: expireHeight,
+		id:           id,
+		markDirty:    a.markDirty(id),
+	}
+	a.setToMap(id, model)
+	model.markDirty()
+
+	a.lock.Lock()
+	if id >= a.nextID {
+		a.nextID = id + 1
+		a.dirtyNextID = true
+	}
+	a.lock.Unlock()
+
+	a.bus.Checker().AddCoin(coin, value)
+}
+
+// Claim marks the entry as paid out and unlocks its value
+func (a *Airdrops) Claim(id uint32, index uint32, value *big.Int) {
+	a.SetClaimed(id, index)
+	a.sub(id, value)
+}
+
+// Reclaim unlocks the rest of the airdrop and returns it
+func (a *Airdrops) Reclaim(id uint32) *big.Int {
+	value := a.get(id).GetValue()
+	a.sub(id, value)
+	return value
+}
+
+// SetClaimed marks the entry as paid out
+func (a *Airdrops) SetClaimed(id uint32, index uint32) {
+	path := getWordPath(id, index/wordBits)
+	word := append([]byte{}, a.getWord(id, index/wordBits)...)
+	bit := index % wordBits
+	word[bit/8] |= 1 << (bit % 8)
+
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	a.words[path] = word
+	a.dirtyWords[path] = struct{}{}
+}
+
+func (a *Airdrops) Export(state *types.AppState) {
+	a.immutableTree().IterateRange([]byte{mainPrefix}, []byte{mainPrefix + 1}, true, func(key []byte, value []byte) bool {
+		switch len(key) {
+		case 1 + idLength:
+			model := &Model{}
+			if err := rlp.DecodeBytes(value, model); err != nil {
+				panic(fmt.Sprintf("failed to decode airdrop: %s", err))
+			}
+			state.Airdrops = append(state.Airdrops, types.Airdrop{
+				ID:           uint64(binary.BigEndian.Uint32(key[1:])),
+				Creator:      model.Creator,
+				Coin:         uint64(model.Coin),
+				Root:         hex.EncodeToString(model.Root[:]),
+				Value:        model.Value.String(),
+				ExpireHeight: model.ExpireHeight,
+			})
+		case 1 + 2*idLength:
+			// the key of the airdrop goes before keys of its bitmap
+			airdrop := &state.Airdrops[len(state.Airdrops)-1]
+			offset := uint64(binary.BigEndian.Uint32(key[1+idLength:])) * wordBits
+			for i, b := range value {
+				for ; b != 0; b &= b - 1 {
+					airdrop.Claimed = append(airdrop.Claimed, offset+uint64(i*8+bits.TrailingZeros8(b)))
+				}
+			}
+		}
+
+		return false
+	})
+}
+
+func (a *Airdrops) sub(id uint32, value *big.Int) {
+	model := a.get(id)
+	model.subValue(value)
+	a.bus.Checker().AddCoin(model.Coin, new(big.Int).Neg(value))
+}
+
+func (a *Airdrops) getNextID() uint32 {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	if a.nextID == 0 {
+		a.nextID = 1
+		if _, enc := a.immutableTree().Get([]byte{mainPrefix}); len(enc) != 0 {
+			a.nextID = binary.BigEndian.Uint32(enc)
+		}
+	}
+
+	return a.nextID
+}
+
+func (a *Airdrops) get(id uint32) *Model {
+	if model := a.getFromMap(id); model != nil {
+		return model
+	}
+
+	_, enc := a.immutableTree().Get(getPath(id))
+	if len(enc) == 0 {
+		return nil
+	}
+
+	model := &Model{}
+	if err := rlp.DecodeBytes(enc, model); err != nil {
+		panic(fmt.Sprintf("failed to decode airdrop %d: %s", id, err))
+	}
+
+	model.id = id
+	model.markDirty = a.markDirty(id)
+
+	a.setToMap(id, model)
+
+	return model
+}
+
+func (a *Airdrops) getWord(id uint32, word uint32) []byte {
+	path := getWordPath(id, word)
+
+	a.lock.RLock()
+	value, ok := a.words[path]
+	a.lock.RUnlock()
+	if ok {
+		return value
+	}
+
+	value = make([]byte, wordBits/8)
+	if _, enc := a.immutableTree().Get([]byte(path)); len(enc) != 0 {
+		copy(value, enc)
+	}
+
+	a.lock.Lock()
+	a.words[path] = value
+	a.lock.Unlock()
+
+	return value
+}
+
+func (a *Airdrops) markDirty(id uint32) func() {
+	return func() {
+		a.lock.Lock()
+		defer a.lock.Unlock()
+		a.dirty[id] = struct{}{}
+	}
+}
+
+func (a *Airdrops) getFromMap(id uint32) *Model {
+	a.lock.RLock()
+	defer a.lock.RUnlock()
+
+	return a.list[id]
+}
+
+func (a *Airdrops) setToMap(id uint32, model *Model) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	a.list[id] = model
+}
+
+func encodeID(id uint32) []byte {
+	b := make([]byte, idLength)
+	binary.BigEndian.PutUint32(b, id)
+	return b
+}
+
+// getPath is mainPrefix + id
+func getPath(id uint32) []byte {
+	return append([]byte{mainPrefix}, encodeID(id)...)
+}
+
+// getWordPath is mainPrefix + id + number of the word of the claim bitmap
+func getWordPath(id uint32, word uint32) string {
+	return string(append(getPath(id), encodeID(word)...))
+}
@@ -0,0 +1,76 @@
+package airdrops
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/MinterTeam/minter-go-node/coreV2/state/bus"
+	"github.com/MinterTeam/minter-go-node/coreV2/state/checker"
+	"github.com/MinterTeam/minter-go-node/coreV2/types"
+	"github.com/MinterTeam/minter-go-node/tree"
+	db "github.com/tendermint/tm-db"
+)
+
+func TestTree(t *testing.T) {
+	t.Parallel()
+	for count := 1; count <= 9; count++ {
+		leaves := make([]types.Hash, count)
+		for i := range leaves {
+			leaves[i] = Leaf(uint32(i), types.Address{byte(i)}, big.NewInt(int64(i+1)))
+		}
+
+		tree := NewTree(leaves)
+		for i, leaf := range leaves {
+			if !VerifyProof(tree.Root(), leaf, tree.Proof(i)) {
+				t.Fatalf("proof of leaf %d of %d is not valid", i, count)
+			}
+		}
+
+		if VerifyProof(tree.Root(), Leaf(0, types.Address{0}, big.NewInt(2)), tree.Proof(0)) {
+			t.Fatalf("proof of wrong value is valid for %d leaves", count)
+		}
+	}
+}
+
+func TestAirdrops(t *testing.T) {
+	t.Parallel()
+	mutableTree, _ := tree.NewMutableTree(0, db.NewMemDB(), 1024, 0)
+	b := bus.NewBus()
+	b.SetChecker(checker.NewChecker(b))
+	a := New(b, mutableTree.GetLastImmutable())
+
+	id := a.CreateAirdrop(types.Address{1}, 1, types.Hash{1}, big.NewInt(100), 10)
+	if id != 1 {
+		t.Fatalf("wrong airdrop id %d", id)
+	}
+	a.Claim(id, 3, big.NewInt(10))
+	a.Claim(id, 300, big.NewInt(20))
+
+	if _, _, err := mutableTree.Commit(a); err != nil {
+		t.Fatal(err)
+	}
+
+	a = New(b, mutableTree.GetLastImmutable())
+	if !a.IsClaimed(id, 3) || !a.IsClaimed(id, 300) || a.IsClaimed(id, 4) {
+		t.Fatal("wrong claimed indexes")
+	}
+	if value := a.GetAirdrop(id).GetValue(); value.Cmp(big.NewInt(70)) != 0 {
+		t.Fatalf("wrong airdrop value %s", value)
+	}
+	if id := a.CreateAirdrop(types.Address{1}, 1, types.Hash{2}, big.NewInt(50), 10); id != 2 {
+		t.Fatalf("wrong next airdrop id %d", id)
+	}
+
+	if _, _, err := mutableTree.Commit(a); err != nil {
+		t.Fatal(err)
+	}
+
+	appState := new(types.AppState)
+	New(b, mutableTree.GetLastImmutable()).Export(appState)
+	if len(appState.Airdrops) != 2 {
+		t.Fatalf("wrong exported airdrops count %d", len(appState.Airdrops))
+	}
+	if claimed := appState.Airdrops[0].Claimed; len(claimed) != 2 || claimed[0] != 3 || claimed[1] != 300 {
+		t.Fatalf("wrong exported claimed indexes %v", claimed)
+	}
+}
@@ -0,0 +1,80 @@
+package airdrops
+
+import (
+	"bytes"
+	"math/big"
+
+	"github.com/MinterTeam/minter-go-node/coreV2/types"
+	"github.com/MinterTeam/minter-go-node/crypto"
+	"github.com/MinterTeam/minter-go-node/rlp"
+)
+
+// Leaf returns the hash of the entry of the airdrop, the index is the position of the bit in the claim bitmap
+func Leaf(index uint32, address types.Address, value *big.Int) types.Hash {
+	data, err := rlp.EncodeToBytes([]interface{}{index, address, value})
+	if err != nil {
+		panic(err)
+	}
+	return crypto.Keccak256Hash(data)
+}
+
+// VerifyProof checks that the leaf belongs to the tree with the root, pairs of nodes are hashed in sorted order
+func VerifyProof(root, leaf types.Hash, proof []types.Hash) bool {
+	hash := leaf
+	for _, node := range proof {
+		hash = hashPair(hash, node)
+	}
+	return hash == root
+}
+
+// Tree is a Merkle tree over leaves of the airdrop
+type Tree struct {
+	levels [][]types.Hash
+}
+
+// NewTree builds the tree, the node without a pair is moved to the next level as is
+func NewTree(leaves []types.Hash) *Tree {
+	levels := [][]types.Hash{leaves}
+	for level := leaves; len(level) > 1; {
+		next := make([]types.Hash, 0, (len(level)+1)/2)
+		for i := 0; i < len(level); i += 2 {
+			if i+1 == len(level) {
+				next = append(next, level[i])
+				continue
+			}
+			next = append(next, hashPair(level[i], level[i+1]))
+		}
+		levels = append(levels, next)
+		level = next
+	}
+	return &Tree{levels: levels}
+}
+
+// Root returns the root of the tree, the root of the empty tree is zero hash
+func (t *Tree) Root() types.Hash {
+	top := t.levels[len(t.levels)-1]
+	if len(top) == 0 {
+		return types.Hash{}
+	}
+	return top[0]
+}
+
+// Proof returns sibling nodes of the leaf from the bottom to the top of the tree
+func (t *Tree) Proof(index int) []types.Hash {
+	var proof []types.Hash
+	for _, level := range t.levels[:len(t.levels)-1] {
+		sibling := index ^ 1
+		if sibling < len(level) {
+			proof = append(proof, level[sibling])
+		}
+		index /= 2
+	}
+	return proof
+}
+
+func hashPair(a, b types.Hash) types.Hash {
+	if bytes.Compare(a[:], b[:]) == 1 {
+		a, b = b, a
+	}
+	return crypto.Keccak256Hash(a[:], b[:])
+}
@@ -0,0 +1,42 @@
+package airdrops
+
+import (
+	"math/big"
+	"sync"
+
+	"github.com/MinterTeam/minter-go-node/coreV2/types"
+)
+
+// Model is an amount of the coin locked by the creator for addresses of the Merkle tree
+type Model struct {
+	Creator      types.Address
+	Coin         types.CoinID
+	Root         types.Hash
+	Value        *big.Int
+	ExpireHeight uint64
+
+	id        uint32
+	markDirty func()
+
+	lock sync.RWMutex
+}
+
+func (m *Model) ID() uint32 {
+	return m.id
+}
+
+// GetValue returns the amount which is not claimed yet
+func (m *Model) GetValue() *big.Int {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	return new(big.Int).Set(m.Value)
+}
+
+func (m *Model) subValue(value *big.Int) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.Value = new(big.Int).Sub(m.Value, value)
+	m.markDirty()
+}
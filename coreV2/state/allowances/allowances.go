@@ -0,0 +1,251 @@
+package allowances
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+	"sync/atomic"
+
+	"github.com/MinterTeam/minter-go-node/coreV2/types"
+	"github.com/MinterTeam/minter-go-node/rlp"
+	"github.com/cosmos/iavl"
+)
+
+const mainPrefix = byte('x')
+
+type RAllowances interface {
+	Export(state *types.AppState)
+	GetAllowance(owner, spender types.Address, coin types.CoinID) *big.Int
+	GetAllowances(owner types.Address) []*Model
+}
+
+// Allowances keeps amounts of coins which owners allowed spenders to transfer from their accounts
+type Allowances struct {
+	list  map[string]*Model
+	dirty map[string]struct{}
+
+	db   atomic.Value
+	lock sync.RWMutex
+}
+
+func New(db *iavl.ImmutableTree) *Allowances {
+	immutableTree := atomic.Value{}
+	if db != nil {
+		immutableTree.Store(db)
+	}
+	return &Allowances{
+		db:    immutableTree,
+		list:  map[string]*Model{},
+		dirty: map[string]struct{}{},
+	}
+}
+
+func (a *Allowances) immutableTree() *iavl.ImmutableTree {
+	db := a.db.Load()
+	if db == nil {
+		return nil
+	}
+	return db.(*iavl.ImmutableTree)
+}
+
+func (a *Allowances) SetImmutableTree(immutableTree *iavl.ImmutableTree) {
+	a.db.Store(immutableTree)
+}
+
+func (a *Allowances) Commit(db *iavl.MutableTree) error {
+	for _, path := range a.getOrderedDirty() {
+		model := a.getFromMap(path)
+
+		a.lock.Lock()
+		delete(a.dirty, path)
+		a.lock.Unlock()
+
+		if model.GetValue().Sign() != 1 {
+			db.Remove([]byte(path))
+			continue
+		}
+
+		data, err := rlp.EncodeToBytes(model)
+		if err != nil {
+			return fmt.Errorf("can't encode allowance of %s: %v", model.owner, err)
+		}
+		db.Set([]byte(path), data)
+	}
+
+	return nil
+}
+
+// GetAllowance returns the amount of the coin of the owner which the spender is allowed to transfer
+func (a *Allowances) GetAllowance(owner, spender types.Address, coin types.CoinID) *big.Int {
+	model := a.get(owner, spender, coin)
+	if model == nil {
+		return big.NewInt(0)
+	}
+
+	return model.GetValue()
+}
+
+// GetAllowances returns positive allowances given by the owner sorted by spender and coin
+func (a *Allowances) GetAllowances(owner types.Address) []*Model {
+	paths := map[string]struct{}{}
+
+	start := append([]byte{mainPrefix}, owner.Bytes()...)
+	a.immutableTree().IterateRange(start, []byte{mainPrefix + 1}, true, func(key []byte, value []byte) bool {
+		if !bytes.HasPrefix(key, start) {
+			return true
+		}
+		paths[string(key)] = struct{}{}
+		return false
+	})
+
+	a.lock.RLock()
+	for path := range a.list {
+		if bytes.HasPrefix([]byte(path), start) {
+			paths[path] = struct{}{}
+		}
+	}
+	a.lock.RUnlock()
+
+	var models []*Model
+	for path := range paths {
+		spender, coin := parsePath([]byte(path))
+		model := a.get(owner, spender, coin)
+		if model == nil || model.GetValue().Sign() != 1 {
+			continue
+		}
+		models = append(models, model)
+	}
+
+	sort.Slice(models, func(i, j int) bool {
+		if models[i].spender != models[j].spender {
+			return bytes.Compare(models[i].spender.Bytes(), models[j].spender.Bytes()) == -1
+		}
+		return models[i].coin < models[j].coin
+	})
+
+	return models
+}
+
+// SetAllowance sets the amount of the coin of the owner which the spender is allowed to transfer, zero removes the allowance
+func (a *Allowances) SetAllowance(owner, spender types.Address, coin types.CoinID, value *big.Int) {
+	a.getOrNew(owner, spender, coin).setValue(value)
+}
+
+// SubAllowance decreases the allowance after a transfer by the spender
+func (a *Allowances) SubAllowance(owner, spender types.Address, coin types.CoinID, value *big.Int) {
+	a.getOrNew(owner, spender, coin).subValue(value)
+}
+
+func (a *Allowances) Export(state *types.AppState) {
+	a.immutableTree().IterateRange([]byte{mainPrefix}, []byte{mainPrefix + 1}, true, func(key []byte, value []byte) bool {
+		model := &Model{}
+		if err := rlp.DecodeBytes(value, model); err != nil {
+			panic(fmt.Sprintf("failed to decode allowance: %s", err))
+		}
+
+		owner := types.BytesToAddress(key[1 : 1+types.AddressLength])
+		spender, coin := parsePath(key)
+		state.Allowances = append(state.Allowances, types.Allowance{
+			Owner:   owner,
+			Spender: spender,
+			Coin:    uint64(coin),
+			Value:   model.Value.String(),
+		})
+
+		return false
+	})
+}
+
+func (a *Allowances) getOrNew(owner, spender types.Address, coin types.CoinID) *Model {
+	if model := a.get(owner, spender, coin); model != nil {
+		return model
+	}
+
+	path := getPath(owner, spender, coin)
+	model := &Model{
+		Value:     big.NewInt(0),
+		owner:     owner,
+		spender:   spender,
+		coin:      coin,
+		markDirty: a.markDirty(path),
+	}
+	a.setToMap(path, model)
+
+	return model
+}
+
+func (a *Allowances) get(owner, spender types.Address, coin types.CoinID) *Model {
+	path := getPath(owner, spender, coin)
+	if model := a.getFromMap(path); model != nil {
+		return model
+	}
+
+	_, enc := a.immutableTree().Get([]byte(path))
+	if len(enc) == 0 {
+		return nil
+	}
+
+	model := &Model{}
+	if err := rlp.DecodeBytes(enc, model); err != nil {
+		panic(fmt.Sprintf("failed to decode allowance of %s: %s", owner, err))
+	}
+
+	model.owner = owner
+	model.spender = spender
+	model.coin = coin
+	model.markDirty = a.markDirty(path)
+
+	a.setToMap(path, model)
+
+	return model
+}
+
+func (a *Allowances) markDirty(path string) func() {
+	return func() {
+		a.lock.Lock()
+		defer a.lock.Unlock()
+		a.dirty[path] = struct{}{}
+	}
+}
+
+func (a *Allowances) getOrderedDirty() []string {
+	a.lock.RLock()
+	keys := make([]string, 0, len(a.dirty))
+	for k := range a.dirty {
+		keys = append(keys, k)
+	}
+	a.lock.RUnlock()
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+func (a *Allowances) getFromMap(path string) *Model {
+	a.lock.RLock()
+	defer a.lock.RUnlock()
+
+	return a.list[path]
+}
+
+func (a *Allowances) setToMap(path string, model *Model) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	a.list[path] = model
+}
+
+// getPath is mainPrefix + owner + spender + coin
+func getPath(owner, spender types.Address, coin types.CoinID) string {
+	path := append([]byte{mainPrefix}, owner.Bytes()...)
+	path = append(path, spender.Bytes()...)
+	return string(append(path, coin.Bytes()...))
+}
+
+func parsePath(path []byte) (spender types.Address, coin types.CoinID) {
+	spender = types.BytesToAddress(path[1+types.AddressLength : 1+2*types.AddressLength])
+	coin = types.BytesToCoinID(path[1+2*types.AddressLength:])
+	return spender, coin
+}
@@ -0,0 +1,55 @@
+package allowances
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/MinterTeam/minter-go-node/coreV2/types"
+	"github.com/MinterTeam/minter-go-node/tree"
+	db "github.com/tendermint/tm-db"
+)
+
+func TestAllowances(t *testing.T) {
+	t.Parallel()
+	mutableTree, _ := tree.NewMutableTree(0, db.NewMemDB(), 1024, 0)
+	a := New(mutableTree.GetLastImmutable())
+
+	owner := types.Address{1}
+	spender := types.Address{2}
+	a.SetAllowance(owner, spender, 0, big.NewInt(100))
+	a.SetAllowance(owner, spender, 1, big.NewInt(50))
+	a.SetAllowance(owner, types.Address{3}, 0, big.NewInt(10))
+	a.SetAllowance(types.Address{4}, spender, 0, big.NewInt(10))
+
+	if _, _, err := mutableTree.Commit(a); err != nil {
+		t.Fatal(err)
+	}
+
+	a = New(mutableTree.GetLastImmutable())
+	if a.GetAllowance(owner, spender, 0).String() != "100" {
+		t.Fatalf("wrong allowance %s", a.GetAllowance(owner, spender, 0))
+	}
+
+	a.SubAllowance(owner, spender, 0, big.NewInt(40))
+	a.SubAllowance(owner, spender, 1, big.NewInt(50))
+	list := a.GetAllowances(owner)
+	if len(list) != 2 {
+		t.Fatalf("wrong allowances count %d", len(list))
+	}
+	if list[0].Spender() != spender || list[0].Coin() != 0 || list[0].GetValue().String() != "60" {
+		t.Fatalf("wrong allowance %s %s %s", list[0].Spender(), list[0].Coin(), list[0].GetValue())
+	}
+	if list[1].Spender() != (types.Address{3}) {
+		t.Fatalf("wrong allowance spender %s", list[1].Spender())
+	}
+
+	if _, _, err := mutableTree.Commit(a); err != nil {
+		t.Fatal(err)
+	}
+
+	appState := new(types.AppState)
+	New(mutableTree.GetLastImmutable()).Export(appState)
+	if len(appState.Allowances) != 3 {
+		t.Fatalf("wrong exported allowances count %d", len(appState.Allowances))
+	}
+}
@@ -0,0 +1,55 @@
+package allowances
+
+import (
+	"math/big"
+	"sync"
+
+	"github.com/MinterTeam/minter-go-node/coreV2/types"
+)
+
+// Model is an amount of the coin of the owner which the spender is allowed to transfer
+type Model struct {
+	Value *big.Int
+
+	owner     types.Address
+	spender   types.Address
+	coin      types.CoinID
+	markDirty func()
+
+	lock sync.RWMutex
+}
+
+func (m *Model) Owner() types.Address {
+	return m.owner
+}
+
+func (m *Model) Spender() types.Address {
+	return m.spender
+}
+
+func (m *Model) Coin() types.CoinID {
+	return m.coin
+}
+
+func (m *Model) GetValue() *big.Int {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	return new(big.Int).Set(m.Value)
+}
+
+func (m *Model) setValue(value *big.Int) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.Value = new(big.Int).Set(value)
+	m.markDirty()
+}
+
+func (m *Model) subValue(value *big.Int) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.Value = new(big.Int).Sub(m.Value, value)
+	m.markDirty()
+}
@@ -0,0 +1,113 @@
+package blskeys
+
+import (
+	"bytes"
+	"encoding/hex"
+	"sort"
+	"sync"
+	"sync/atomic"
+
+	"github.com/MinterTeam/minter-go-node/coreV2/types"
+	"github.com/cosmos/iavl"
+)
+
+const mainPrefix = byte('n')
+
+type RBLSKeys interface {
+	Export(state *types.AppState)
+	GetKey(address types.Address) []byte
+}
+
+// BLSKeys keeps BLS public keys registered by addresses for aggregated multisig signatures
+type BLSKeys struct {
+	list  map[types.Address][]byte
+	dirty map[types.Address]struct{}
+
+	db   atomic.Value
+	lock sync.RWMutex
+}
+
+func New(db *iavl.ImmutableTree) *BLSKeys {
+	immutableTree := atomic.Value{}
+	if db != nil {
+		immutableTree.Store(db)
+	}
+	return &BLSKeys{
+		db:    immutableTree,
+		list:  map[types.Address][]byte{},
+		dirty: map[types.Address]struct{}{},
+	}
+}
+
+func (k *BLSKeys) immutableTree() *iavl.ImmutableTree {
+	db := k.db.Load()
+	if db == nil {
+		return nil
+	}
+	return db.(*iavl.ImmutableTree)
+}
+
+func (k *BLSKeys) SetImmutableTree(immutableTree *iavl.ImmutableTree) {
+	k.db.Store(immutableTree)
+}
+
+func (k *BLSKeys) Commit(db *iavl.MutableTree) error {
+	k.lock.Lock()
+	addresses := make([]types.Address, 0, len(k.dirty))
+	for address := range k.dirty {
+		addresses = append(addresses, address)
+	}
+	k.dirty = map[types.Address]struct{}{}
+	k.lock.Unlock()
+
+	sort.Slice(addresses, func(i, j int) bool {
+		return bytes.Compare(addresses[i].Bytes(), addresses[j].Bytes()) == -1
+	})
+	for _, address := range addresses {
+		db.Set(getPath(address), k.GetKey(address))
+	}
+
+	return nil
+}
+
+// GetKey returns the BLS public key registered by the address or nil
+func (k *BLSKeys) GetKey(address types.Address) []byte {
+	k.lock.RLock()
+	key, ok := k.list[address]
+	k.lock.RUnlock()
+	if ok {
+		return key
+	}
+
+	_, key = k.immutableTree().Get(getPath(address))
+
+	k.lock.Lock()
+	k.list[address] = key
+	k.lock.Unlock()
+
+	return key
+}
+
+// SetKey registers the BLS public key of the address, the previous key is replaced
+func (k *BLSKeys) SetKey(address types.Address, key []byte) {
+	k.lock.Lock()
+	defer k.lock.Unlock()
+
+	k.list[address] = append([]byte{}, key...)
+	k.dirty[address] = struct{}{}
+}
+
+func (k *BLSKeys) Export(state *types.AppState) {
+	k.immutableTree().IterateRange([]byte{mainPrefix}, []byte{mainPrefix + 1}, true, func(key []byte, value []byte) bool {
+		state.BLSKeys = append(state.BLSKeys, types.BLSKey{
+			Address:   types.BytesToAddress(key[1:]),
+			PublicKey: hex.EncodeToString(value),
+		})
+		return false
+	})
+}
+
+// getPath is mainPrefix + address
+func getPath(address types.Address) []byte {
+	return append([]byte{mainPrefix}, address.Bytes()...)
+}
@@ -0,0 +1,40 @@
+package blskeys
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/MinterTeam/minter-go-node/coreV2/types"
+	"github.com/MinterTeam/minter-go-node/tree"
+	db "github.com/tendermint/tm-db"
+)
+
+func TestBLSKeys(t *testing.T) {
+	t.Parallel()
+	mutableTree, _ := tree.NewMutableTree(0, db.NewMemDB(), 1024, 0)
+	k := New(mutableTree.GetLastImmutable())
+
+	key := bytes.Repeat([]byte{1}, types.BLSPublicKeyLength)
+	k.SetKey(types.Address{1}, key)
+	if !bytes.Equal(k.GetKey(types.Address{1}), key) {
+		t.Fatal("key is not set before commit")
+	}
+
+	if _, _, err := mutableTree.Commit(k); err != nil {
+		t.Fatal(err)
+	}
+
+	k = New(mutableTree.GetLastImmutable())
+	if !bytes.Equal(k.GetKey(types.Address{1}), key) {
+		t.Fatal("key is not committed")
+	}
+	if k.GetKey(types.Address{2}) != nil {
+		t.Fatal("unexpected key of address without registration")
+	}
+
+	appState := new(types.AppState)
+	k.Export(appState)
+	if len(appState.BLSKeys) != 1 || appState.BLSKeys[0].Address != (types.Address{1}) {
+		t.Fatalf("wrong exported keys %+v", appState.BLSKeys)
+	}
+}
@@ -1,6 +1,10 @@
 package bus
 
-import eventsdb "github.com/MinterTeam/minter-go-node/coreV2/events"
+import (
+	"github.com/MinterTeam/minter-go-node/coreV2/candles"
+	eventsdb "github.com/MinterTeam/minter-go-node/coreV2/events"
+	"github.com/MinterTeam/minter-go-node/coreV2/lpstats"
+)
 
 type Bus struct {
 	coins       Coins
@@ -11,6 +15,8 @@ type Bus struct {
 	halts       HaltBlocks
 	waitlist    WaitList
 	events      eventsdb.IEventsDB
+	lpStats     lpstats.ILPStats
+	candles     candles.ICandles
 	checker     Checker
 }
 
@@ -82,6 +88,22 @@ func (b *Bus) Events() eventsdb.IEventsDB {
 	return b.events
 }
 
+func (b *Bus) SetLPStats(lpStats lpstats.ILPStats) {
+	b.lpStats = lpStats
+}
+
+func (b *Bus) LPStats() lpstats.ILPStats {
+	return b.lpStats
+}
+
+func (b *Bus) SetCandles(candles candles.ICandles) {
+	b.candles = candles
+}
+
+func (b *Bus) Candles() candles.ICandles {
+	return b.candles
+}
+
 func (b *Bus) SetChecker(checker Checker) {
 	b.checker = checker
 }
@@ -11,6 +11,7 @@ type Coins interface {
 	GetCoinV1(types.CoinID) *Coin
 
 	GetCoin(types.CoinID) *Coin
+	GetCoinBySymbol(types.CoinSymbol, types.CoinVersion) *Coin
 	SubCoinVolume(types.CoinID, *big.Int)
 	SubCoinReserve(types.CoinID, *big.Int)
 }
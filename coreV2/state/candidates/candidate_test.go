@@ -639,6 +639,48 @@ func TestCandidates_Punish(t *testing.T) {
 	}
 }
 
+func TestCandidates_PunishWithJailReasons(t *testing.T) {
+	t.Parallel()
+	mutableTree, _ := tree.NewMutableTree(0, db.NewMemDB(), 1024, 0)
+	b := bus.NewBus()
+	b.SetEvents(eventsdb.NewEventsStore(db.NewMemDB()))
+	b.SetChecker(checker.NewChecker(b))
+	candidates := NewCandidates(b, mutableTree.GetLastImmutable())
+	candidates.EnableJailReasons()
+
+	candidates.Create([20]byte{1}, [20]byte{2}, [20]byte{3}, [32]byte{4}, 10, 0, 0)
+	candidate := candidates.GetCandidate([32]byte{4})
+	candidates.bus.Candidates().Punish(10, candidate.GetTmAddress())
+
+	_, _, err := mutableTree.Commit(candidates)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	candidates = NewCandidates(b, mutableTree.GetLastImmutable())
+	candidates.LoadCandidates()
+	candidate = candidates.GetCandidate([32]byte{4})
+
+	jail := candidate.GetJail()
+	if jail == nil || jail.Reason != JailReasonDowntime || jail.Height != 10 {
+		t.Fatalf("wrong jail %+v", jail)
+	}
+	if candidate.JailedUntil != 10+types.GetJailPeriod() {
+		t.Fatalf("candidate.JailedUntil == %d", candidate.JailedUntil)
+	}
+	if !candidates.IsCandidateJailed([32]byte{4}, candidate.JailedUntil+1) {
+		t.Fatal("candidate should stay jailed until unjail")
+	}
+
+	candidates.Unjail([32]byte{4})
+	if candidates.IsCandidateJailed([32]byte{4}, candidate.JailedUntil+1) {
+		t.Fatal("candidate should not be jailed")
+	}
+	if candidate.GetStatus() != CandidateStatusOnline {
+		t.Fatal("candidate should be online")
+	}
+}
+
 type fr struct {
 	unbounds []*big.Int
 }
@@ -26,6 +26,24 @@ const (
 	MaxDelegatorsPerCandidate = 1000
 )
 
+// Jail reasons
+const (
+	JailReasonDowntime   byte = 0x01
+	JailReasonDoubleSign byte = 0x02
+)
+
+// JailReasonString returns the name of the jail reason
+func JailReasonString(reason byte) string {
+	switch reason {
+	case JailReasonDowntime:
+		return "downtime"
+	case JailReasonDoubleSign:
+		return "double_sign"
+	}
+
+	return "unknown"
+}
+
 const (
 	mainPrefix       = 'c'
 	pubKeyIDPrefix   = mainPrefix + 'p'
@@ -80,6 +98,7 @@ type Candidates struct {
 	lock                sync.RWMutex
 	loaded              bool
 	isChangedPublicKeys bool
+	withJailReasons     bool
 }
 
 // NewCandidates returns newly created Candidates state with a given bus and iavl
@@ -133,10 +152,41 @@ func (c *Candidates) ResetIsChangedPublicKeys() {
 	c.isChangedPublicKeys = false
 }
 
+// IsCandidateJailed returns true if the jail period of a candidate is not over
+// or the candidate was jailed since v250 update and was not unjailed yet
 func (c *Candidates) IsCandidateJailed(pubkey types.Pubkey, block uint64) bool {
 	candidate := c.GetCandidate(pubkey)
 
-	return candidate.JailedUntil >= block
+	return candidate.JailedUntil >= block || candidate.GetJail() != nil
+}
+
+// EnableJailReasons makes candidates keep the reason of a jail, candidates jailed since then stay in jail until Unjail
+func (c *Candidates) EnableJailReasons() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.withJailReasons = true
+}
+
+func (c *Candidates) isWithJailReasons() bool {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return c.withJailReasons
+}
+
+// Unjail releases a candidate from jail and switches it on
+func (c *Candidates) Unjail(pubkey types.Pubkey) {
+	candidate := c.getFromMap(pubkey)
+	candidate.unjail()
+	candidate.setStatus(CandidateStatusOnline)
+	c.bus.Events().AddEvent(&eventsdb.UnjailEvent{ValidatorPubKey: pubkey})
+}
+
+// SetJail sets the current jail of a candidate. Used in Import.
+func (c *Candidates) SetJail(pubkey types.Pubkey, reason byte, height uint64) {
+	candidate := c.getFromMap(pubkey)
+	candidate.jail(reason, height, candidate.JailedUntil)
 }
 
 // Commit writes changes to iavl, may return an error
@@ -344,10 +394,17 @@ func (c *Candidates) CreateWithID(ownerAddress, rewardAddress, controlAddress ty
 // PunishByzantineCandidate finds candidate with given tmAddress and punishes it:
 // 1. Subs 5% of each stake of a candidate
 // 2. Unbond each stake of a candidate
+// 3. Jails a candidate for double signing if jail reasons are enabled
 func (c *Candidates) PunishByzantineCandidate(height uint64, tmAddress types.TmAddress) {
 	candidate := c.GetCandidateByTendermintAddress(tmAddress)
 	stakes := c.GetStakes(candidate.PubKey)
 
+	if c.isWithJailReasons() {
+		jailUntil := height + types.GetJailPeriod()
+		candidate.jail(JailReasonDoubleSign, height, jailUntil)
+		c.bus.Events().AddEvent(&eventsdb.JailEvent{ValidatorPubKey: candidate.PubKey, JailedUntil: jailUntil})
+	}
+
 	for _, stake := range stakes {
 		newValue := big.NewInt(0).Set(stake.Value)
 		newValue.Mul(newValue, big.NewInt(95))
@@ -535,6 +592,11 @@ func (c *Candidates) IsNewCandidateStakeSufficient(coin types.CoinID, stake *big
 		return stakes[i].Cmp(stakes[j]) == 1
 	})
 
+	// there is a free slot if the limit has been raised by the slots schedule
+	if len(stakes) < limit {
+		return true
+	}
+
 	for _, stake := range stakes[:limit] {
 		if stake.Cmp(bipValue) == -1 {
 			return true
@@ -846,7 +908,11 @@ func (c *Candidates) calculateBipValue(coinID types.CoinID, amount *big.Int, inc
 func (c *Candidates) Punish(height uint64, address types.TmAddress) {
 	candidate := c.GetCandidateByTendermintAddress(address)
 	jailUntil := height + types.GetJailPeriod()
-	candidate.jainUntil(jailUntil)
+	if c.isWithJailReasons() {
+		candidate.jail(JailReasonDowntime, height, jailUntil)
+	} else {
+		candidate.jainUntil(jailUntil)
+	}
 	c.bus.Events().AddEvent(&eventsdb.JailEvent{ValidatorPubKey: candidate.PubKey, JailedUntil: jailUntil})
 }
 
@@ -904,12 +970,21 @@ func (c *Candidates) SetStakes(pubkey types.Pubkey, stakes []types.Stake, update
 
 // Export exports all data to the given state
 func (c *Candidates) Export(state *types.AppState) {
+	state.Candidates = []types.Candidate{}
+	c.ExportEach(func(candidate types.Candidate) {
+		state.Candidates = append(state.Candidates, candidate)
+	})
+	c.ExportBlockList(func(pubkey types.Pubkey) {
+		state.BlockListCandidates = append(state.BlockListCandidates, pubkey)
+	})
+}
+
+// ExportEach calls fn for every candidate with its stakes
+func (c *Candidates) ExportEach(fn func(candidate types.Candidate)) {
 	c.LoadCandidatesDeliver()
 	c.LoadStakes()
 
-	candidates := c.GetCandidates()
-	state.Candidates = make([]types.Candidate, 0, len(candidates))
-	for _, candidate := range candidates {
+	for _, candidate := range c.GetCandidates() {
 		candidateStakes := c.GetStakes(candidate.PubKey)
 		stakes := make([]types.Stake, len(candidateStakes))
 		for i, s := range candidateStakes {
@@ -931,7 +1006,12 @@ func (c *Candidates) Export(state *types.AppState) {
 			}
 		}
 
-		state.Candidates = append(state.Candidates, types.Candidate{
+		var jailReason, jailHeight uint64
+		if jail := candidate.GetJail(); jail != nil {
+			jailReason, jailHeight = uint64(jail.Reason), jail.Height
+		}
+
+		fn(types.Candidate{
 			ID:                       uint64(candidate.ID),
 			RewardAddress:            candidate.RewardAddress,
 			OwnerAddress:             candidate.OwnerAddress,
@@ -944,15 +1024,27 @@ func (c *Candidates) Export(state *types.AppState) {
 			Stakes:                   stakes,
 			JailedUntil:              candidate.JailedUntil,
 			LastEditCommissionHeight: candidate.LastEditCommissionHeight,
+			JailReason:               jailReason,
+			JailHeight:               jailHeight,
 		})
 	}
+}
 
+// ExportBlockList calls fn for every blocked public key in descending order
+func (c *Candidates) ExportBlockList(fn func(pubkey types.Pubkey)) {
+	c.LoadCandidatesDeliver()
+
+	blockList := make([]types.Pubkey, 0, len(c.blockList))
 	for pubkey := range c.blockList {
-		state.BlockListCandidates = append(state.BlockListCandidates, pubkey)
+		blockList = append(blockList, pubkey)
 	}
-	sort.SliceStable(state.BlockListCandidates, func(i, j int) bool {
-		return bytes.Compare(state.BlockListCandidates[i].Bytes(), state.BlockListCandidates[j].Bytes()) == 1
+	sort.SliceStable(blockList, func(i, j int) bool {
+		return bytes.Compare(blockList[i].Bytes(), blockList[j].Bytes()) == 1
 	})
+
+	for _, pubkey := range blockList {
+		fn(pubkey)
+	}
 }
 
 func (c *Candidates) getOrderedCandidates() []*Candidate {
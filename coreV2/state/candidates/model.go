@@ -37,6 +37,13 @@ type Candidate struct {
 	ID                       uint32
 	LastEditCommissionHeight uint64
 	JailedUntil              uint64
+	Jail                     []*Jail `rlp:"tail"` // the current jail since v250 update, at most one item
+}
+
+// Jail describes the reason and the start height of candidate's jail
+type Jail struct {
+	Reason byte
+	Height uint64
 }
 
 func (candidate *Candidate) idBytes() []byte {
@@ -82,6 +89,36 @@ func (candidate *Candidate) jainUntil(height uint64) {
 	candidate.JailedUntil = height
 }
 
+func (candidate *Candidate) jail(reason byte, height uint64, until uint64) {
+	candidate.lock.Lock()
+	defer candidate.lock.Unlock()
+
+	candidate.isDirty = true
+	candidate.JailedUntil = until
+	candidate.Jail = []*Jail{{Reason: reason, Height: height}}
+}
+
+func (candidate *Candidate) unjail() {
+	candidate.lock.Lock()
+	defer candidate.lock.Unlock()
+
+	candidate.isDirty = true
+	candidate.Jail = nil
+}
+
+// GetJail returns the current jail of a candidate or nil if it is not jailed or was jailed before v250 update
+func (candidate *Candidate) GetJail() *Jail {
+	candidate.lock.RLock()
+	defer candidate.lock.RUnlock()
+
+	if len(candidate.Jail) == 0 {
+		return nil
+	}
+
+	jail := *candidate.Jail[0]
+	return &jail
+}
+
 func (candidate *Candidate) setReward(address types.Address) {
 	candidate.lock.Lock()
 	defer candidate.lock.Unlock()
@@ -85,8 +85,15 @@ func (c *Checks) UseCheckHash(hash types.Hash) {
 }
 
 func (c *Checks) Export(state *types.AppState) {
+	c.ExportEach(func(check types.UsedCheck) {
+		state.UsedChecks = append(state.UsedChecks, check)
+	})
+}
+
+// ExportEach calls fn for every used check hash
+func (c *Checks) ExportEach(fn func(check types.UsedCheck)) {
 	c.immutableTree().IterateRange([]byte{mainPrefix}, []byte{mainPrefix + 1}, true, func(key []byte, value []byte) bool {
-		state.UsedChecks = append(state.UsedChecks, types.UsedCheck(fmt.Sprintf("%x", key[1:])))
+		fn(types.UsedCheck(fmt.Sprintf("%x", key[1:])))
 		return false
 	})
 }
@@ -31,6 +31,15 @@ func (b *Bus) GetCoin(id types.CoinID) *bus.Coin {
 	}
 }
 
+func (b *Bus) GetCoinBySymbol(symbol types.CoinSymbol, version types.CoinVersion) *bus.Coin {
+	coin := b.coins.GetCoinBySymbol(symbol, version)
+	if coin == nil {
+		return nil
+	}
+
+	return b.GetCoin(coin.id)
+}
+
 func (b *Bus) SubCoinVolume(id types.CoinID, amount *big.Int) {
 	b.coins.SubVolume(id, amount)
 }
@@ -1,6 +1,7 @@
 package coins
 
 import (
+	"encoding/hex"
 	"fmt"
 	"github.com/MinterTeam/minter-go-node/coreV2/state/bus"
 	"github.com/MinterTeam/minter-go-node/coreV2/types"
@@ -14,9 +15,10 @@ import (
 )
 
 const (
-	mainPrefix   = byte('q')
-	infoPrefix   = byte('i')
-	symbolPrefix = byte('s')
+	mainPrefix     = byte('q')
+	infoPrefix     = byte('i')
+	symbolPrefix   = byte('s')
+	metadataPrefix = byte('m')
 
 	BaseVersion types.CoinVersion = 0
 )
@@ -169,6 +171,21 @@ func (c *Coins) Commit(db *iavl.MutableTree) error {
 			db.Set(getCoinInfoPath(id), data)
 		}
 
+		if coin.IsMetadataDirty() {
+			coin.lock.RLock()
+			coin.metadata.lock.Lock()
+			coin.metadata.isDirty = false
+			data, err := rlp.EncodeToBytes(coin.metadata)
+			coin.metadata.lock.Unlock()
+			coin.lock.RUnlock()
+
+			if err != nil {
+				return fmt.Errorf("can't encode object at %d: %v", id, err)
+			}
+
+			db.Set(getCoinMetadataPath(id), data)
+		}
+
 		if coin.IsSymbolInfoDirty() {
 			coin.lock.RLock()
 			coin.symbolInfo.lock.Lock()
@@ -482,6 +499,11 @@ func (c *Coins) RecreateToken(newID types.CoinID, name string, symbol types.Coin
 	c.CreateToken(newID, recreateCoin.Symbol(), name, mintable, burnable, initialAmount, maxSupply, nil)
 }
 
+// SetMetadata sets the description of the coin for wallets and explorers
+func (c *Coins) SetMetadata(id types.CoinID, url, description string, iconHash []byte, decimals uint32) {
+	c.get(id).SetMetadata(url, description, iconHash, decimals)
+}
+
 func (c *Coins) ChangeOwner(symbol types.CoinSymbol, owner types.Address) {
 	info := c.getSymbolInfo(symbol)
 	info.setOwnerAddress(owner)
@@ -541,6 +563,19 @@ func (c *Coins) get(id types.CoinID) *Model {
 		coin.lock.Unlock()
 	}
 
+	// load metadata
+	_, enc = c.immutableTree().Get(getCoinMetadataPath(id))
+	if len(enc) != 0 {
+		var metadata Metadata
+		if err := rlp.DecodeBytes(enc, &metadata); err != nil {
+			panic(fmt.Sprintf("failed to decode coin metadata %d: %s", id, err))
+		}
+
+		coin.lock.Lock()
+		coin.metadata = &metadata
+		coin.lock.Unlock()
+	}
+
 	c.setToMap(id, coin)
 
 	return coin
@@ -611,6 +646,17 @@ func (c *Coins) getOrderedDirtyCoins() []types.CoinID {
 }
 
 func (c *Coins) Export(state *types.AppState) {
+	c.ExportEach(func(coin types.Coin) {
+		state.Coins = append(state.Coins, coin)
+	})
+
+	sort.Slice(state.Coins[:], func(i, j int) bool {
+		return state.Coins[i].ID < state.Coins[j].ID
+	})
+}
+
+// ExportEach calls fn for every coin in order of IDs
+func (c *Coins) ExportEach(fn func(coin types.Coin)) {
 	c.immutableTree().IterateRange([]byte{mainPrefix}, []byte{mainPrefix + 1}, true, func(key []byte, value []byte) bool {
 		if len(key) > 5 {
 			return false
@@ -625,7 +671,17 @@ func (c *Coins) Export(state *types.AppState) {
 			owner = info.OwnerAddress()
 		}
 
-		state.Coins = append(state.Coins, types.Coin{
+		var metadata *types.CoinMetadata
+		if m := coin.Metadata(); m != nil {
+			metadata = &types.CoinMetadata{
+				URL:         m.URL(),
+				Description: m.Description(),
+				IconHash:    hex.EncodeToString(m.IconHash()),
+				Decimals:    m.Decimals(),
+			}
+		}
+
+		fn(types.Coin{
 			ID:           uint64(coin.ID()),
 			Name:         coin.Name(),
 			Symbol:       coin.Symbol(),
@@ -637,14 +693,11 @@ func (c *Coins) Export(state *types.AppState) {
 			Mintable:     coin.Mintable,
 			Burnable:     coin.Burnable,
 			OwnerAddress: owner,
+			Metadata:     metadata,
 		})
 
 		return false
 	})
-
-	sort.Slice(state.Coins[:], func(i, j int) bool {
-		return state.Coins[i].ID < state.Coins[j].ID
-	})
 }
 
 func (c *Coins) getFromMap(id types.CoinID) *Model {
@@ -709,3 +762,7 @@ func getCoinPath(id types.CoinID) []byte {
 func getCoinInfoPath(id types.CoinID) []byte {
 	return append(getCoinPath(id), infoPrefix)
 }
+
+func getCoinMetadataPath(id types.CoinID) []byte {
+	return append(getCoinPath(id), metadataPrefix)
+}
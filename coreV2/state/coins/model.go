@@ -23,6 +23,7 @@ type Model struct {
 	id         types.CoinID
 	info       *Info
 	symbolInfo *SymbolInfo
+	metadata   *Metadata
 
 	markDirty func(symbol types.CoinID)
 	lock      sync.RWMutex
@@ -235,6 +236,40 @@ func (m *Model) IsInfoDirty() bool {
 	return m.info.isDirty
 }
 
+func (m *Model) IsMetadataDirty() bool {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	if m.metadata == nil {
+		return false
+	}
+
+	m.metadata.lock.RLock()
+	defer m.metadata.lock.RUnlock()
+
+	return m.metadata.isDirty
+}
+
+// Metadata returns the metadata set by the owner of the coin or nil if it is not set
+func (m *Model) Metadata() *Metadata {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	return m.metadata
+}
+
+func (m *Model) SetMetadata(url, description string, iconHash []byte, decimals uint32) {
+	m.lock.Lock()
+	if m.metadata == nil {
+		m.metadata = &Metadata{}
+	}
+	m.lock.Unlock()
+
+	m.metadata.set(url, description, iconHash, decimals)
+
+	m.markDirty(m.id)
+}
+
 func (m *Model) IsSymbolInfoDirty() bool {
 	m.lock.RLock()
 	defer m.lock.RUnlock()
@@ -308,3 +343,54 @@ func (i *SymbolInfo) OwnerAddress() *types.Address {
 
 	return i.COwnerAddress
 }
+
+// Metadata is a description of the coin for wallets and explorers set by the owner of the coin
+type Metadata struct {
+	CURL         string
+	CDescription string
+	CIconHash    []byte
+	CDecimals    uint32
+
+	isDirty bool
+	lock    sync.RWMutex
+}
+
+func (i *Metadata) set(url, description string, iconHash []byte, decimals uint32) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	i.CURL = url
+	i.CDescription = description
+	i.CIconHash = append([]byte(nil), iconHash...)
+	i.CDecimals = decimals
+	i.isDirty = true
+}
+
+func (i *Metadata) URL() string {
+	i.lock.RLock()
+	defer i.lock.RUnlock()
+
+	return i.CURL
+}
+
+func (i *Metadata) Description() string {
+	i.lock.RLock()
+	defer i.lock.RUnlock()
+
+	return i.CDescription
+}
+
+func (i *Metadata) IconHash() []byte {
+	i.lock.RLock()
+	defer i.lock.RUnlock()
+
+	return append([]byte(nil), i.CIconHash...)
+}
+
+// Decimals is a hint for displaying amounts of the coin
+func (i *Metadata) Decimals() uint32 {
+	i.lock.RLock()
+	defer i.lock.RUnlock()
+
+	return i.CDecimals
+}
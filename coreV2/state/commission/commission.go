@@ -65,6 +65,14 @@ func (c *Commission) SetImmutableTree(immutableTree *iavl.ImmutableTree) {
 }
 
 func (c *Commission) Export(state *types.AppState) {
+	c.ExportVotesEach(func(vote types.CommissionVote) {
+		state.CommissionVotes = append(state.CommissionVotes, vote)
+	})
+	state.Commission = c.ExportCurrent()
+}
+
+// ExportVotesEach calls fn for every commission vote in order of heights
+func (c *Commission) ExportVotesEach(fn func(vote types.CommissionVote)) {
 	c.immutableTree().IterateRange([]byte{mainPrefix}, []byte{mainPrefix + 1}, true, func(key []byte, value []byte) bool {
 		if len(key) < 8 {
 			return false
@@ -77,7 +85,7 @@ func (c *Commission) Export(state *types.AppState) {
 
 		for _, price := range prices {
 			p := Decode(price.Price)
-			state.CommissionVotes = append(state.CommissionVotes, types.CommissionVote{
+			fn(types.CommissionVote{
 				Height: height,
 				Votes:  price.Votes,
 				Commission: types.Commission{
@@ -130,9 +138,12 @@ func (c *Commission) Export(state *types.AppState) {
 
 		return false
 	})
+}
 
+// ExportCurrent returns current commission prices
+func (c *Commission) ExportCurrent() types.Commission {
 	current := c.GetCommissions()
-	state.Commission = types.Commission{
+	return types.Commission{
 		Coin:                    uint64(current.Coin),
 		PayloadByte:             current.PayloadByte.String(),
 		Send:                    current.Send.String(),
@@ -0,0 +1,304 @@
+package controls
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+
+	"github.com/MinterTeam/minter-go-node/coreV2/types"
+	"github.com/MinterTeam/minter-go-node/rlp"
+	"github.com/cosmos/iavl"
+)
+
+const (
+	mainPrefix   = byte('k')
+	coinIDLength = 4
+)
+
+type RControls interface {
+	Export(state *types.AppState)
+	IsControlled(coin types.CoinID) bool
+	IsFrozen(coin types.CoinID, address types.Address) bool
+	IsBlocklisted(coin types.CoinID, address types.Address) bool
+	GetRestrictions(coin types.CoinID) []*Model
+}
+
+// Controls keeps tokens controlled by their owners and addresses which are frozen or blocklisted for these tokens
+type Controls struct {
+	controlled      map[types.CoinID]bool
+	dirtyControlled map[types.CoinID]struct{}
+
+	list  map[string]*Model
+	dirty map[string]struct{}
+
+	db   atomic.Value
+	lock sync.RWMutex
+}
+
+func New(db *iavl.ImmutableTree) *Controls {
+	immutableTree := atomic.Value{}
+	if db != nil {
+		immutableTree.Store(db)
+	}
+	return &Controls{
+		db:              immutableTree,
+		controlled:      map[types.CoinID]bool{},
+		dirtyControlled: map[types.CoinID]struct{}{},
+		list:            map[string]*Model{},
+		dirty:           map[string]struct{}{},
+	}
+}
+
+func (c *Controls) immutableTree() *iavl.ImmutableTree {
+	db := c.db.Load()
+	if db == nil {
+		return nil
+	}
+	return db.(*iavl.ImmutableTree)
+}
+
+func (c *Controls) SetImmutableTree(immutableTree *iavl.ImmutableTree) {
+	c.db.Store(immutableTree)
+}
+
+func (c *Controls) Commit(db *iavl.MutableTree) error {
+	c.lock.Lock()
+	coins := make([]types.CoinID, 0, len(c.dirtyControlled))
+	for coin := range c.dirtyControlled {
+		coins = append(coins, coin)
+	}
+	c.dirtyControlled = map[types.CoinID]struct{}{}
+	c.lock.Unlock()
+
+	sort.Slice(coins, func(i, j int) bool {
+		return coins[i] < coins[j]
+	})
+	for _, coin := range coins {
+		db.Set(getCoinPath(coin), []byte{1})
+	}
+
+	for _, path := range c.getOrderedDirty() {
+		model := c.getFromMap(path)
+
+		c.lock.Lock()
+		delete(c.dirty, path)
+		c.lock.Unlock()
+
+		if model.isEmpty() {
+			db.Remove([]byte(path))
+			continue
+		}
+
+		data, err := rlp.EncodeToBytes(model)
+		if err != nil {
+			return fmt.Errorf("can't encode restriction of %s: %v", model.address, err)
+		}
+		db.Set([]byte(path), data)
+	}
+
+	return nil
+}
+
+// IsControlled returns true if the owner of the token can freeze and blocklist addresses
+func (c *Controls) IsControlled(coin types.CoinID) bool {
+	c.lock.RLock()
+	controlled, ok := c.controlled[coin]
+	c.lock.RUnlock()
+	if ok {
+		return controlled
+	}
+
+	_, enc := c.immutableTree().Get(getCoinPath(coin))
+	controlled = len(enc) != 0
+
+	c.lock.Lock()
+	c.controlled[coin] = controlled
+	c.lock.Unlock()
+
+	return controlled
+}
+
+// IsFrozen returns true if the address can't spend its balance of the controlled coin
+func (c *Controls) IsFrozen(coin types.CoinID, address types.Address) bool {
+	model := c.get(coin, address)
+	return model != nil && model.IsFrozen()
+}
+
+// IsBlocklisted returns true if the address can't receive the controlled coin
+func (c *Controls) IsBlocklisted(coin types.CoinID, address types.Address) bool {
+	model := c.get(coin, address)
+	return model != nil && model.IsBlocklisted()
+}
+
+// GetRestrictions returns frozen and blocklisted addresses of the coin sorted by address
+func (c *Controls) GetRestrictions(coin types.CoinID) []*Model {
+	paths := map[string]struct{}{}
+
+	start := getCoinPath(coin)
+	c.immutableTree().IterateRange(start, []byte{mainPrefix + 1}, true, func(key []byte, value []byte) bool {
+		if !bytes.HasPrefix(key, start) {
+			return true
+		}
+		if len(key) == len(start) {
+			return false
+		}
+		paths[string(key)] = struct{}{}
+		return false
+	})
+
+	c.lock.RLock()
+	for path := range c.list {
+		if bytes.HasPrefix([]byte(path), start) {
+			paths[path] = struct{}{}
+		}
+	}
+	c.lock.RUnlock()
+
+	var models []*Model
+	for path := range paths {
+		model := c.get(coin, parseAddress([]byte(path)))
+		if model == nil || model.isEmpty() {
+			continue
+		}
+		models = append(models, model)
+	}
+
+	sort.Slice(models, func(i, j int) bool {
+		return bytes.Compare(models[i].address.Bytes(), models[j].address.Bytes()) == -1
+	})
+
+	return models
+}
+
+// SetControlled marks the token as controlled by its owner
+func (c *Controls) SetControlled(coin types.CoinID) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.controlled[coin] = true
+	c.dirtyControlled[coin] = struct{}{}
+}
+
+// SetRestriction sets whether the address is frozen and blocklisted for the coin
+func (c *Controls) SetRestriction(coin types.CoinID, address types.Address, frozen, blocklisted bool) {
+	c.getOrNew(coin, address).set(frozen, blocklisted)
+}
+
+func (c *Controls) Export(state *types.AppState) {
+	c.immutableTree().IterateRange([]byte{mainPrefix}, []byte{mainPrefix + 1}, true, func(key []byte, value []byte) bool {
+		coin := types.BytesToCoinID(key[1 : 1+coinIDLength])
+		if len(key) == 1+coinIDLength {
+			state.ControlledCoins = append(state.ControlledCoins, types.ControlledCoin{Coin: uint64(coin)})
+			return false
+		}
+
+		model := &Model{}
+		if err := rlp.DecodeBytes(value, model); err != nil {
+			panic(fmt.Sprintf("failed to decode restriction: %s", err))
+		}
+
+		// the key of the coin goes before keys of its addresses
+		controlled := &state.ControlledCoins[len(state.ControlledCoins)-1]
+		address := parseAddress(key)
+		if model.Frozen {
+			controlled.Frozen = append(controlled.Frozen, address)
+		}
+		if model.Blocklisted {
+			controlled.Blocklist = append(controlled.Blocklist, address)
+		}
+
+		return false
+	})
+}
+
+func (c *Controls) getOrNew(coin types.CoinID, address types.Address) *Model {
+	if model := c.get(coin, address); model != nil {
+		return model
+	}
+
+	path := getPath(coin, address)
+	model := &Model{
+		coin:      coin,
+		address:   address,
+		markDirty: c.markDirty(path),
+	}
+	c.setToMap(path, model)
+
+	return model
+}
+
+func (c *Controls) get(coin types.CoinID, address types.Address) *Model {
+	path := getPath(coin, address)
+	if model := c.getFromMap(path); model != nil {
+		return model
+	}
+
+	_, enc := c.immutableTree().Get([]byte(path))
+	if len(enc) == 0 {
+		return nil
+	}
+
+	model := &Model{}
+	if err := rlp.DecodeBytes(enc, model); err != nil {
+		panic(fmt.Sprintf("failed to decode restriction of %s: %s", address, err))
+	}
+
+	model.coin = coin
+	model.address = address
+	model.markDirty = c.markDirty(path)
+
+	c.setToMap(path, model)
+
+	return model
+}
+
+func (c *Controls) markDirty(path string) func() {
+	return func() {
+		c.lock.Lock()
+		defer c.lock.Unlock()
+		c.dirty[path] = struct{}{}
+	}
+}
+
+func (c *Controls) getOrderedDirty() []string {
+	c.lock.RLock()
+	keys := make([]string, 0, len(c.dirty))
+	for k := range c.dirty {
+		keys = append(keys, k)
+	}
+	c.lock.RUnlock()
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+func (c *Controls) getFromMap(path string) *Model {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return c.list[path]
+}
+
+func (c *Controls) setToMap(path string, model *Model) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.list[path] = model
+}
+
+// getCoinPath is mainPrefix + coin
+func getCoinPath(coin types.CoinID) []byte {
+	return append([]byte{mainPrefix}, coin.Bytes()...)
+}
+
+// getPath is mainPrefix + coin + address
+func getPath(coin types.CoinID, address types.Address) string {
+	return string(append(getCoinPath(coin), address.Bytes()...))
+}
+
+func parseAddress(path []byte) types.Address {
+	return types.BytesToAddress(path[1+coinIDLength:])
+}
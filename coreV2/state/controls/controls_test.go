@@ -0,0 +1,54 @@
+package controls
+
+import (
+	"testing"
+
+	"github.com/MinterTeam/minter-go-node/coreV2/types"
+	"github.com/MinterTeam/minter-go-node/tree"
+	db "github.com/tendermint/tm-db"
+)
+
+func TestControls(t *testing.T) {
+	t.Parallel()
+	mutableTree, _ := tree.NewMutableTree(0, db.NewMemDB(), 1024, 0)
+	c := New(mutableTree.GetLastImmutable())
+
+	c.SetControlled(1)
+	c.SetRestriction(1, types.Address{2}, true, false)
+	c.SetRestriction(1, types.Address{1}, false, true)
+	c.SetRestriction(1, types.Address{3}, true, true)
+
+	if _, _, err := mutableTree.Commit(c); err != nil {
+		t.Fatal(err)
+	}
+
+	c = New(mutableTree.GetLastImmutable())
+	if !c.IsControlled(1) || c.IsControlled(2) {
+		t.Fatal("wrong controlled coins")
+	}
+	if !c.IsFrozen(1, types.Address{2}) || c.IsBlocklisted(1, types.Address{2}) {
+		t.Fatal("wrong restriction of frozen address")
+	}
+
+	c.SetRestriction(1, types.Address{3}, false, false)
+	list := c.GetRestrictions(1)
+	if len(list) != 2 {
+		t.Fatalf("wrong restrictions count %d", len(list))
+	}
+	if list[0].Address() != (types.Address{1}) || !list[0].IsBlocklisted() || list[0].IsFrozen() {
+		t.Fatalf("wrong restriction %s", list[0].Address())
+	}
+
+	if _, _, err := mutableTree.Commit(c); err != nil {
+		t.Fatal(err)
+	}
+
+	appState := new(types.AppState)
+	New(mutableTree.GetLastImmutable()).Export(appState)
+	if len(appState.ControlledCoins) != 1 {
+		t.Fatalf("wrong exported controlled coins count %d", len(appState.ControlledCoins))
+	}
+	if controlled := appState.ControlledCoins[0]; len(controlled.Frozen) != 1 || len(controlled.Blocklist) != 1 {
+		t.Fatalf("wrong exported restrictions %+v", controlled)
+	}
+}
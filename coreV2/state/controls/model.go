@@ -0,0 +1,59 @@
+package controls
+
+import (
+	"sync"
+
+	"github.com/MinterTeam/minter-go-node/coreV2/types"
+)
+
+// Model is a restriction set by the owner of a controlled token for the address
+type Model struct {
+	Frozen      bool
+	Blocklisted bool
+
+	coin      types.CoinID
+	address   types.Address
+	markDirty func()
+
+	lock sync.RWMutex
+}
+
+func (m *Model) Coin() types.CoinID {
+	return m.coin
+}
+
+func (m *Model) Address() types.Address {
+	return m.address
+}
+
+// IsFrozen returns true if the address can't spend its balance of the coin
+func (m *Model) IsFrozen() bool {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	return m.Frozen
+}
+
+// IsBlocklisted returns true if the address can't receive the coin
+func (m *Model) IsBlocklisted() bool {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	return m.Blocklisted
+}
+
+func (m *Model) set(frozen, blocklisted bool) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.Frozen = frozen
+	m.Blocklisted = blocklisted
+	m.markDirty()
+}
+
+func (m *Model) isEmpty() bool {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	return !m.Frozen && !m.Blocklisted
+}
@@ -216,6 +216,13 @@ func (f *FrozenFunds) Delete(height uint64) {
 }
 
 func (f *FrozenFunds) Export(state *types.AppState, height uint64) {
+	f.ExportEach(height, func(frozenFund types.FrozenFund) {
+		state.FrozenFunds = append(state.FrozenFunds, frozenFund)
+	})
+}
+
+// ExportEach calls fn for every frozen fund which unlocks after given height
+func (f *FrozenFunds) ExportEach(height uint64, fn func(frozenFund types.FrozenFund)) {
 	for i := height; i <= height+types.GetUnbondPeriodWithChain(types.ChainMainnet); i++ {
 		frozenFunds := f.get(i)
 		if frozenFunds == nil {
@@ -224,7 +231,7 @@ func (f *FrozenFunds) Export(state *types.AppState, height uint64) {
 
 		frozenFunds.lock.RLock()
 		for _, frozenFund := range frozenFunds.List {
-			state.FrozenFunds = append(state.FrozenFunds, types.FrozenFund{
+			fn(types.FrozenFund{
 				Height:       i,
 				Address:      frozenFund.Address,
 				CandidateKey: frozenFund.CandidateKey,
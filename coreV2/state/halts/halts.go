@@ -178,6 +178,13 @@ func (hb *HaltBlocks) Delete(height uint64) {
 }
 
 func (hb *HaltBlocks) Export(state *types.AppState) {
+	hb.ExportEach(func(haltBlock types.HaltBlock) {
+		state.HaltBlocks = append(state.HaltBlocks, haltBlock)
+	})
+}
+
+// ExportEach calls fn for every halt block vote in order of heights
+func (hb *HaltBlocks) ExportEach(fn func(haltBlock types.HaltBlock)) {
 	hb.immutableTree().IterateRange([]byte{mainPrefix}, []byte{mainPrefix + 1}, true, func(key []byte, value []byte) bool {
 		if len(key) < 8 {
 			return false
@@ -190,7 +197,7 @@ func (hb *HaltBlocks) Export(state *types.AppState) {
 		}
 
 		for _, haltBlock := range halts.List {
-			state.HaltBlocks = append(state.HaltBlocks, types.HaltBlock{
+			fn(types.HaltBlock{
 				Height:       height,
 				CandidateKey: haltBlock.Pubkey,
 			})
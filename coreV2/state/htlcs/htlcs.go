@@ -0,0 +1,248 @@
+package htlcs
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+	"sync/atomic"
+
+	"github.com/MinterTeam/minter-go-node/coreV2/state/bus"
+	"github.com/MinterTeam/minter-go-node/coreV2/types"
+	"github.com/MinterTeam/minter-go-node/rlp"
+	"github.com/cosmos/iavl"
+)
+
+const (
+	mainPrefix = byte('j')
+	idLength   = 4
+)
+
+type RHTLCs interface {
+	Export(state *types.AppState)
+	GetHTLC(id uint32) *Model
+}
+
+// HTLCs keeps coins locked by hash time-locked contracts
+type HTLCs struct {
+	list  map[uint32]*Model
+	dirty map[uint32]struct{}
+
+	nextID      uint32
+	dirtyNextID bool
+
+	bus  *bus.Bus
+	db   atomic.Value
+	lock sync.RWMutex
+}
+
+func New(stateBus *bus.Bus, db *iavl.ImmutableTree) *HTLCs {
+	immutableTree := atomic.Value{}
+	if db != nil {
+		immutableTree.Store(db)
+	}
+	return &HTLCs{
+		bus:   stateBus,
+		db:    immutableTree,
+		list:  map[uint32]*Model{},
+		dirty: map[uint32]struct{}{},
+	}
+}
+
+func (h *HTLCs) immutableTree() *iavl.ImmutableTree {
+	db := h.db.Load()
+	if db == nil {
+		return nil
+	}
+	return db.(*iavl.ImmutableTree)
+}
+
+func (h *HTLCs) SetImmutableTree(immutableTree *iavl.ImmutableTree) {
+	h.db.Store(immutableTree)
+}
+
+func (h *HTLCs) Commit(db *iavl.MutableTree) error {
+	h.lock.Lock()
+	if h.dirtyNextID {
+		h.dirtyNextID = false
+		db.Set([]byte{mainPrefix}, encodeID(h.nextID))
+	}
+
+	ids := make([]uint32, 0, len(h.dirty))
+	for id := range h.dirty {
+		ids = append(ids, id)
+	}
+	h.dirty = map[uint32]struct{}{}
+	h.lock.Unlock()
+
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i] < ids[j]
+	})
+	for _, id := range ids {
+		model := h.getFromMap(id)
+		if model.GetValue().Sign() == 0 {
+			db.Remove(getPath(id))
+
+			h.lock.Lock()
+			delete(h.list, id)
+			h.lock.Unlock()
+			continue
+		}
+
+		model.lock.RLock()
+		data, err := rlp.EncodeToBytes(model)
+		model.lock.RUnlock()
+		if err != nil {
+			return fmt.Errorf("can't encode htlc %d: %v", id, err)
+		}
+		db.Set(getPath(id), data)
+	}
+
+	return nil
+}
+
+// GetHTLC returns the contract by its ID or nil if it does not exist or is already closed
+func (h *HTLCs) GetHTLC(id uint32) *Model {
+	return h.get(id)
+}
+
+// CreateHTLC locks the value of the coin and returns ID of the new contract
+func (h *HTLCs) CreateHTLC(sender, recipient types.Address, coin types.CoinID, value *big.Int, hashLock types.Hash, timeout uint64) uint32 {
+	id := h.getNextID()
+	h.SetHTLC(id, sender, recipient, coin, value, hashLock, timeout)
+	return id
+}
+
+// SetHTLC locks the value of the coin by the contract with the given ID
+func (h *HTLCs) SetHTLC(id uint32, sender, recipient types.Address, coin types.CoinID, value *big.Int, hashLock types.Hash, timeout uint64) {
+	model := &Model{
+		Sender:    sender,
+		Recipient: recipient,
+		Coin:      coin,
+		Value:     new(big.Int).Set(value),
+		HashLock:  hashLock,
+		Timeout:   timeout,
+		id:        id,
+		markDirty: h.markDirty(id),
+	}
+	h.setToMap(id, model)
+	model.markDirty()
+
+	h.lock.Lock()
+	if id >= h.nextID {
+		h.nextID = id + 1
+		h.dirtyNextID = true
+	}
+	h.lock.Unlock()
+
+	h.bus.Checker().AddCoin(coin, value)
+}
+
+// Close unlocks the value of the contract, deletes it and returns the value.
+// It is used both for claims and refunds, the caller decides who gets the value.
+func (h *HTLCs) Close(id uint32) *big.Int {
+	model := h.get(id)
+	value := model.close()
+
+	h.bus.Checker().AddCoin(model.Coin, new(big.Int).Neg(value))
+	return value
+}
+
+func (h *HTLCs) Export(state *types.AppState) {
+	h.immutableTree().IterateRange([]byte{mainPrefix}, []byte{mainPrefix + 1}, true, func(key []byte, value []byte) bool {
+		if len(key) != 1+idLength {
+			return false
+		}
+
+		model := &Model{}
+		if err := rlp.DecodeBytes(value, model); err != nil {
+			panic(fmt.Sprintf("failed to decode htlc: %s", err))
+		}
+		state.HTLCs = append(state.HTLCs, types.HTLC{
+			ID:        uint64(binary.BigEndian.Uint32(key[1:])),
+			Sender:    model.Sender,
+			Recipient: model.Recipient,
+			Coin:      uint64(model.Coin),
+			Value:     model.Value.String(),
+			HashLock:  hex.EncodeToString(model.HashLock[:]),
+			Timeout:   model.Timeout,
+		})
+
+		return false
+	})
+}
+
+func (h *HTLCs) getNextID() uint32 {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	if h.nextID == 0 {
+		h.nextID = 1
+		if _, enc := h.immutableTree().Get([]byte{mainPrefix}); len(enc) != 0 {
+			h.nextID = binary.BigEndian.Uint32(enc)
+		}
+	}
+
+	return h.nextID
+}
+
+func (h *HTLCs) get(id uint32) *Model {
+	if model := h.getFromMap(id); model != nil {
+		if model.GetValue().Sign() == 0 {
+			return nil
+		}
+		return model
+	}
+
+	_, enc := h.immutableTree().Get(getPath(id))
+	if len(enc) == 0 {
+		return nil
+	}
+
+	model := &Model{}
+	if err := rlp.DecodeBytes(enc, model); err != nil {
+		panic(fmt.Sprintf("failed to decode htlc %d: %s", id, err))
+	}
+
+	model.id = id
+	model.markDirty = h.markDirty(id)
+
+	h.setToMap(id, model)
+
+	return model
+}
+
+func (h *HTLCs) markDirty(id uint32) func() {
+	return func() {
+		h.lock.Lock()
+		defer h.lock.Unlock()
+		h.dirty[id] = struct{}{}
+	}
+}
+
+func (h *HTLCs) getFromMap(id uint32) *Model {
+	h.lock.RLock()
+	defer h.lock.RUnlock()
+
+	return h.list[id]
+}
+
+func (h *HTLCs) setToMap(id uint32, model *Model) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	h.list[id] = model
+}
+
+func encodeID(id uint32) []byte {
+	b := make([]byte, idLength)
+	binary.BigEndian.PutUint32(b, id)
+	return b
+}
+
+// getPath is mainPrefix + id
+func getPath(id uint32) []byte {
+	return append([]byte{mainPrefix}, encodeID(id)...)
+}
@@ -0,0 +1,60 @@
+package htlcs
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/MinterTeam/minter-go-node/coreV2/state/bus"
+	"github.com/MinterTeam/minter-go-node/coreV2/state/checker"
+	"github.com/MinterTeam/minter-go-node/coreV2/types"
+	"github.com/MinterTeam/minter-go-node/tree"
+	db "github.com/tendermint/tm-db"
+)
+
+func TestHTLCs(t *testing.T) {
+	t.Parallel()
+	mutableTree, _ := tree.NewMutableTree(0, db.NewMemDB(), 1024, 0)
+	b := bus.NewBus()
+	b.SetChecker(checker.NewChecker(b))
+	h := New(b, mutableTree.GetLastImmutable())
+
+	hashLock := HashLock(make([]byte, PreimageLength))
+	id := h.CreateHTLC(types.Address{1}, types.Address{2}, 0, big.NewInt(100), hashLock, 10)
+	if id != 1 {
+		t.Fatalf("wrong htlc id %d", id)
+	}
+	if id := h.CreateHTLC(types.Address{1}, types.Address{3}, 0, big.NewInt(50), hashLock, 20); id != 2 {
+		t.Fatalf("wrong next htlc id %d", id)
+	}
+
+	if _, _, err := mutableTree.Commit(h); err != nil {
+		t.Fatal(err)
+	}
+
+	h = New(b, mutableTree.GetLastImmutable())
+	htlc := h.GetHTLC(1)
+	if htlc == nil || htlc.Recipient != (types.Address{2}) || htlc.HashLock != hashLock || htlc.GetValue().Cmp(big.NewInt(100)) != 0 {
+		t.Fatal("wrong htlc after commit")
+	}
+	if value := h.Close(1); value.Cmp(big.NewInt(100)) != 0 {
+		t.Fatalf("wrong closed value %s", value)
+	}
+	if h.GetHTLC(1) != nil {
+		t.Fatal("closed htlc is returned")
+	}
+
+	if _, _, err := mutableTree.Commit(h); err != nil {
+		t.Fatal(err)
+	}
+
+	h = New(b, mutableTree.GetLastImmutable())
+	if h.GetHTLC(1) != nil {
+		t.Fatal("closed htlc is not deleted")
+	}
+
+	appState := new(types.AppState)
+	h.Export(appState)
+	if len(appState.HTLCs) != 1 || appState.HTLCs[0].ID != 2 || appState.HTLCs[0].Value != "50" {
+		t.Fatalf("wrong exported htlcs %v", appState.HTLCs)
+	}
+}
@@ -0,0 +1,56 @@
+package htlcs
+
+import (
+	"crypto/sha256"
+	"math/big"
+	"sync"
+
+	"github.com/MinterTeam/minter-go-node/coreV2/types"
+)
+
+// PreimageLength is the length of secrets of contracts, it is fixed so that the same secret
+// can be revealed on other chains which limit its size
+const PreimageLength = 32
+
+// HashLock returns the SHA-256 hash of the secret, which is used by HTLCs of most other chains
+func HashLock(preimage []byte) types.Hash {
+	return sha256.Sum256(preimage)
+}
+
+// Model is an amount of the coin locked by the sender, which the recipient can claim with the preimage of HashLock
+// before Timeout height, and the sender can refund after it
+type Model struct {
+	Sender    types.Address
+	Recipient types.Address
+	Coin      types.CoinID
+	Value     *big.Int
+	HashLock  types.Hash
+	Timeout   uint64
+
+	id        uint32
+	markDirty func()
+
+	lock sync.RWMutex
+}
+
+func (m *Model) ID() uint32 {
+	return m.id
+}
+
+// GetValue returns the locked amount, it is zero when the contract is claimed or refunded
+func (m *Model) GetValue() *big.Int {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	return new(big.Int).Set(m.Value)
+}
+
+func (m *Model) close() *big.Int {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	value := m.Value
+	m.Value = big.NewInt(0)
+	m.markDirty()
+	return value
+}
@@ -0,0 +1,76 @@
+package schedules
+
+import (
+	"math/big"
+	"sync"
+
+	"github.com/MinterTeam/minter-go-node/coreV2/types"
+)
+
+// Model is a send of the value of the coin from the owner to the recipient, repeated Count more times every Interval blocks from NextHeight.
+// The value of all remaining sends is locked when the schedule is created.
+type Model struct {
+	Owner      types.Address
+	Recipient  types.Address
+	Coin       types.CoinID
+	Value      *big.Int
+	NextHeight uint64
+	Interval   uint64
+	Count      uint32
+
+	id        uint32
+	markDirty func()
+
+	lock sync.RWMutex
+}
+
+func (m *Model) ID() uint32 {
+	return m.id
+}
+
+// GetCount returns the number of sends which are not executed yet
+func (m *Model) GetCount() uint32 {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	return m.Count
+}
+
+// GetNextHeight returns the height of the next send
+func (m *Model) GetNextHeight() uint64 {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	return m.NextHeight
+}
+
+// GetRemaining returns the locked value of all sends which are not executed yet
+func (m *Model) GetRemaining() *big.Int {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	return remaining(m.Value, m.Count)
+}
+
+func (m *Model) pay() {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.Count--
+	if m.Count > 0 {
+		m.NextHeight += m.Interval
+	}
+	m.markDirty()
+}
+
+func (m *Model) cancel() {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.Count = 0
+	m.markDirty()
+}
+
+func remaining(value *big.Int, count uint32) *big.Int {
+	return new(big.Int).Mul(value, big.NewInt(int64(count)))
+}
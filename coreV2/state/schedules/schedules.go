@@ -0,0 +1,364 @@
+package schedules
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+	"sync/atomic"
+
+	"github.com/MinterTeam/minter-go-node/coreV2/state/bus"
+	"github.com/MinterTeam/minter-go-node/coreV2/types"
+	"github.com/MinterTeam/minter-go-node/rlp"
+	"github.com/cosmos/iavl"
+)
+
+const (
+	mainPrefix   = byte('e')
+	idLength     = 4
+	heightLength = 8
+)
+
+type RSchedules interface {
+	Export(state *types.AppState)
+	GetSchedule(id uint32) *Model
+	GetDue(height uint64) []uint32
+}
+
+// Schedules keeps coins locked for sends which are executed at the beginning of blocks
+type Schedules struct {
+	list  map[uint32]*Model
+	dirty map[uint32]struct{}
+
+	due      map[uint64][]uint32
+	dirtyDue map[uint64]struct{}
+
+	nextID      uint32
+	dirtyNextID bool
+
+	bus  *bus.Bus
+	db   atomic.Value
+	lock sync.RWMutex
+}
+
+func New(stateBus *bus.Bus, db *iavl.ImmutableTree) *Schedules {
+	immutableTree := atomic.Value{}
+	if db != nil {
+		immutableTree.Store(db)
+	}
+	return &Schedules{
+		bus:      stateBus,
+		db:       immutableTree,
+		list:     map[uint32]*Model{},
+		dirty:    map[uint32]struct{}{},
+		due:      map[uint64][]uint32{},
+		dirtyDue: map[uint64]struct{}{},
+	}
+}
+
+func (s *Schedules) immutableTree() *iavl.ImmutableTree {
+	db := s.db.Load()
+	if db == nil {
+		return nil
+	}
+	return db.(*iavl.ImmutableTree)
+}
+
+func (s *Schedules) SetImmutableTree(immutableTree *iavl.ImmutableTree) {
+	s.db.Store(immutableTree)
+}
+
+func (s *Schedules) Commit(db *iavl.MutableTree) error {
+	s.lock.Lock()
+	if s.dirtyNextID {
+		s.dirtyNextID = false
+		db.Set([]byte{mainPrefix}, encodeID(s.nextID))
+	}
+
+	ids := make([]uint32, 0, len(s.dirty))
+	for id := range s.dirty {
+		ids = append(ids, id)
+	}
+	s.dirty = map[uint32]struct{}{}
+
+	heights := make([]uint64, 0, len(s.dirtyDue))
+	for height := range s.dirtyDue {
+		heights = append(heights, height)
+	}
+	s.dirtyDue = map[uint64]struct{}{}
+	s.lock.Unlock()
+
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i] < ids[j]
+	})
+	for _, id := range ids {
+		model := s.getFromMap(id)
+		if model.GetCount() == 0 {
+			db.Remove(getPath(id))
+
+			s.lock.Lock()
+			delete(s.list, id)
+			s.lock.Unlock()
+			continue
+		}
+
+		model.lock.RLock()
+		data, err := rlp.EncodeToBytes(model)
+		model.lock.RUnlock()
+		if err != nil {
+			return fmt.Errorf("can't encode schedule %d: %v", id, err)
+		}
+		db.Set(getPath(id), data)
+	}
+
+	sort.Slice(heights, func(i, j int) bool {
+		return heights[i] < heights[j]
+	})
+	for _, height := range heights {
+		s.lock.Lock()
+		list := s.due[height]
+		delete(s.due, height)
+		s.lock.Unlock()
+
+		if len(list) == 0 {
+			db.Remove(getDuePath(height))
+			continue
+		}
+
+		data, err := rlp.EncodeToBytes(list)
+		if err != nil {
+			return fmt.Errorf("can't encode schedules of height %d: %v", height, err)
+		}
+		db.Set(getDuePath(height), data)
+	}
+
+	return nil
+}
+
+// GetSchedule returns the schedule by its ID or nil
+func (s *Schedules) GetSchedule(id uint32) *Model {
+	return s.get(id)
+}
+
+// GetDue returns IDs of schedules with sends at the height
+func (s *Schedules) GetDue(height uint64) []uint32 {
+	return append([]uint32{}, s.getDue(height)...)
+}
+
+// CreateSchedule locks the value of all sends and returns ID of the new schedule
+func (s *Schedules) CreateSchedule(owner, recipient types.Address, coin types.CoinID, value *big.Int, startHeight, interval uint64, count uint32) uint32 {
+	id := s.getNextID()
+	s.SetSchedule(id, owner, recipient, coin, value, startHeight, interval, count)
+	return id
+}
+
+// SetSchedule locks the value of all sends of the schedule with the given ID
+func (s *Schedules) SetSchedule(id uint32, owner, recipient types.Address, coin types.CoinID, value *big.Int, nextHeight, interval uint64, count uint32) {
+	model := &Model{
+		Owner:      owner,
+		Recipient:  recipient,
+		Coin:       coin,
+		Value:      new(big.Int).Set(value),
+		NextHeight: nextHeight,
+		Interval:   interval,
+		Count:      count,
+		id:         id,
+		markDirty:  s.markDirty(id),
+	}
+	s.setToMap(id, model)
+	model.markDirty()
+	s.addDue(nextHeight, id)
+
+	s.lock.Lock()
+	if id >= s.nextID {
+		s.nextID = id + 1
+		s.dirtyNextID = true
+	}
+	s.lock.Unlock()
+
+	s.bus.Checker().AddCoin(coin, model.GetRemaining())
+}
+
+// Pay unlocks the value of the current send and moves the schedule to the height of the next send if there is one.
+// The list of schedules at the current height is kept until DeleteDue.
+func (s *Schedules) Pay(id uint32) *Model {
+	model := s.get(id)
+	model.pay()
+	if model.GetCount() > 0 {
+		s.addDue(model.GetNextHeight(), id)
+	}
+
+	s.bus.Checker().AddCoin(model.Coin, new(big.Int).Neg(model.Value))
+	return model
+}
+
+// Cancel unlocks the value of all remaining sends, deletes the schedule and returns the value
+func (s *Schedules) Cancel(id uint32) *big.Int {
+	model := s.get(id)
+	value := model.GetRemaining()
+	s.removeDue(model.GetNextHeight(), id)
+	model.cancel()
+
+	s.bus.Checker().AddCoin(model.Coin, new(big.Int).Neg(value))
+	return value
+}
+
+// DeleteDue deletes the list of schedules with sends at the height
+func (s *Schedules) DeleteDue(height uint64) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.due[height] = nil
+	s.dirtyDue[height] = struct{}{}
+}
+
+func (s *Schedules) Export(state *types.AppState) {
+	s.immutableTree().IterateRange([]byte{mainPrefix}, []byte{mainPrefix + 1}, true, func(key []byte, value []byte) bool {
+		if len(key) != 1+idLength {
+			return false
+		}
+
+		model := &Model{}
+		if err := rlp.DecodeBytes(value, model); err != nil {
+			panic(fmt.Sprintf("failed to decode schedule: %s", err))
+		}
+		state.Schedules = append(state.Schedules, types.Schedule{
+			ID:         uint64(binary.BigEndian.Uint32(key[1:])),
+			Owner:      model.Owner,
+			Recipient:  model.Recipient,
+			Coin:       uint64(model.Coin),
+			Value:      model.Value.String(),
+			NextHeight: model.NextHeight,
+			Interval:   model.Interval,
+			Count:      uint64(model.Count),
+		})
+
+		return false
+	})
+}
+
+func (s *Schedules) addDue(height uint64, id uint32) {
+	list := append(s.GetDue(height), id)
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.due[height] = list
+	s.dirtyDue[height] = struct{}{}
+}
+
+func (s *Schedules) removeDue(height uint64, id uint32) {
+	var list []uint32
+	for _, item := range s.getDue(height) {
+		if item != id {
+			list = append(list, item)
+		}
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.due[height] = list
+	s.dirtyDue[height] = struct{}{}
+}
+
+func (s *Schedules) getDue(height uint64) []uint32 {
+	s.lock.RLock()
+	list, ok := s.due[height]
+	s.lock.RUnlock()
+	if ok {
+		return list
+	}
+
+	// lists are read at every block, so only changed ones are kept in memory until commit
+	_, enc := s.immutableTree().Get(getDuePath(height))
+	if len(enc) != 0 {
+		if err := rlp.DecodeBytes(enc, &list); err != nil {
+			panic(fmt.Sprintf("failed to decode schedules of height %d: %s", height, err))
+		}
+	}
+
+	return list
+}
+
+func (s *Schedules) getNextID() uint32 {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.nextID == 0 {
+		s.nextID = 1
+		if _, enc := s.immutableTree().Get([]byte{mainPrefix}); len(enc) != 0 {
+			s.nextID = binary.BigEndian.Uint32(enc)
+		}
+	}
+
+	return s.nextID
+}
+
+func (s *Schedules) get(id uint32) *Model {
+	if model := s.getFromMap(id); model != nil {
+		if model.GetCount() == 0 {
+			return nil
+		}
+		return model
+	}
+
+	_, enc := s.immutableTree().Get(getPath(id))
+	if len(enc) == 0 {
+		return nil
+	}
+
+	model := &Model{}
+	if err := rlp.DecodeBytes(enc, model); err != nil {
+		panic(fmt.Sprintf("failed to decode schedule %d: %s", id, err))
+	}
+
+	model.id = id
+	model.markDirty = s.markDirty(id)
+
+	s.setToMap(id, model)
+
+	return model
+}
+
+func (s *Schedules) markDirty(id uint32) func() {
+	return func() {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+		s.dirty[id] = struct{}{}
+	}
+}
+
+func (s *Schedules) getFromMap(id uint32) *Model {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return s.list[id]
+}
+
+func (s *Schedules) setToMap(id uint32, model *Model) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.list[id] = model
+}
+
+func encodeID(id uint32) []byte {
+	b := make([]byte, idLength)
+	binary.BigEndian.PutUint32(b, id)
+	return b
+}
+
+// getPath is mainPrefix + id
+func getPath(id uint32) []byte {
+	return append([]byte{mainPrefix}, encodeID(id)...)
+}
+
+// getDuePath is mainPrefix + height, the value is the list of IDs of schedules with sends at the height
+func getDuePath(height uint64) []byte {
+	b := make([]byte, 1+heightLength)
+	b[0] = mainPrefix
+	binary.BigEndian.PutUint64(b[1:], height)
+	return b
+}
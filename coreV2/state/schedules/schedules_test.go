@@ -0,0 +1,82 @@
+package schedules
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/MinterTeam/minter-go-node/coreV2/state/bus"
+	"github.com/MinterTeam/minter-go-node/coreV2/state/checker"
+	"github.com/MinterTeam/minter-go-node/coreV2/types"
+	"github.com/MinterTeam/minter-go-node/tree"
+	db "github.com/tendermint/tm-db"
+)
+
+func TestSchedules(t *testing.T) {
+	t.Parallel()
+	mutableTree, _ := tree.NewMutableTree(0, db.NewMemDB(), 1024, 0)
+	b := bus.NewBus()
+	b.SetChecker(checker.NewChecker(b))
+	s := New(b, mutableTree.GetLastImmutable())
+
+	id := s.CreateSchedule(types.Address{1}, types.Address{2}, 0, big.NewInt(100), 10, 5, 3)
+	if id != 1 {
+		t.Fatalf("wrong schedule id %d", id)
+	}
+	if id := s.CreateSchedule(types.Address{1}, types.Address{3}, 0, big.NewInt(10), 10, 0, 1); id != 2 {
+		t.Fatalf("wrong next schedule id %d", id)
+	}
+
+	if _, _, err := mutableTree.Commit(s); err != nil {
+		t.Fatal(err)
+	}
+
+	s = New(b, mutableTree.GetLastImmutable())
+	if due := s.GetDue(10); len(due) != 2 || due[0] != 1 || due[1] != 2 {
+		t.Fatalf("wrong due schedules %v", due)
+	}
+	for _, id := range s.GetDue(10) {
+		s.Pay(id)
+	}
+	s.DeleteDue(10)
+
+	if _, _, err := mutableTree.Commit(s); err != nil {
+		t.Fatal(err)
+	}
+
+	s = New(b, mutableTree.GetLastImmutable())
+	if due := s.GetDue(10); len(due) != 0 {
+		t.Fatalf("due schedules are not deleted %v", due)
+	}
+	if due := s.GetDue(15); len(due) != 1 || due[0] != 1 {
+		t.Fatalf("wrong next due schedules %v", due)
+	}
+	if s.GetSchedule(2) != nil {
+		t.Fatal("finished schedule is not deleted")
+	}
+	schedule := s.GetSchedule(1)
+	if schedule.GetCount() != 2 || schedule.GetNextHeight() != 15 || schedule.GetRemaining().Cmp(big.NewInt(200)) != 0 {
+		t.Fatalf("wrong schedule after send: count %d, next height %d", schedule.GetCount(), schedule.GetNextHeight())
+	}
+
+	appState := new(types.AppState)
+	s.Export(appState)
+	if len(appState.Schedules) != 1 || appState.Schedules[0].ID != 1 || appState.Schedules[0].Count != 2 {
+		t.Fatalf("wrong exported schedules %v", appState.Schedules)
+	}
+
+	if value := s.Cancel(1); value.Cmp(big.NewInt(200)) != 0 {
+		t.Fatalf("wrong cancelled value %s", value)
+	}
+
+	if _, _, err := mutableTree.Commit(s); err != nil {
+		t.Fatal(err)
+	}
+
+	s = New(b, mutableTree.GetLastImmutable())
+	if s.GetSchedule(1) != nil || len(s.GetDue(15)) != 0 {
+		t.Fatal("cancelled schedule is not deleted")
+	}
+	if id := s.CreateSchedule(types.Address{1}, types.Address{2}, 0, big.NewInt(1), 20, 0, 1); id != 3 {
+		t.Fatalf("wrong schedule id after cancel %d", id)
+	}
+}
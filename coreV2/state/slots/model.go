@@ -0,0 +1,32 @@
+package slots
+
+import (
+	"sync"
+
+	"github.com/MinterTeam/minter-go-node/coreV2/types"
+)
+
+// Item is an entry of the slots schedule, its counts are used since given height
+type Item struct {
+	Height     uint64
+	Validators uint32
+	Candidates uint32
+}
+
+type Model struct {
+	Votes      []types.Pubkey
+	Validators uint32
+	Candidates uint32
+
+	height    uint64
+	markDirty func()
+
+	lock sync.Mutex
+}
+
+func (m *Model) addVote(pubkey types.Pubkey) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.Votes = append(m.Votes, pubkey)
+	m.markDirty()
+}
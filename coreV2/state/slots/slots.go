@@ -0,0 +1,351 @@
+package slots
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+
+	"github.com/MinterTeam/minter-go-node/coreV2/types"
+	"github.com/MinterTeam/minter-go-node/coreV2/validators"
+	"github.com/MinterTeam/minter-go-node/rlp"
+	"github.com/cosmos/iavl"
+)
+
+const mainPrefix = byte('l')
+
+type RSlots interface {
+	Export(state *types.AppState)
+	GetValidatorsCount(height uint64) int
+	GetCandidatesCount(height uint64) int
+	GetSchedule() []Item
+	GetVotes(height uint64) []*Model
+	IsVoteExists(height uint64, pubkey types.Pubkey) bool
+}
+
+// Slots keeps the height schedule of validators and candidates slots counts and votes for its changes.
+// Without schedule entries the counts of coreV2/validators are used.
+type Slots struct {
+	schedule      []Item
+	scheduleDirty bool
+	loaded        bool
+
+	list      map[uint64][]*Model
+	dirty     map[uint64]struct{}
+	forDelete uint64
+
+	db   atomic.Value
+	lock sync.RWMutex
+}
+
+func New(db *iavl.ImmutableTree) *Slots {
+	immutableTree := atomic.Value{}
+	if db != nil {
+		immutableTree.Store(db)
+	}
+	return &Slots{
+		db:    immutableTree,
+		list:  map[uint64][]*Model{},
+		dirty: map[uint64]struct{}{},
+	}
+}
+
+func (s *Slots) immutableTree() *iavl.ImmutableTree {
+	db := s.db.Load()
+	if db == nil {
+		return nil
+	}
+	return db.(*iavl.ImmutableTree)
+}
+
+func (s *Slots) SetImmutableTree(immutableTree *iavl.ImmutableTree) {
+	s.db.Store(immutableTree)
+}
+
+func (s *Slots) Export(state *types.AppState) {
+	s.ExportScheduleEach(func(item types.Slots) {
+		state.Slots = append(state.Slots, item)
+	})
+	s.ExportVotesEach(func(vote types.SlotsVote) {
+		state.SlotsVotes = append(state.SlotsVotes, vote)
+	})
+}
+
+// ExportScheduleEach calls fn for every change of validators and candidates count
+func (s *Slots) ExportScheduleEach(fn func(item types.Slots)) {
+	for _, item := range s.GetSchedule() {
+		fn(types.Slots{
+			Height:     item.Height,
+			Validators: uint64(item.Validators),
+			Candidates: uint64(item.Candidates),
+		})
+	}
+}
+
+// ExportVotesEach calls fn for every slots vote in order of heights
+func (s *Slots) ExportVotesEach(fn func(vote types.SlotsVote)) {
+	s.immutableTree().IterateRange([]byte{mainPrefix}, []byte{mainPrefix + 1}, true, func(key []byte, value []byte) bool {
+		if len(key) < 9 {
+			return false
+		}
+		height := binary.LittleEndian.Uint64(key[1:])
+		votes := s.get(height)
+		if votes == nil {
+			return false
+		}
+
+		for _, vote := range votes {
+			fn(types.SlotsVote{
+				Height:     height,
+				Votes:      vote.Votes,
+				Validators: uint64(vote.Validators),
+				Candidates: uint64(vote.Candidates),
+			})
+		}
+
+		return false
+	})
+}
+
+func (s *Slots) Commit(db *iavl.MutableTree) error {
+	s.lock.Lock()
+	if s.scheduleDirty {
+		s.scheduleDirty = false
+		data, err := rlp.EncodeToBytes(s.schedule)
+		if err != nil {
+			s.lock.Unlock()
+			return fmt.Errorf("can't encode slots schedule: %v", err)
+		}
+		db.Set([]byte{mainPrefix}, data)
+	}
+	dirties := s.getOrderedDirty()
+	s.lock.Unlock()
+
+	for _, height := range dirties {
+		models := s.getFromMap(height)
+
+		s.lock.Lock()
+		delete(s.dirty, height)
+		s.lock.Unlock()
+
+		data, err := rlp.EncodeToBytes(models)
+		if err != nil {
+			return fmt.Errorf("can't encode object at %d: %v", height, err)
+		}
+
+		db.Set(getPath(height), data)
+	}
+
+	if s.forDelete != 0 {
+		db.Remove(getPath(s.forDelete))
+		s.lock.Lock()
+		delete(s.list, s.forDelete)
+		s.forDelete = 0
+		s.lock.Unlock()
+	}
+
+	return nil
+}
+
+// GetValidatorsCount returns available validators slots for given height
+func (s *Slots) GetValidatorsCount(height uint64) int {
+	if item := s.getItem(height); item != nil {
+		return int(item.Validators)
+	}
+
+	return validators.GetValidatorsCountForBlock(height)
+}
+
+// GetCandidatesCount returns available candidates slots for given height
+func (s *Slots) GetCandidatesCount(height uint64) int {
+	if item := s.getItem(height); item != nil {
+		return int(item.Candidates)
+	}
+
+	return validators.GetCandidatesCountForBlock(height)
+}
+
+// GetSchedule returns schedule entries sorted by height
+func (s *Slots) GetSchedule() []Item {
+	s.loadSchedule()
+
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	schedule := make([]Item, len(s.schedule))
+	copy(schedule, s.schedule)
+
+	return schedule
+}
+
+// SetSlots sets slots counts used since given height, later entries of the schedule are removed
+func (s *Slots) SetSlots(height uint64, validators, candidates uint32) {
+	s.loadSchedule()
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	i := sort.Search(len(s.schedule), func(i int) bool {
+		return s.schedule[i].Height >= height
+	})
+
+	s.schedule = append(s.schedule[:i], Item{
+		Height:     height,
+		Validators: validators,
+		Candidates: candidates,
+	})
+	s.scheduleDirty = true
+}
+
+func (s *Slots) getItem(height uint64) *Item {
+	s.loadSchedule()
+
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	for i := len(s.schedule) - 1; i >= 0; i-- {
+		if s.schedule[i].Height <= height {
+			item := s.schedule[i]
+			return &item
+		}
+	}
+
+	return nil
+}
+
+func (s *Slots) loadSchedule() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.loaded {
+		return
+	}
+	s.loaded = true
+
+	_, enc := s.immutableTree().Get([]byte{mainPrefix})
+	if len(enc) == 0 {
+		return
+	}
+
+	if err := rlp.DecodeBytes(enc, &s.schedule); err != nil {
+		panic(fmt.Sprintf("failed to decode slots schedule: %s", err))
+	}
+}
+
+func (s *Slots) GetVotes(height uint64) []*Model {
+	return s.get(height)
+}
+
+func (s *Slots) IsVoteExists(height uint64, pubkey types.Pubkey) bool {
+	for _, model := range s.get(height) {
+		for _, vote := range model.Votes {
+			if vote == pubkey {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func (s *Slots) AddVote(height uint64, pubkey types.Pubkey, validators, candidates uint32) {
+	s.getOrNew(height, validators, candidates).addVote(pubkey)
+}
+
+func (s *Slots) Delete(height uint64) {
+	if len(s.get(height)) == 0 {
+		return
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.forDelete = height
+}
+
+func (s *Slots) getOrNew(height uint64, validators, candidates uint32) *Model {
+	models := s.get(height)
+
+	for _, model := range models {
+		if model.Validators == validators && model.Candidates == candidates {
+			return model
+		}
+	}
+
+	model := &Model{
+		Validators: validators,
+		Candidates: candidates,
+		height:     height,
+		markDirty:  s.markDirty(height),
+	}
+	s.setToMap(height, append(models, model))
+	return model
+}
+
+func (s *Slots) get(height uint64) []*Model {
+	if models := s.getFromMap(height); models != nil {
+		return models
+	}
+
+	_, enc := s.immutableTree().Get(getPath(height))
+	if len(enc) == 0 {
+		return nil
+	}
+
+	var models []*Model
+	if err := rlp.DecodeBytes(enc, &models); err != nil {
+		panic(fmt.Sprintf("failed to decode slots votes at height %d: %s", height, err))
+	}
+
+	for _, model := range models {
+		model.markDirty = s.markDirty(height)
+		model.height = height
+	}
+
+	s.setToMap(height, models)
+
+	return models
+}
+
+func (s *Slots) markDirty(height uint64) func() {
+	return func() {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+		s.dirty[height] = struct{}{}
+	}
+}
+
+func (s *Slots) getOrderedDirty() []uint64 {
+	keys := make([]uint64, 0, len(s.dirty))
+	for k := range s.dirty {
+		keys = append(keys, k)
+	}
+
+	sort.SliceStable(keys, func(i, j int) bool {
+		return keys[i] < keys[j]
+	})
+
+	return keys
+}
+
+func (s *Slots) getFromMap(height uint64) []*Model {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return s.list[height]
+}
+
+func (s *Slots) setToMap(height uint64, models []*Model) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.list[height] = models
+}
+
+func getPath(height uint64) []byte {
+	b := make([]byte, 8)
+	binary.LittleEndian.PutUint64(b, height)
+
+	return append([]byte{mainPrefix}, b...)
+}
@@ -0,0 +1,91 @@
+package slots
+
+import (
+	"testing"
+
+	"github.com/MinterTeam/minter-go-node/coreV2/types"
+	"github.com/MinterTeam/minter-go-node/coreV2/validators"
+	"github.com/MinterTeam/minter-go-node/tree"
+	db "github.com/tendermint/tm-db"
+)
+
+func TestSlotsSchedule(t *testing.T) {
+	t.Parallel()
+	mutableTree, _ := tree.NewMutableTree(0, db.NewMemDB(), 1024, 0)
+	s := New(mutableTree.GetLastImmutable())
+
+	if s.GetValidatorsCount(10) != validators.GetValidatorsCountForBlock(10) {
+		t.Fatal("default validators count expected")
+	}
+	if s.GetCandidatesCount(10) != validators.GetCandidatesCountForBlock(10) {
+		t.Fatal("default candidates count expected")
+	}
+
+	s.SetSlots(100, 10, 20)
+	s.SetSlots(200, 30, 40)
+
+	_, _, err := mutableTree.Commit(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s = New(mutableTree.GetLastImmutable())
+
+	if s.GetValidatorsCount(99) != validators.GetValidatorsCountForBlock(99) {
+		t.Fatal("default validators count expected")
+	}
+	if s.GetValidatorsCount(150) != 10 || s.GetCandidatesCount(150) != 20 {
+		t.Fatal("wrong slots counts")
+	}
+	if s.GetValidatorsCount(200) != 30 || s.GetCandidatesCount(200) != 40 {
+		t.Fatal("wrong slots counts")
+	}
+
+	s.SetSlots(150, 5, 5)
+	if len(s.GetSchedule()) != 2 {
+		t.Fatal("later schedule entries should be removed")
+	}
+	if s.GetValidatorsCount(300) != 5 {
+		t.Fatal("wrong slots counts")
+	}
+}
+
+func TestSlotsVotes(t *testing.T) {
+	t.Parallel()
+	mutableTree, _ := tree.NewMutableTree(0, db.NewMemDB(), 1024, 0)
+	s := New(mutableTree.GetLastImmutable())
+
+	height := uint64(100)
+	s.AddVote(height, types.Pubkey{1}, 10, 20)
+	s.AddVote(height, types.Pubkey{2}, 10, 20)
+	s.AddVote(height, types.Pubkey{3}, 15, 20)
+
+	_, _, err := mutableTree.Commit(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s = New(mutableTree.GetLastImmutable())
+	if len(s.GetVotes(height)) != 2 {
+		t.Fatal("wrong votes count")
+	}
+	if !s.IsVoteExists(height, types.Pubkey{3}) || s.IsVoteExists(height, types.Pubkey{4}) {
+		t.Fatal("wrong vote existence")
+	}
+
+	appState := &types.AppState{}
+	s.Export(appState)
+	if len(appState.SlotsVotes) != 2 {
+		t.Fatal("wrong exported votes count")
+	}
+
+	s.Delete(height)
+	_, _, err = mutableTree.Commit(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if s.GetVotes(height) != nil {
+		t.Fatal("votes not deleted")
+	}
+}
@@ -2,17 +2,26 @@ package state
 
 import (
 	"encoding/hex"
+	"github.com/MinterTeam/minter-go-node/coreV2/candles"
 	eventsdb "github.com/MinterTeam/minter-go-node/coreV2/events"
+	"github.com/MinterTeam/minter-go-node/coreV2/lpstats"
 	"github.com/MinterTeam/minter-go-node/coreV2/state/accounts"
+	"github.com/MinterTeam/minter-go-node/coreV2/state/airdrops"
+	"github.com/MinterTeam/minter-go-node/coreV2/state/allowances"
 	"github.com/MinterTeam/minter-go-node/coreV2/state/app"
+	"github.com/MinterTeam/minter-go-node/coreV2/state/blskeys"
 	"github.com/MinterTeam/minter-go-node/coreV2/state/bus"
 	"github.com/MinterTeam/minter-go-node/coreV2/state/candidates"
 	"github.com/MinterTeam/minter-go-node/coreV2/state/checker"
 	"github.com/MinterTeam/minter-go-node/coreV2/state/checks"
 	"github.com/MinterTeam/minter-go-node/coreV2/state/coins"
 	"github.com/MinterTeam/minter-go-node/coreV2/state/commission"
+	"github.com/MinterTeam/minter-go-node/coreV2/state/controls"
 	"github.com/MinterTeam/minter-go-node/coreV2/state/frozenfunds"
 	"github.com/MinterTeam/minter-go-node/coreV2/state/halts"
+	"github.com/MinterTeam/minter-go-node/coreV2/state/htlcs"
+	"github.com/MinterTeam/minter-go-node/coreV2/state/schedules"
+	"github.com/MinterTeam/minter-go-node/coreV2/state/slots"
 	"github.com/MinterTeam/minter-go-node/coreV2/state/swap"
 	"github.com/MinterTeam/minter-go-node/coreV2/state/update"
 	"github.com/MinterTeam/minter-go-node/coreV2/state/validators"
@@ -54,6 +63,13 @@ func (cs *CheckState) Export() types.AppState {
 	cs.Swap().Export(appState)
 	cs.Commission().Export(appState)
 	cs.Updates().Export(appState)
+	cs.Slots().Export(appState)
+	cs.Allowances().Export(appState)
+	cs.Controls().Export(appState)
+	cs.Airdrops().Export(appState)
+	cs.BLSKeys().Export(appState)
+	cs.Schedules().Export(appState)
+	cs.HTLCs().Export(appState)
 
 	return *appState
 }
@@ -100,6 +116,34 @@ func (cs *CheckState) Commission() commission.RCommission {
 	return cs.state.Commission
 }
 
+func (cs *CheckState) Slots() slots.RSlots {
+	return cs.state.Slots
+}
+
+func (cs *CheckState) Allowances() allowances.RAllowances {
+	return cs.state.Allowances
+}
+
+func (cs *CheckState) Controls() controls.RControls {
+	return cs.state.Controls
+}
+
+func (cs *CheckState) Airdrops() airdrops.RAirdrops {
+	return cs.state.Airdrops
+}
+
+func (cs *CheckState) BLSKeys() blskeys.RBLSKeys {
+	return cs.state.BLSKeys
+}
+
+func (cs *CheckState) Schedules() schedules.RSchedules {
+	return cs.state.Schedules
+}
+
+func (cs *CheckState) HTLCs() htlcs.RHTLCs {
+	return cs.state.HTLCs
+}
+
 type State struct {
 	App         *app.App
 	Validators  *validators.Validators
@@ -114,6 +158,13 @@ type State struct {
 	Swap        *swap.Swap
 	Commission  *commission.Commission
 	Updates     *update.Update
+	Slots       *slots.Slots
+	Allowances  *allowances.Allowances
+	Controls    *controls.Controls
+	Airdrops    *airdrops.Airdrops
+	BLSKeys     *blskeys.BLSKeys
+	Schedules   *schedules.Schedules
+	HTLCs       *htlcs.HTLCs
 
 	db     db.DB
 	events eventsdb.IEventsDB
@@ -157,6 +208,23 @@ func NewCheckStateAtHeight(height uint64, db db.DB) (*CheckState, error) {
 	return newCheckStateForTree(iavlTree, nil, db, 0)
 }
 
+// SetLPStats enables tracking of swap pool fees and liquidity of providers
+func (s *State) SetLPStats(lpStats lpstats.ILPStats) {
+	s.bus.SetLPStats(lpStats)
+}
+
+// SetCandles enables aggregation of swaps into candles
+func (s *State) SetCandles(candles candles.ICandles) {
+	s.bus.SetCandles(candles)
+}
+
+// AddEvent adds the event of the current block to the events DB, if it is set
+func (s *State) AddEvent(event eventsdb.Event) {
+	if s.events != nil {
+		s.events.AddEvent(event)
+	}
+}
+
 func (s *State) Tree() tree.MTree {
 	return s.tree
 }
@@ -197,6 +265,13 @@ func (s *State) Commit() ([]byte, error) {
 		s.Swap,
 		s.Commission,
 		s.Updates,
+		s.Slots,
+		s.Allowances,
+		s.Controls,
+		s.Airdrops,
+		s.BLSKeys,
+		s.Schedules,
+		s.HTLCs,
 	)
 	if err != nil {
 		return hash, err
@@ -219,43 +294,121 @@ func (s *State) Commit() ([]byte, error) {
 func (s *State) Import(state types.AppState) error {
 	defer s.Checker.RemoveBaseCoin()
 
-	s.App.SetMaxGas(state.MaxGas)
-	totalSlash := helpers.StringToBigInt(state.TotalSlashed)
-	s.App.SetTotalSlashed(totalSlash)
+	s.importApp(state.MaxGas, state.TotalSlashed)
 	s.App.SetCoinsCount(uint32(len(state.Coins)))
 
 	for _, a := range state.Accounts {
-		if a.MultisigData != nil {
-			var weights []uint32
-			for _, weight := range a.MultisigData.Weights {
-				weights = append(weights, uint32(weight))
-			}
-			s.Accounts.CreateMultisig(weights, a.MultisigData.Addresses, uint32(a.MultisigData.Threshold), a.Address)
-		}
+		s.importAccount(a)
+	}
 
-		s.Accounts.SetNonce(a.Address, a.Nonce)
+	for _, c := range state.Coins {
+		s.importCoin(c)
+	}
 
-		for _, b := range a.Balance {
-			balance := helpers.StringToBigInt(b.Value)
-			coinID := types.CoinID(b.Coin)
-			s.Accounts.SetBalance(a.Address, coinID, balance)
-		}
+	s.importValidators(state.Validators)
+
+	for _, pubkey := range state.BlockListCandidates {
+		s.Candidates.AddToBlockPubKey(pubkey)
 	}
 
-	for _, c := range state.Coins {
-		coinID := types.CoinID(c.ID)
-		volume := helpers.StringToBigInt(c.Volume)
-		maxSupply := helpers.StringToBigInt(c.MaxSupply)
-		if c.Crr == 0 {
-			s.Coins.ImportToken(coinID, c.Symbol, c.Name, c.Mintable, c.Burnable, volume, maxSupply, c.OwnerAddress, c.Version)
-		} else {
-			reserve := helpers.StringToBigInt(c.Reserve)
-			s.Coins.ImportCoin(coinID, c.Symbol, c.Name, volume, uint32(c.Crr), reserve, maxSupply, c.OwnerAddress, c.Version)
+	for _, c := range state.Candidates {
+		s.importCandidate(c)
+	}
+	s.Candidates.RecalculateStakes(uint64(s.height))
+
+	for _, w := range state.Waitlist {
+		s.importWaitlist(w)
+	}
+
+	for _, hashString := range state.UsedChecks {
+		s.importUsedCheck(hashString)
+	}
+
+	for _, ff := range state.FrozenFunds {
+		s.importFrozenFund(ff)
+	}
+
+	s.Swap.Import(&state)
+
+	s.importCommission(state.Commission)
+
+	for _, item := range state.Slots {
+		s.importSlots(item)
+	}
+
+	for _, allowance := range state.Allowances {
+		s.importAllowance(allowance)
+	}
+
+	for _, controlled := range state.ControlledCoins {
+		s.importControlledCoin(controlled)
+	}
+
+	for _, airdrop := range state.Airdrops {
+		s.importAirdrop(airdrop)
+	}
+
+	for _, key := range state.BLSKeys {
+		s.importBLSKey(key)
+	}
+
+	for _, schedule := range state.Schedules {
+		s.importSchedule(schedule)
+	}
+
+	for _, htlc := range state.HTLCs {
+		s.importHTLC(htlc)
+	}
+
+	return nil
+}
+
+func (s *State) importApp(maxGas uint64, totalSlashed string) {
+	s.App.SetMaxGas(maxGas)
+	s.App.SetTotalSlashed(helpers.StringToBigInt(totalSlashed))
+}
+
+func (s *State) importAccount(a types.Account) {
+	if a.MultisigData != nil {
+		var weights []uint32
+		for _, weight := range a.MultisigData.Weights {
+			weights = append(weights, uint32(weight))
 		}
+		s.Accounts.CreateMultisig(weights, a.MultisigData.Addresses, uint32(a.MultisigData.Threshold), a.Address)
+	}
+
+	s.Accounts.SetNonce(a.Address, a.Nonce)
+	if a.Signer != nil {
+		s.Accounts.SetSigner(a.Address, *a.Signer)
+	}
+
+	for _, b := range a.Balance {
+		balance := helpers.StringToBigInt(b.Value)
+		coinID := types.CoinID(b.Coin)
+		s.Accounts.SetBalance(a.Address, coinID, balance)
+	}
+}
+
+func (s *State) importCoin(c types.Coin) {
+	coinID := types.CoinID(c.ID)
+	volume := helpers.StringToBigInt(c.Volume)
+	maxSupply := helpers.StringToBigInt(c.MaxSupply)
+	if c.Crr == 0 {
+		s.Coins.ImportToken(coinID, c.Symbol, c.Name, c.Mintable, c.Burnable, volume, maxSupply, c.OwnerAddress, c.Version)
+	} else {
+		reserve := helpers.StringToBigInt(c.Reserve)
+		s.Coins.ImportCoin(coinID, c.Symbol, c.Name, volume, uint32(c.Crr), reserve, maxSupply, c.OwnerAddress, c.Version)
 	}
 
+	if c.Metadata != nil {
+		iconHash, _ := hex.DecodeString(c.Metadata.IconHash)
+		s.Coins.SetMetadata(coinID, c.Metadata.URL, c.Metadata.Description, iconHash, c.Metadata.Decimals)
+	}
+}
+
+func (s *State) importValidators(list []types.Validator) {
 	var vals []*validators.Validator
-	for _, v := range state.Validators {
+	for _, v := range list {
 		vals = append(vals, validators.NewValidator(
 			v.PubKey,
 			v.AbsentTimes,
@@ -267,93 +420,131 @@ func (s *State) Import(state types.AppState) error {
 			s.bus))
 	}
 	s.Validators.SetValidators(vals)
+}
 
-	for _, pubkey := range state.BlockListCandidates {
-		s.Candidates.AddToBlockPubKey(pubkey)
+func (s *State) importCandidate(c types.Candidate) {
+	s.Candidates.CreateWithID(c.OwnerAddress, c.RewardAddress, c.ControlAddress, c.PubKey, uint32(c.Commission), uint32(c.ID), c.LastEditCommissionHeight, c.JailedUntil)
+	if c.Status == candidates.CandidateStatusOnline {
+		s.Candidates.SetOnline(c.PubKey)
+	}
+	if c.JailReason != 0 {
+		s.Candidates.SetJail(c.PubKey, byte(c.JailReason), c.JailHeight)
 	}
 
-	for _, c := range state.Candidates {
-		s.Candidates.CreateWithID(c.OwnerAddress, c.RewardAddress, c.ControlAddress, c.PubKey, uint32(c.Commission), uint32(c.ID), c.LastEditCommissionHeight, c.JailedUntil)
-		if c.Status == candidates.CandidateStatusOnline {
-			s.Candidates.SetOnline(c.PubKey)
-		}
+	s.Candidates.SetTotalStake(c.PubKey, helpers.StringToBigInt(c.TotalBipStake))
+	s.Candidates.SetStakes(c.PubKey, c.Stakes, c.Updates)
+}
 
-		s.Candidates.SetTotalStake(c.PubKey, helpers.StringToBigInt(c.TotalBipStake))
-		s.Candidates.SetStakes(c.PubKey, c.Stakes, c.Updates)
-	}
-	s.Candidates.RecalculateStakes(uint64(s.height))
+func (s *State) importWaitlist(w types.Waitlist) {
+	value := helpers.StringToBigInt(w.Value)
+	coinID := types.CoinID(w.Coin)
+	s.Waitlist.AddWaitList(w.Owner, s.Candidates.PubKey(uint32(w.CandidateID)), coinID, value)
+}
 
-	for _, w := range state.Waitlist {
-		value := helpers.StringToBigInt(w.Value)
-		coinID := types.CoinID(w.Coin)
-		s.Waitlist.AddWaitList(w.Owner, s.Candidates.PubKey(uint32(w.CandidateID)), coinID, value)
-	}
+func (s *State) importUsedCheck(hashString types.UsedCheck) {
+	bytes, _ := hex.DecodeString(string(hashString))
+	var hash types.Hash
+	copy(hash[:], bytes)
+	s.Checks.UseCheckHash(hash)
+}
 
-	for _, hashString := range state.UsedChecks {
-		bytes, _ := hex.DecodeString(string(hashString))
-		var hash types.Hash
-		copy(hash[:], bytes)
-		s.Checks.UseCheckHash(hash)
+func (s *State) importFrozenFund(ff types.FrozenFund) {
+	coinID := types.CoinID(ff.Coin)
+	value := helpers.StringToBigInt(ff.Value)
+	s.FrozenFunds.AddFund(ff.Height, ff.Address, ff.CandidateKey, uint32(ff.CandidateID), coinID, value, nil)
+}
+
+func (s *State) importSlots(item types.Slots) {
+	s.Slots.SetSlots(item.Height, uint32(item.Validators), uint32(item.Candidates))
+}
+
+func (s *State) importAllowance(allowance types.Allowance) {
+	s.Allowances.SetAllowance(allowance.Owner, allowance.Spender, types.CoinID(allowance.Coin), helpers.StringToBigInt(allowance.Value))
+}
+
+func (s *State) importControlledCoin(controlled types.ControlledCoin) {
+	coinID := types.CoinID(controlled.Coin)
+	s.Controls.SetControlled(coinID)
+	for _, address := range controlled.Frozen {
+		s.Controls.SetRestriction(coinID, address, true, s.Controls.IsBlocklisted(coinID, address))
 	}
+	for _, address := range controlled.Blocklist {
+		s.Controls.SetRestriction(coinID, address, s.Controls.IsFrozen(coinID, address), true)
+	}
+}
 
-	for _, ff := range state.FrozenFunds {
-		coinID := types.CoinID(ff.Coin)
-		value := helpers.StringToBigInt(ff.Value)
-		s.FrozenFunds.AddFund(ff.Height, ff.Address, ff.CandidateKey, uint32(ff.CandidateID), coinID, value, nil)
+func (s *State) importAirdrop(airdrop types.Airdrop) {
+	root, _ := hex.DecodeString(airdrop.Root)
+	id := uint32(airdrop.ID)
+	s.Airdrops.SetAirdrop(id, airdrop.Creator, types.CoinID(airdrop.Coin), types.BytesToHash(root), helpers.StringToBigInt(airdrop.Value), airdrop.ExpireHeight)
+	for _, index := range airdrop.Claimed {
+		s.Airdrops.SetClaimed(id, uint32(index))
 	}
+}
 
-	s.Swap.Import(&state)
+func (s *State) importBLSKey(key types.BLSKey) {
+	publicKey, _ := hex.DecodeString(key.PublicKey)
+	s.BLSKeys.SetKey(key.Address, publicKey)
+}
+
+func (s *State) importSchedule(schedule types.Schedule) {
+	s.Schedules.SetSchedule(uint32(schedule.ID), schedule.Owner, schedule.Recipient, types.CoinID(schedule.Coin), helpers.StringToBigInt(schedule.Value), schedule.NextHeight, schedule.Interval, uint32(schedule.Count))
+}
 
+func (s *State) importHTLC(htlc types.HTLC) {
+	hashLock, _ := hex.DecodeString(htlc.HashLock)
+	s.HTLCs.SetHTLC(uint32(htlc.ID), htlc.Sender, htlc.Recipient, types.CoinID(htlc.Coin), helpers.StringToBigInt(htlc.Value), types.BytesToHash(hashLock), htlc.Timeout)
+}
+
+func (s *State) importCommission(c types.Commission) {
 	com := &commission.Price{
-		Coin:                    types.CoinID(state.Commission.Coin),
-		PayloadByte:             helpers.StringToBigInt(state.Commission.PayloadByte),
-		Send:                    helpers.StringToBigInt(state.Commission.Send),
-		BuyBancor:               helpers.StringToBigInt(state.Commission.BuyBancor),
-		SellBancor:              helpers.StringToBigInt(state.Commission.SellBancor),
-		SellAllBancor:           helpers.StringToBigInt(state.Commission.SellAllBancor),
-		BuyPoolBase:             helpers.StringToBigInt(state.Commission.BuyPoolBase),
-		BuyPoolDelta:            helpers.StringToBigInt(state.Commission.BuyPoolDelta),
-		SellPoolBase:            helpers.StringToBigInt(state.Commission.SellPoolBase),
-		SellPoolDelta:           helpers.StringToBigInt(state.Commission.SellPoolDelta),
-		SellAllPoolBase:         helpers.StringToBigInt(state.Commission.SellAllPoolBase),
-		SellAllPoolDelta:        helpers.StringToBigInt(state.Commission.SellAllPoolDelta),
-		CreateTicker3:           helpers.StringToBigInt(state.Commission.CreateTicker3),
-		CreateTicker4:           helpers.StringToBigInt(state.Commission.CreateTicker4),
-		CreateTicker5:           helpers.StringToBigInt(state.Commission.CreateTicker5),
-		CreateTicker6:           helpers.StringToBigInt(state.Commission.CreateTicker6),
-		CreateTicker7to10:       helpers.StringToBigInt(state.Commission.CreateTicker7_10),
-		CreateCoin:              helpers.StringToBigInt(state.Commission.CreateCoin),
-		CreateToken:             helpers.StringToBigInt(state.Commission.CreateToken),
-		RecreateCoin:            helpers.StringToBigInt(state.Commission.RecreateCoin),
-		RecreateToken:           helpers.StringToBigInt(state.Commission.RecreateToken),
-		DeclareCandidacy:        helpers.StringToBigInt(state.Commission.DeclareCandidacy),
-		Delegate:                helpers.StringToBigInt(state.Commission.Delegate),
-		Unbond:                  helpers.StringToBigInt(state.Commission.Unbond),
-		RedeemCheck:             helpers.StringToBigInt(state.Commission.RedeemCheck),
-		SetCandidateOn:          helpers.StringToBigInt(state.Commission.SetCandidateOn),
-		SetCandidateOff:         helpers.StringToBigInt(state.Commission.SetCandidateOff),
-		CreateMultisig:          helpers.StringToBigInt(state.Commission.CreateMultisig),
-		MultisendBase:           helpers.StringToBigInt(state.Commission.MultisendBase),
-		MultisendDelta:          helpers.StringToBigInt(state.Commission.MultisendDelta),
-		EditCandidate:           helpers.StringToBigInt(state.Commission.EditCandidate),
-		SetHaltBlock:            helpers.StringToBigInt(state.Commission.SetHaltBlock),
-		EditTickerOwner:         helpers.StringToBigInt(state.Commission.EditTickerOwner),
-		EditMultisig:            helpers.StringToBigInt(state.Commission.EditMultisig),
-		EditCandidatePublicKey:  helpers.StringToBigInt(state.Commission.EditCandidatePublicKey),
-		CreateSwapPool:          helpers.StringToBigInt(state.Commission.CreateSwapPool),
-		AddLiquidity:            helpers.StringToBigInt(state.Commission.AddLiquidity),
-		RemoveLiquidity:         helpers.StringToBigInt(state.Commission.RemoveLiquidity),
-		EditCandidateCommission: helpers.StringToBigInt(state.Commission.EditCandidateCommission),
-		BurnToken:               helpers.StringToBigInt(state.Commission.BurnToken),
-		MintToken:               helpers.StringToBigInt(state.Commission.MintToken),
-		VoteCommission:          helpers.StringToBigInt(state.Commission.VoteCommission),
-		VoteUpdate:              helpers.StringToBigInt(state.Commission.VoteUpdate),
+		Coin:                    types.CoinID(c.Coin),
+		PayloadByte:             helpers.StringToBigInt(c.PayloadByte),
+		Send:                    helpers.StringToBigInt(c.Send),
+		BuyBancor:               helpers.StringToBigInt(c.BuyBancor),
+		SellBancor:              helpers.StringToBigInt(c.SellBancor),
+		SellAllBancor:           helpers.StringToBigInt(c.SellAllBancor),
+		BuyPoolBase:             helpers.StringToBigInt(c.BuyPoolBase),
+		BuyPoolDelta:            helpers.StringToBigInt(c.BuyPoolDelta),
+		SellPoolBase:            helpers.StringToBigInt(c.SellPoolBase),
+		SellPoolDelta:           helpers.StringToBigInt(c.SellPoolDelta),
+		SellAllPoolBase:         helpers.StringToBigInt(c.SellAllPoolBase),
+		SellAllPoolDelta:        helpers.StringToBigInt(c.SellAllPoolDelta),
+		CreateTicker3:           helpers.StringToBigInt(c.CreateTicker3),
+		CreateTicker4:           helpers.StringToBigInt(c.CreateTicker4),
+		CreateTicker5:           helpers.StringToBigInt(c.CreateTicker5),
+		CreateTicker6:           helpers.StringToBigInt(c.CreateTicker6),
+		CreateTicker7to10:       helpers.StringToBigInt(c.CreateTicker7_10),
+		CreateCoin:              helpers.StringToBigInt(c.CreateCoin),
+		CreateToken:             helpers.StringToBigInt(c.CreateToken),
+		RecreateCoin:            helpers.StringToBigInt(c.RecreateCoin),
+		RecreateToken:           helpers.StringToBigInt(c.RecreateToken),
+		DeclareCandidacy:        helpers.StringToBigInt(c.DeclareCandidacy),
+		Delegate:                helpers.StringToBigInt(c.Delegate),
+		Unbond:                  helpers.StringToBigInt(c.Unbond),
+		RedeemCheck:             helpers.StringToBigInt(c.RedeemCheck),
+		SetCandidateOn:          helpers.StringToBigInt(c.SetCandidateOn),
+		SetCandidateOff:         helpers.StringToBigInt(c.SetCandidateOff),
+		CreateMultisig:          helpers.StringToBigInt(c.CreateMultisig),
+		MultisendBase:           helpers.StringToBigInt(c.MultisendBase),
+		MultisendDelta:          helpers.StringToBigInt(c.MultisendDelta),
+		EditCandidate:           helpers.StringToBigInt(c.EditCandidate),
+		SetHaltBlock:            helpers.StringToBigInt(c.SetHaltBlock),
+		EditTickerOwner:         helpers.StringToBigInt(c.EditTickerOwner),
+		EditMultisig:            helpers.StringToBigInt(c.EditMultisig),
+		EditCandidatePublicKey:  helpers.StringToBigInt(c.EditCandidatePublicKey),
+		CreateSwapPool:          helpers.StringToBigInt(c.CreateSwapPool),
+		AddLiquidity:            helpers.StringToBigInt(c.AddLiquidity),
+		RemoveLiquidity:         helpers.StringToBigInt(c.RemoveLiquidity),
+		EditCandidateCommission: helpers.StringToBigInt(c.EditCandidateCommission),
+		BurnToken:               helpers.StringToBigInt(c.BurnToken),
+		MintToken:               helpers.StringToBigInt(c.MintToken),
+		VoteCommission:          helpers.StringToBigInt(c.VoteCommission),
+		VoteUpdate:              helpers.StringToBigInt(c.VoteUpdate),
 		More:                    nil,
 	}
 
 	s.Commission.SetNewCommissions(com.Encode())
-
-	return nil
 }
 
 func (s *State) Export() types.AppState {
@@ -414,6 +605,20 @@ func newStateForTree(immutableTree *iavl.ImmutableTree, events eventsdb.IEventsD
 
 	update := update.New(immutableTree)
 
+	slotsState := slots.New(immutableTree)
+
+	allowancesState := allowances.New(immutableTree)
+
+	controlsState := controls.New(immutableTree)
+
+	airdropsState := airdrops.New(stateBus, immutableTree)
+
+	blsKeysState := blskeys.New(immutableTree)
+
+	schedulesState := schedules.New(stateBus, immutableTree)
+
+	htlcsState := htlcs.New(stateBus, immutableTree)
+
 	state := &State{
 		Validators:  validatorsState,
 		App:         appState,
@@ -428,6 +633,13 @@ func newStateForTree(immutableTree *iavl.ImmutableTree, events eventsdb.IEventsD
 		Swap:        pool,
 		Commission:  commission,
 		Updates:     update,
+		Slots:       slotsState,
+		Allowances:  allowancesState,
+		Controls:    controlsState,
+		Airdrops:    airdropsState,
+		BLSKeys:     blsKeysState,
+		Schedules:   schedulesState,
+		HTLCs:       htlcsState,
 
 		height:         immutableTree.Version(),
 		bus:            stateBus,
@@ -0,0 +1,555 @@
+package state
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/MinterTeam/minter-go-node/coreV2/types"
+	tmjson "github.com/tendermint/tendermint/libs/json"
+)
+
+// State stream is a JSON lines file. The first line is a header with the height of the state,
+// then every module writes one line per item followed by a line with done flag and count of items.
+// The last line has module "end". Items of modules are encoded with tmjson, same as in genesis.
+const (
+	StreamHeader              = "header"
+	StreamApp                 = "app"
+	StreamCommission          = "commission"
+	StreamCoins               = "coins"
+	StreamAccounts            = "accounts"
+	StreamValidators          = "validators"
+	StreamBlockListCandidates = "block_list_candidates"
+	StreamCandidates          = "candidates"
+	StreamWaitlist            = "waitlist"
+	StreamUsedChecks          = "used_checks"
+	StreamFrozenFunds         = "frozen_funds"
+	StreamHaltBlocks          = "halt_blocks"
+	StreamPools               = "pools"
+	StreamCommissionVotes     = "commission_votes"
+	StreamUpdateVotes         = "update_votes"
+	StreamSlots               = "slots"
+	StreamSlotsVotes          = "slots_votes"
+	StreamAllowances          = "allowances"
+	StreamControlledCoins     = "controlled_coins"
+	StreamAirdrops            = "airdrops"
+	StreamBLSKeys             = "bls_keys"
+	StreamSchedules           = "schedules"
+	StreamHTLCs               = "htlcs"
+	StreamEnd                 = "end"
+)
+
+// progress is reported every streamProgressStep items of a module
+const streamProgressStep = 10000
+
+type streamRecord struct {
+	Module string          `json:"module"`
+	Value  json.RawMessage `json:"value,omitempty"`
+	Done   bool            `json:"done,omitempty"`
+	Count  uint64          `json:"count,omitempty"`
+}
+
+type streamHeader struct {
+	Height uint64 `json:"height"`
+}
+
+type streamApp struct {
+	MaxGas       uint64 `json:"max_gas"`
+	TotalSlashed string `json:"total_slashed"`
+}
+
+type streamWriter struct {
+	w        *bufio.Writer
+	module   string
+	count    uint64
+	progress func(module string, count uint64)
+	err      error
+}
+
+func (sw *streamWriter) writeRecord(record streamRecord) {
+	if sw.err != nil {
+		return
+	}
+
+	line, err := json.Marshal(record)
+	if err != nil {
+		sw.err = err
+		return
+	}
+
+	if _, err := sw.w.Write(append(line, '\n')); err != nil {
+		sw.err = err
+	}
+}
+
+func (sw *streamWriter) write(value interface{}) {
+	if sw.err != nil {
+		return
+	}
+
+	data, err := tmjson.Marshal(value)
+	if err != nil {
+		sw.err = fmt.Errorf("can't encode %s item: %s", sw.module, err)
+		return
+	}
+
+	sw.writeRecord(streamRecord{Module: sw.module, Value: data})
+	sw.count++
+
+	if sw.progress != nil && sw.count%streamProgressStep == 0 {
+		sw.progress(sw.module, sw.count)
+	}
+}
+
+func (sw *streamWriter) done() error {
+	sw.writeRecord(streamRecord{Module: sw.module, Done: true, Count: sw.count})
+	if sw.err != nil {
+		return sw.err
+	}
+
+	if err := sw.w.Flush(); err != nil {
+		return err
+	}
+
+	if sw.progress != nil {
+		sw.progress(sw.module, sw.count)
+	}
+
+	return nil
+}
+
+type streamModule struct {
+	name   string
+	export func(sw *streamWriter)
+}
+
+// streamModules returns modules in the order they must be imported
+func (cs *CheckState) streamModules() []streamModule {
+	return []streamModule{
+		{StreamApp, func(sw *streamWriter) {
+			appState := new(types.AppState)
+			cs.App().Export(appState)
+			sw.write(streamApp{MaxGas: appState.MaxGas, TotalSlashed: appState.TotalSlashed})
+		}},
+		{StreamCommission, func(sw *streamWriter) {
+			sw.write(cs.state.Commission.ExportCurrent())
+		}},
+		{StreamCoins, func(sw *streamWriter) {
+			cs.state.Coins.ExportEach(func(coin types.Coin) {
+				sw.write(coin)
+			})
+		}},
+		{StreamAccounts, func(sw *streamWriter) {
+			cs.state.Accounts.ExportEach(func(account types.Account) {
+				sw.write(account)
+			})
+		}},
+		{StreamValidators, func(sw *streamWriter) {
+			cs.state.Validators.ExportEach(func(validator types.Validator) {
+				sw.write(validator)
+			})
+		}},
+		{StreamBlockListCandidates, func(sw *streamWriter) {
+			cs.state.Candidates.ExportBlockList(func(pubkey types.Pubkey) {
+				sw.write(pubkey)
+			})
+		}},
+		{StreamCandidates, func(sw *streamWriter) {
+			cs.state.Candidates.ExportEach(func(candidate types.Candidate) {
+				sw.write(candidate)
+			})
+		}},
+		{StreamWaitlist, func(sw *streamWriter) {
+			cs.state.Waitlist.ExportEach(func(item types.Waitlist) {
+				sw.write(item)
+			})
+		}},
+		{StreamUsedChecks, func(sw *streamWriter) {
+			cs.state.Checks.ExportEach(func(check types.UsedCheck) {
+				sw.write(check)
+			})
+		}},
+		{StreamFrozenFunds, func(sw *streamWriter) {
+			cs.state.FrozenFunds.ExportEach(uint64(cs.state.height), func(frozenFund types.FrozenFund) {
+				sw.write(frozenFund)
+			})
+		}},
+		{StreamHaltBlocks, func(sw *streamWriter) {
+			cs.state.Halts.ExportEach(func(haltBlock types.HaltBlock) {
+				sw.write(haltBlock)
+			})
+		}},
+		{StreamPools, func(sw *streamWriter) {
+			cs.state.Swap.ExportEach(func(pool types.Pool) {
+				sw.write(pool)
+			})
+		}},
+		{StreamCommissionVotes, func(sw *streamWriter) {
+			cs.state.Commission.ExportVotesEach(func(vote types.CommissionVote) {
+				sw.write(vote)
+			})
+		}},
+		{StreamUpdateVotes, func(sw *streamWriter) {
+			cs.state.Updates.ExportEach(func(vote types.UpdateVote) {
+				sw.write(vote)
+			})
+		}},
+		{StreamSlots, func(sw *streamWriter) {
+			cs.state.Slots.ExportScheduleEach(func(item types.Slots) {
+				sw.write(item)
+			})
+		}},
+		{StreamSlotsVotes, func(sw *streamWriter) {
+			cs.state.Slots.ExportVotesEach(func(vote types.SlotsVote) {
+				sw.write(vote)
+			})
+		}},
+		{StreamAllowances, func(sw *streamWriter) {
+			appState := new(types.AppState)
+			cs.Allowances().Export(appState)
+			for _, allowance := range appState.Allowances {
+				sw.write(allowance)
+			}
+		}},
+		{StreamControlledCoins, func(sw *streamWriter) {
+			appState := new(types.AppState)
+			cs.Controls().Export(appState)
+			for _, controlled := range appState.ControlledCoins {
+				sw.write(controlled)
+			}
+		}},
+		{StreamAirdrops, func(sw *streamWriter) {
+			appState := new(types.AppState)
+			cs.Airdrops().Export(appState)
+			for _, airdrop := range appState.Airdrops {
+				sw.write(airdrop)
+			}
+		}},
+		{StreamBLSKeys, func(sw *streamWriter) {
+			appState := new(types.AppState)
+			cs.BLSKeys().Export(appState)
+			for _, key := range appState.BLSKeys {
+				sw.write(key)
+			}
+		}},
+		{StreamSchedules, func(sw *streamWriter) {
+			appState := new(types.AppState)
+			cs.Schedules().Export(appState)
+			for _, schedule := range appState.Schedules {
+				sw.write(schedule)
+			}
+		}},
+		{StreamHTLCs, func(sw *streamWriter) {
+			appState := new(types.AppState)
+			cs.HTLCs().Export(appState)
+			for _, htlc := range appState.HTLCs {
+				sw.write(htlc)
+			}
+		}},
+	}
+}
+
+// ExportStream writes state to w module by module without building the whole AppState in memory.
+// If progress is not nil, it is called periodically with the module name and count of written items.
+func (cs *CheckState) ExportStream(w io.Writer, progress func(module string, count uint64)) error {
+	return cs.exportStream(w, true, nil, progress)
+}
+
+// ExportStreamFile writes state stream to the file at path.
+// If the file already has an unfinished stream of the same height, export continues after the last completed module.
+func (cs *CheckState) ExportStreamFile(path string, progress func(module string, count uint64)) error {
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	height, done, offset, err := readStreamProgress(file)
+	if err != nil {
+		return err
+	}
+
+	if offset != 0 && height != uint64(cs.state.height) {
+		return fmt.Errorf("file %s contains state at height %d, not %d", path, height, cs.state.height)
+	}
+
+	if done[StreamEnd] {
+		return nil
+	}
+
+	if err := file.Truncate(offset); err != nil {
+		return err
+	}
+	if _, err := file.Seek(offset, io.SeekStart); err != nil {
+		return err
+	}
+
+	if err := cs.exportStream(file, offset == 0, done, progress); err != nil {
+		return err
+	}
+
+	return file.Sync()
+}
+
+func (cs *CheckState) exportStream(w io.Writer, header bool, skip map[string]bool, progress func(module string, count uint64)) error {
+	sw := &streamWriter{w: bufio.NewWriter(w), progress: progress}
+
+	if header {
+		sw.module = StreamHeader
+		sw.write(streamHeader{Height: uint64(cs.state.height)})
+		if sw.err != nil {
+			return sw.err
+		}
+		if err := sw.w.Flush(); err != nil {
+			return err
+		}
+	}
+
+	for _, module := range cs.streamModules() {
+		if skip[module.name] {
+			continue
+		}
+
+		sw.module = module.name
+		sw.count = 0
+		module.export(sw)
+		if err := sw.done(); err != nil {
+			return err
+		}
+	}
+
+	sw.progress = nil
+	sw.module = StreamEnd
+	sw.count = 0
+	return sw.done()
+}
+
+// readStreamProgress returns height of the stream, completed modules and offset right after the last completed module
+func readStreamProgress(r io.Reader) (height uint64, done map[string]bool, offset int64, err error) {
+	done = map[string]bool{}
+	reader := bufio.NewReader(r)
+
+	var read int64
+	for {
+		line, err := reader.ReadBytes('\n')
+		if err != nil {
+			// unfinished line is written after the last completed module and will be overwritten
+			if err == io.EOF {
+				return height, done, offset, nil
+			}
+			return 0, nil, 0, err
+		}
+		read += int64(len(line))
+
+		var record streamRecord
+		if err := json.Unmarshal(line, &record); err != nil {
+			return height, done, offset, nil
+		}
+
+		switch {
+		case record.Module == StreamHeader:
+			var h streamHeader
+			if err := tmjson.Unmarshal(record.Value, &h); err != nil {
+				return 0, nil, 0, fmt.Errorf("can't decode stream header: %s", err)
+			}
+			height = h.Height
+			offset = read
+		case record.Done:
+			done[record.Module] = true
+			offset = read
+		}
+	}
+}
+
+// ImportStream imports state written by ExportStream reading it line by line.
+// As in Import, halt blocks and votes are not imported.
+func (s *State) ImportStream(r io.Reader) (height uint64, err error) {
+	defer s.Checker.RemoveBaseCoin()
+
+	reader := bufio.NewReader(r)
+
+	var (
+		vals       []types.Validator
+		count      uint64
+		hasHeader  bool
+		lineNumber int
+	)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if err == io.EOF {
+			return 0, errors.New("unexpected end of state stream")
+		}
+		if err != nil {
+			return 0, err
+		}
+		lineNumber++
+
+		var record streamRecord
+		if err := json.Unmarshal(line, &record); err != nil {
+			return 0, fmt.Errorf("line %d: %s", lineNumber, err)
+		}
+
+		if !hasHeader {
+			if record.Module != StreamHeader {
+				return 0, errors.New("state stream has no header")
+			}
+
+			var header streamHeader
+			if err := tmjson.Unmarshal(record.Value, &header); err != nil {
+				return 0, fmt.Errorf("line %d: %s", lineNumber, err)
+			}
+			height = header.Height
+			hasHeader = true
+			continue
+		}
+
+		if record.Module == StreamEnd {
+			return height, nil
+		}
+
+		if record.Done {
+			if record.Count != count {
+				return 0, fmt.Errorf("line %d: module %s has %d items, expected %d", lineNumber, record.Module, count, record.Count)
+			}
+
+			switch record.Module {
+			case StreamCoins:
+				s.App.SetCoinsCount(uint32(count))
+			case StreamValidators:
+				s.importValidators(vals)
+				vals = nil
+			case StreamCandidates:
+				s.Candidates.RecalculateStakes(uint64(s.height))
+			}
+
+			count = 0
+			continue
+		}
+
+		if err := s.importStreamItem(record, &vals); err != nil {
+			return 0, fmt.Errorf("line %d: %s", lineNumber, err)
+		}
+		count++
+	}
+}
+
+func (s *State) importStreamItem(record streamRecord, vals *[]types.Validator) error {
+	switch record.Module {
+	case StreamApp:
+		var a streamApp
+		if err := tmjson.Unmarshal(record.Value, &a); err != nil {
+			return err
+		}
+		s.importApp(a.MaxGas, a.TotalSlashed)
+	case StreamCommission:
+		var c types.Commission
+		if err := tmjson.Unmarshal(record.Value, &c); err != nil {
+			return err
+		}
+		s.importCommission(c)
+	case StreamCoins:
+		var c types.Coin
+		if err := tmjson.Unmarshal(record.Value, &c); err != nil {
+			return err
+		}
+		s.importCoin(c)
+	case StreamAccounts:
+		var a types.Account
+		if err := tmjson.Unmarshal(record.Value, &a); err != nil {
+			return err
+		}
+		s.importAccount(a)
+	case StreamValidators:
+		var v types.Validator
+		if err := tmjson.Unmarshal(record.Value, &v); err != nil {
+			return err
+		}
+		*vals = append(*vals, v)
+	case StreamBlockListCandidates:
+		var pubkey types.Pubkey
+		if err := tmjson.Unmarshal(record.Value, &pubkey); err != nil {
+			return err
+		}
+		s.Candidates.AddToBlockPubKey(pubkey)
+	case StreamCandidates:
+		var c types.Candidate
+		if err := tmjson.Unmarshal(record.Value, &c); err != nil {
+			return err
+		}
+		s.importCandidate(c)
+	case StreamWaitlist:
+		var w types.Waitlist
+		if err := tmjson.Unmarshal(record.Value, &w); err != nil {
+			return err
+		}
+		s.importWaitlist(w)
+	case StreamUsedChecks:
+		var check types.UsedCheck
+		if err := tmjson.Unmarshal(record.Value, &check); err != nil {
+			return err
+		}
+		s.importUsedCheck(check)
+	case StreamFrozenFunds:
+		var ff types.FrozenFund
+		if err := tmjson.Unmarshal(record.Value, &ff); err != nil {
+			return err
+		}
+		s.importFrozenFund(ff)
+	case StreamPools:
+		var pool types.Pool
+		if err := tmjson.Unmarshal(record.Value, &pool); err != nil {
+			return err
+		}
+		s.Swap.ImportPool(pool)
+	case StreamSlots:
+		var item types.Slots
+		if err := tmjson.Unmarshal(record.Value, &item); err != nil {
+			return err
+		}
+		s.importSlots(item)
+	case StreamAllowances:
+		var allowance types.Allowance
+		if err := tmjson.Unmarshal(record.Value, &allowance); err != nil {
+			return err
+		}
+		s.importAllowance(allowance)
+	case StreamControlledCoins:
+		var controlled types.ControlledCoin
+		if err := tmjson.Unmarshal(record.Value, &controlled); err != nil {
+			return err
+		}
+		s.importControlledCoin(controlled)
+	case StreamAirdrops:
+		var airdrop types.Airdrop
+		if err := tmjson.Unmarshal(record.Value, &airdrop); err != nil {
+			return err
+		}
+		s.importAirdrop(airdrop)
+	case StreamBLSKeys:
+		var key types.BLSKey
+		if err := tmjson.Unmarshal(record.Value, &key); err != nil {
+			return err
+		}
+		s.importBLSKey(key)
+	case StreamSchedules:
+		var schedule types.Schedule
+		if err := tmjson.Unmarshal(record.Value, &schedule); err != nil {
+			return err
+		}
+		s.importSchedule(schedule)
+	case StreamHTLCs:
+		var htlc types.HTLC
+		if err := tmjson.Unmarshal(record.Value, &htlc); err != nil {
+			return err
+		}
+		s.importHTLC(htlc)
+	case StreamHaltBlocks, StreamCommissionVotes, StreamUpdateVotes, StreamSlotsVotes:
+	default:
+		return fmt.Errorf("unknown module %s", record.Module)
+	}
+
+	return nil
+}
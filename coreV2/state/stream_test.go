@@ -0,0 +1,189 @@
+package state
+
+import (
+	"bytes"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	eventsdb "github.com/MinterTeam/minter-go-node/coreV2/events"
+	"github.com/MinterTeam/minter-go-node/coreV2/types"
+	"github.com/MinterTeam/minter-go-node/helpers"
+	tmjson "github.com/tendermint/tendermint/libs/json"
+	db "github.com/tendermint/tm-db"
+)
+
+func createStreamTestState(t *testing.T) *State {
+	state, err := NewState(0, db.NewMemDB(), &eventsdb.MockEvents{}, 1, 2, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var price types.Commission
+	value := reflect.ValueOf(&price).Elem()
+	for i := 0; i < value.NumField(); i++ {
+		if value.Field(i).Kind() == reflect.String {
+			value.Field(i).SetString("1000000000000000000")
+		}
+	}
+	state.importCommission(price)
+
+	coinID := state.App.GetNextCoinID()
+	state.Coins.Create(coinID, types.StrToCoinSymbol("TEST"), "TEST", helpers.BipToPip(big.NewInt(1000)), 50, helpers.BipToPip(big.NewInt(500)), helpers.BipToPip(big.NewInt(1000)), nil)
+	state.App.SetCoinsCount(coinID.Uint32())
+
+	address1 := types.StringToAddress("Mx0000000000000000000000000000000000000001")
+	address2 := types.StringToAddress("Mx0000000000000000000000000000000000000002")
+	state.Accounts.AddBalance(address1, types.GetBaseCoinID(), helpers.BipToPip(big.NewInt(10)))
+	state.Accounts.AddBalance(address1, coinID, helpers.BipToPip(big.NewInt(100)))
+	state.Accounts.AddBalance(address2, coinID, helpers.BipToPip(big.NewInt(200)))
+	state.Accounts.SetNonce(address2, 5)
+
+	pubkey := types.Pubkey{1}
+	state.Candidates.Create(address1, address1, address1, pubkey, 10, 0, 0)
+	state.Candidates.SetOnline(pubkey)
+	state.Validators.Create(pubkey, helpers.BipToPip(big.NewInt(1)))
+	state.FrozenFunds.AddFund(100, address1, &pubkey, state.Candidates.ID(pubkey), coinID, helpers.BipToPip(big.NewInt(3)), nil)
+	state.Waitlist.AddWaitList(address2, pubkey, coinID, helpers.BipToPip(big.NewInt(7)))
+	state.Checks.UseCheckHash(types.Hash{1, 2, 3})
+	state.Swap.ImportPool(types.Pool{Coin0: 0, Coin1: uint64(coinID), Reserve0: "1000000", Reserve1: "2000000", ID: 1})
+
+	if _, err := state.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	return state
+}
+
+func TestStateExportStream(t *testing.T) {
+	t.Parallel()
+
+	state := createStreamTestState(t)
+
+	checkState, err := NewCheckStateAtHeight(1, state.db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var progress []string
+	var stream bytes.Buffer
+	err = checkState.ExportStream(&stream, func(module string, count uint64) {
+		progress = append(progress, module)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(progress) != len(checkState.streamModules()) {
+		t.Errorf("progress reported for %d modules, expected %d", len(progress), len(checkState.streamModules()))
+	}
+
+	newState, err := NewState(0, db.NewMemDB(), &eventsdb.MockEvents{}, 1, 2, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	height, err := newState.ImportStream(&stream)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if height != 1 {
+		t.Errorf("stream height is %d, expected 1", height)
+	}
+
+	if _, err := newState.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	expected, err := tmjson.Marshal(state.Export())
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := tmjson.Marshal(newState.Export())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(expected, got) {
+		t.Errorf("imported state differs from exported\nexpected: %s\ngot:      %s", expected, got)
+	}
+}
+
+func TestStateExportStreamFileResume(t *testing.T) {
+	t.Parallel()
+
+	state := createStreamTestState(t)
+
+	checkState, err := NewCheckStateAtHeight(1, state.db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var full bytes.Buffer
+	if err := checkState.ExportStream(&full, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// stream interrupted while writing validators
+	marker := []byte(`{"module":"accounts","done":true,"count":2}` + "\n")
+	index := bytes.Index(full.Bytes(), marker)
+	if index == -1 {
+		t.Fatalf("no accounts end in stream: %s", full.String())
+	}
+	partial := append(append([]byte{}, full.Bytes()[:index+len(marker)]...), []byte(`{"module":"validators","val`)...)
+
+	dir, err := ioutil.TempDir("", "stream")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "state.jsonl")
+	if err := ioutil.WriteFile(path, partial, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	var progress []string
+	err = checkState.ExportStreamFile(path, func(module string, count uint64) {
+		progress = append(progress, module)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, module := range progress {
+		if module == StreamApp || module == StreamCoins || module == StreamAccounts {
+			t.Errorf("module %s exported again", module)
+		}
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(data, full.Bytes()) {
+		t.Errorf("resumed stream differs from full stream\nexpected: %s\ngot:      %s", full.Bytes(), data)
+	}
+
+	progress = nil
+	if err := checkState.ExportStreamFile(path, func(module string, count uint64) {
+		progress = append(progress, module)
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if len(progress) != 0 {
+		t.Errorf("finished stream exported again: %v", progress)
+	}
+
+	otherState, err := NewCheckStateAtHeight(1, createStreamTestState(t).db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherState.state.height = 2
+	if err := otherState.ExportStreamFile(path, nil); err == nil {
+		t.Error("expected error for stream of another height")
+	}
+}
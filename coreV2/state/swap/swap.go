@@ -2,8 +2,10 @@ package swap
 
 import (
 	"bytes"
+	"encoding/binary"
 	"errors"
 	"fmt"
+	eventsdb "github.com/MinterTeam/minter-go-node/coreV2/events"
 	"github.com/MinterTeam/minter-go-node/coreV2/state/bus"
 	"github.com/MinterTeam/minter-go-node/coreV2/types"
 	"github.com/MinterTeam/minter-go-node/helpers"
@@ -21,9 +23,26 @@ var Bound = big.NewInt(minimumLiquidity)
 const minimumLiquidity = 1000
 const commission = 2
 
+// DefaultFee is the fee tier in basis points of pools created without choosing a tier, 0.2%
+const DefaultFee uint32 = 20
+
+// FeeTiers are fees in basis points which can be chosen on pool creation
+var FeeTiers = []uint32{1, 5, DefaultFee, 30, 100}
+
+// IsFeeTier returns true if fee is one of FeeTiers
+func IsFeeTier(fee uint32) bool {
+	for _, tier := range FeeTiers {
+		if tier == fee {
+			return true
+		}
+	}
+	return false
+}
+
 type EditableChecker interface {
 	Exists() bool
 	GetID() uint32
+	Fee() uint32
 	AddLastSwapStep(amount0In, amount1Out *big.Int) EditableChecker
 	Reverse() EditableChecker
 	Reserves() (reserve0 *big.Int, reserve1 *big.Int)
@@ -44,7 +63,12 @@ type RSwap interface {
 	Export(state *types.AppState)
 	SwapPool(coin0, coin1 types.CoinID) (reserve0, reserve1 *big.Int, id uint32)
 	GetSwapper(coin0, coin1 types.CoinID) EditableChecker
+	GetSwapperWithFee(coin0, coin1 types.CoinID, fee uint32) EditableChecker
 	SwapPoolExist(coin0, coin1 types.CoinID) bool
+	SwapPoolExistWithFee(coin0, coin1 types.CoinID, fee uint32) bool
+	SwapPoolFees(coin0, coin1 types.CoinID) []uint32
+	GetBestSwapperForSell(coin0, coin1 types.CoinID, amount0In *big.Int) EditableChecker
+	GetBestSwapperForBuy(coin0, coin1 types.CoinID, amount1Out *big.Int) EditableChecker
 	PairCalculateBuyForSell(coin0, coin1 types.CoinID, amount0In *big.Int) (amount1Out *big.Int, err error)
 	PairCalculateSellForBuy(coin0, coin1 types.CoinID, amount1Out *big.Int) (amount0In *big.Int, err error)
 }
@@ -100,6 +124,17 @@ func (s *Swap) ExportV1(state *types.AppState, id types.CoinID, usdAmount *big.I
 }
 
 func (s *Swap) Export(state *types.AppState) {
+	s.ExportEach(func(pool types.Pool) {
+		state.Pools = append(state.Pools, pool)
+	})
+
+	sort.Slice(state.Pools, func(i, j int) bool {
+		return strconv.Itoa(int(state.Pools[i].Coin0))+"-"+strconv.Itoa(int(state.Pools[i].Coin1))+"-"+strconv.Itoa(int(state.Pools[i].Fee)) < strconv.Itoa(int(state.Pools[j].Coin0))+"-"+strconv.Itoa(int(state.Pools[j].Coin1))+"-"+strconv.Itoa(int(state.Pools[j].Fee))
+	})
+}
+
+// ExportEach calls fn for every pool in order of keys
+func (s *Swap) ExportEach(fn func(pool types.Pool)) {
 	s.immutableTree().IterateRange([]byte{mainPrefix}, []byte{mainPrefix + 1}, true, func(key []byte, value []byte) bool {
 		if key[1] == 'i' {
 			if err := rlp.DecodeBytes(value, &s.nextID); err != nil {
@@ -109,46 +144,55 @@ func (s *Swap) Export(state *types.AppState) {
 		}
 		coin0 := types.BytesToCoinID(key[2:6])
 		coin1 := types.BytesToCoinID(key[6:10])
-		s.Pair(coin0, coin1)
-		return false
-	})
-
-	for key, pair := range s.pairs {
+		fee := DefaultFee
+		if len(key) == 14 {
+			fee = binary.BigEndian.Uint32(key[10:14])
+		}
+		pair := s.PairWithFee(coin0, coin1, fee)
 		if pair == nil {
-			continue
+			return false
 		}
 		reserve0, reserve1 := pair.Reserves()
-		swap := types.Pool{
-			Coin0:    uint64(key.Coin0),
-			Coin1:    uint64(key.Coin1),
+		pool := types.Pool{
+			Coin0:    uint64(coin0),
+			Coin1:    uint64(coin1),
 			Reserve0: reserve0.String(),
 			Reserve1: reserve1.String(),
 			ID:       uint64(pair.GetID()),
 		}
+		if fee != DefaultFee {
+			pool.Fee = fee
+		}
 
-		state.Pools = append(state.Pools, swap)
-	}
-
-	sort.Slice(state.Pools, func(i, j int) bool {
-		return strconv.Itoa(int(state.Pools[i].Coin0))+"-"+strconv.Itoa(int(state.Pools[i].Coin1)) < strconv.Itoa(int(state.Pools[j].Coin0))+"-"+strconv.Itoa(int(state.Pools[j].Coin1))
+		fn(pool)
+		return false
 	})
 }
 
 func (s *Swap) Import(state *types.AppState) {
 	for _, swap := range state.Pools {
-		coin0 := types.CoinID(swap.Coin0)
-		coin1 := types.CoinID(swap.Coin1)
-		reserve0 := helpers.StringToBigInt(swap.Reserve0)
-		reserve1 := helpers.StringToBigInt(swap.Reserve1)
-		pair := s.ReturnPair(coin0, coin1)
-		*pair.ID = uint32(swap.ID)
-		pair.Reserve0.Set(reserve0)
-		pair.Reserve1.Set(reserve1)
-		s.bus.Checker().AddCoin(coin0, reserve0)
-		s.bus.Checker().AddCoin(coin1, reserve1)
-		pair.markDirty()
-		s.incID()
+		s.ImportPool(swap)
+	}
+}
+
+// ImportPool creates pool with given reserves and id
+func (s *Swap) ImportPool(swap types.Pool) {
+	coin0 := types.CoinID(swap.Coin0)
+	coin1 := types.CoinID(swap.Coin1)
+	reserve0 := helpers.StringToBigInt(swap.Reserve0)
+	reserve1 := helpers.StringToBigInt(swap.Reserve1)
+	fee := swap.Fee
+	if fee == 0 {
+		fee = DefaultFee
 	}
+	pair := s.ReturnPairWithFee(coin0, coin1, fee)
+	*pair.ID = uint32(swap.ID)
+	pair.Reserve0.Set(reserve0)
+	pair.Reserve1.Set(reserve1)
+	s.bus.Checker().AddCoin(coin0, reserve0)
+	s.bus.Checker().AddCoin(coin1, reserve1)
+	pair.markDirty()
+	s.incID()
 }
 
 const mainPrefix = byte('s')
@@ -159,6 +203,7 @@ type pairData struct {
 	Reserve1  *big.Int
 	ID        *uint32
 	markDirty func()
+	fee       uint32
 }
 
 func (pd *pairData) Reserves() (reserve0 *big.Int, reserve1 *big.Int) {
@@ -174,7 +219,17 @@ func (pd *pairData) reverse() *pairData {
 		Reserve1:  pd.Reserve0,
 		ID:        pd.ID,
 		markDirty: pd.markDirty,
+		fee:       pd.fee,
+	}
+}
+
+// commission returns the fee of the pool as a fraction: fee / denominator.
+// Pools of the default tier keep per mille precision to calculate swaps exactly as before fee tiers.
+func (pd *pairData) commission() (fee *big.Int, denominator *big.Int) {
+	if pd.fee == 0 || pd.fee == DefaultFee {
+		return big.NewInt(commission), big.NewInt(1000)
 	}
+	return big.NewInt(int64(pd.fee)), big.NewInt(10000)
 }
 
 func (s *Swap) CheckSwap(coin0, coin1 types.CoinID, amount0In, amount1Out *big.Int) error {
@@ -194,6 +249,7 @@ func (p *Pair) AddLastSwapStep(amount0In, amount1Out *big.Int) EditableChecker {
 		Reserve1:  reserve1.Sub(reserve1, amount1Out),
 		ID:        p.ID,
 		markDirty: func() {},
+		fee:       p.fee,
 	}}
 }
 func (p *Pair) Reverse() EditableChecker {
@@ -204,7 +260,13 @@ const pairDataPrefix = 'd'
 const pairOrdersPrefix = 'o'
 
 func (pk pairKey) bytes() []byte {
-	return append(pk.Coin0.Bytes(), pk.Coin1.Bytes()...)
+	key := append(pk.Coin0.Bytes(), pk.Coin1.Bytes()...)
+	if pk.Fee == DefaultFee {
+		return key
+	}
+	fee := make([]byte, 4)
+	binary.BigEndian.PutUint32(fee, pk.Fee)
+	return append(key, fee...)
 }
 
 func (pk pairKey) pathData() []byte {
@@ -251,6 +313,21 @@ func (s *Swap) SwapPoolExist(coin0, coin1 types.CoinID) bool {
 	return s.Pair(coin0, coin1) != nil
 }
 
+func (s *Swap) SwapPoolExistWithFee(coin0, coin1 types.CoinID, fee uint32) bool {
+	return s.PairWithFee(coin0, coin1, fee) != nil
+}
+
+// SwapPoolFees returns fee tiers of existing pools of the pair
+func (s *Swap) SwapPoolFees(coin0, coin1 types.CoinID) []uint32 {
+	var fees []uint32
+	for _, fee := range FeeTiers {
+		if s.SwapPoolExistWithFee(coin0, coin1, fee) {
+			fees = append(fees, fee)
+		}
+	}
+	return fees
+}
+
 func (s *Swap) pair(key pairKey) (*Pair, bool) {
 	pair, ok := s.pairs[key.sort()]
 	if pair == nil {
@@ -277,11 +354,51 @@ func (s *Swap) GetSwapper(coinA, coinB types.CoinID) EditableChecker {
 	return s.Pair(coinA, coinB)
 }
 
+func (s *Swap) GetSwapperWithFee(coinA, coinB types.CoinID, fee uint32) EditableChecker {
+	return s.PairWithFee(coinA, coinB, fee)
+}
+
+// GetBestSwapperForSell returns the pool of the pair which gives the most coin1 for amount0In.
+// If none of the pools has enough liquidity, the pool of the lowest fee tier is returned.
+func (s *Swap) GetBestSwapperForSell(coin0, coin1 types.CoinID, amount0In *big.Int) EditableChecker {
+	var best *Pair
+	var bestAmount1Out *big.Int
+	for _, fee := range s.SwapPoolFees(coin0, coin1) {
+		pair := s.PairWithFee(coin0, coin1, fee)
+		amount1Out := pair.CalculateBuyForSell(amount0In)
+		if best == nil || amount1Out != nil && (bestAmount1Out == nil || amount1Out.Cmp(bestAmount1Out) == 1) {
+			best, bestAmount1Out = pair, amount1Out
+		}
+	}
+	return best
+}
+
+// GetBestSwapperForBuy returns the pool of the pair which takes the least coin0 for amount1Out.
+// If none of the pools has enough liquidity, the pool of the lowest fee tier is returned.
+func (s *Swap) GetBestSwapperForBuy(coin0, coin1 types.CoinID, amount1Out *big.Int) EditableChecker {
+	var best *Pair
+	var bestAmount0In *big.Int
+	for _, fee := range s.SwapPoolFees(coin0, coin1) {
+		pair := s.PairWithFee(coin0, coin1, fee)
+		amount0In := pair.CalculateSellForBuy(amount1Out)
+		if best == nil || amount0In != nil && (bestAmount0In == nil || amount0In.Cmp(bestAmount0In) == -1) {
+			best, bestAmount0In = pair, amount0In
+		}
+	}
+	return best
+}
+
+// Pair returns the pool of the default fee tier
 func (s *Swap) Pair(coin0, coin1 types.CoinID) *Pair {
+	return s.PairWithFee(coin0, coin1, DefaultFee)
+}
+
+// PairWithFee returns the pool of given fee tier
+func (s *Swap) PairWithFee(coin0, coin1 types.CoinID, fee uint32) *Pair {
 	s.muPairs.Lock()
 	defer s.muPairs.Unlock()
 
-	key := pairKey{Coin0: coin0, Coin1: coin1}
+	key := pairKey{Coin0: coin0, Coin1: coin1, Fee: fee}
 	pair, ok := s.pair(key)
 	if ok {
 		return pair
@@ -334,7 +451,11 @@ func (s *Swap) PairCalculateBuyForSell(coin0, coin1 types.CoinID, amount0In *big
 }
 
 func (s *Swap) PairMint(coin0, coin1 types.CoinID, amount0, maxAmount1, totalSupply *big.Int) (*big.Int, *big.Int, *big.Int) {
-	pair := s.Pair(coin0, coin1)
+	return s.PairMintWithFee(coin0, coin1, DefaultFee, amount0, maxAmount1, totalSupply)
+}
+
+func (s *Swap) PairMintWithFee(coin0, coin1 types.CoinID, fee uint32, amount0, maxAmount1, totalSupply *big.Int) (*big.Int, *big.Int, *big.Int) {
+	pair := s.PairWithFee(coin0, coin1, fee)
 	oldReserve0, oldReserve1 := pair.Reserves()
 	liquidity := pair.Mint(amount0, maxAmount1, totalSupply)
 	newReserve0, newReserve1 := pair.Reserves()
@@ -349,7 +470,11 @@ func (s *Swap) PairMint(coin0, coin1 types.CoinID, amount0, maxAmount1, totalSup
 }
 
 func (s *Swap) PairCreate(coin0, coin1 types.CoinID, amount0, amount1 *big.Int) (*big.Int, *big.Int, *big.Int, uint32) {
-	pair := s.ReturnPair(coin0, coin1)
+	return s.PairCreateWithFee(coin0, coin1, DefaultFee, amount0, amount1)
+}
+
+func (s *Swap) PairCreateWithFee(coin0, coin1 types.CoinID, fee uint32, amount0, amount1 *big.Int) (*big.Int, *big.Int, *big.Int, uint32) {
+	pair := s.ReturnPairWithFee(coin0, coin1, fee)
 	id := s.incID()
 	*pair.ID = id
 	oldReserve0, oldReserve1 := pair.Reserves()
@@ -366,7 +491,11 @@ func (s *Swap) PairCreate(coin0, coin1 types.CoinID, amount0, amount1 *big.Int)
 }
 
 func (s *Swap) PairBurn(coin0, coin1 types.CoinID, liquidity, minAmount0, minAmount1, totalSupply *big.Int) (*big.Int, *big.Int) {
-	pair := s.Pair(coin0, coin1)
+	return s.PairBurnWithFee(coin0, coin1, DefaultFee, liquidity, minAmount0, minAmount1, totalSupply)
+}
+
+func (s *Swap) PairBurnWithFee(coin0, coin1 types.CoinID, fee uint32, liquidity, minAmount0, minAmount1, totalSupply *big.Int) (*big.Int, *big.Int) {
+	pair := s.PairWithFee(coin0, coin1, fee)
 	oldReserve0, oldReserve1 := pair.Reserves()
 	_, _ = pair.Burn(liquidity, minAmount0, minAmount1, totalSupply)
 	newReserve0, newReserve1 := pair.Reserves()
@@ -381,7 +510,11 @@ func (s *Swap) PairBurn(coin0, coin1 types.CoinID, liquidity, minAmount0, minAmo
 }
 
 func (s *Swap) PairSell(coin0, coin1 types.CoinID, amount0In, minAmount1Out *big.Int) (*big.Int, *big.Int, uint32) {
-	pair := s.Pair(coin0, coin1)
+	return s.PairSellWithFee(coin0, coin1, DefaultFee, amount0In, minAmount1Out)
+}
+
+func (s *Swap) PairSellWithFee(coin0, coin1 types.CoinID, fee uint32, amount0In, minAmount1Out *big.Int) (*big.Int, *big.Int, uint32) {
+	pair := s.PairWithFee(coin0, coin1, fee)
 	calculatedAmount1Out := pair.CalculateBuyForSell(amount0In)
 	if calculatedAmount1Out.Cmp(minAmount1Out) == -1 {
 		panic(fmt.Sprintf("calculatedAmount1Out %s less minAmount1Out %s", calculatedAmount1Out, minAmount1Out))
@@ -389,11 +522,20 @@ func (s *Swap) PairSell(coin0, coin1 types.CoinID, amount0In, minAmount1Out *big
 	balance0, balance1 := pair.Swap(amount0In, big.NewInt(0), big.NewInt(0), calculatedAmount1Out)
 	s.bus.Checker().AddCoin(coin0, balance0)
 	s.bus.Checker().AddCoin(coin1, balance1)
+	s.addSwapFee(pair, coin0, coin1, balance0)
+	s.addSwapEvent(pair, coin0, coin1, balance0, new(big.Int).Neg(balance1))
+	if candles := s.bus.Candles(); candles != nil {
+		candles.AddTrade(*pair.ID, coin0, coin1, balance0, new(big.Int).Neg(balance1))
+	}
 	return balance0, new(big.Int).Neg(balance1), *pair.ID
 }
 
 func (s *Swap) PairBuy(coin0, coin1 types.CoinID, maxAmount0In, amount1Out *big.Int) (*big.Int, *big.Int, uint32) {
-	pair := s.Pair(coin0, coin1)
+	return s.PairBuyWithFee(coin0, coin1, DefaultFee, maxAmount0In, amount1Out)
+}
+
+func (s *Swap) PairBuyWithFee(coin0, coin1 types.CoinID, fee uint32, maxAmount0In, amount1Out *big.Int) (*big.Int, *big.Int, uint32) {
+	pair := s.PairWithFee(coin0, coin1, fee)
 	calculatedAmount0In := pair.CalculateSellForBuy(amount1Out)
 	if calculatedAmount0In.Cmp(maxAmount0In) == 1 {
 		panic(fmt.Sprintf("calculatedAmount0In %s more maxAmount0In %s", calculatedAmount0In, maxAmount0In))
@@ -401,11 +543,72 @@ func (s *Swap) PairBuy(coin0, coin1 types.CoinID, maxAmount0In, amount1Out *big.
 	balance0, balance1 := pair.Swap(calculatedAmount0In, big.NewInt(0), big.NewInt(0), amount1Out)
 	s.bus.Checker().AddCoin(coin0, balance0)
 	s.bus.Checker().AddCoin(coin1, balance1)
+	s.addSwapFee(pair, coin0, coin1, balance0)
+	s.addSwapEvent(pair, coin0, coin1, balance0, new(big.Int).Neg(balance1))
+	if candles := s.bus.Candles(); candles != nil {
+		candles.AddTrade(*pair.ID, coin0, coin1, balance0, new(big.Int).Neg(balance1))
+	}
 	return balance0, new(big.Int).Neg(balance1), *pair.ID
 }
 
+// addSwapFee records the fee paid in coinIn by the swap to pool statistics, if they are enabled
+func (s *Swap) addSwapFee(pair *Pair, coinIn, coinOut types.CoinID, amountIn *big.Int) {
+	lpStats := s.bus.LPStats()
+	if lpStats == nil {
+		return
+	}
+
+	fee, denominator := pair.commission()
+	amount := new(big.Int).Quo(new(big.Int).Mul(amountIn, fee), denominator)
+
+	totalLiquidity := big.NewInt(0)
+	if coin := s.bus.Coins().GetCoinBySymbol(liquidityCoinSymbol(*pair.ID), 0); coin != nil {
+		totalLiquidity = coin.Volume
+	}
+
+	lpStats.AddSwapFee(*pair.ID, coinIn, coinOut, amount, totalLiquidity)
+}
+
+// addSwapEvent adds the event of the swap with reserves of the pool after it
+func (s *Swap) addSwapEvent(pair *Pair, coinIn, coinOut types.CoinID, valueIn, valueOut *big.Int) {
+	events := s.bus.Events()
+	if events == nil {
+		return
+	}
+
+	reserveIn, reserveOut := pair.Reserves()
+	events.AddEvent(&eventsdb.SwapEvent{
+		PoolID:     *pair.ID,
+		CoinIn:     uint64(coinIn),
+		ValueIn:    valueIn.String(),
+		CoinOut:    uint64(coinOut),
+		ValueOut:   valueOut.String(),
+		ReserveIn:  reserveIn.String(),
+		ReserveOut: reserveOut.String(),
+	})
+}
+
+// AddProviderLiquidity records liquidity added by the provider to pool statistics, if they are enabled
+func (s *Swap) AddProviderLiquidity(address types.Address, coin0, coin1 types.CoinID, poolID uint32, liquidity, amount0, amount1 *big.Int) {
+	if lpStats := s.bus.LPStats(); lpStats != nil {
+		lpStats.AddLiquidity(address, poolID, coin0, coin1, liquidity, amount0, amount1)
+	}
+}
+
+// RemoveProviderLiquidity records liquidity removed by the provider to pool statistics, if they are enabled
+func (s *Swap) RemoveProviderLiquidity(address types.Address, coin0, coin1 types.CoinID, poolID uint32, liquidity, amount0, amount1 *big.Int) {
+	if lpStats := s.bus.LPStats(); lpStats != nil {
+		lpStats.RemoveLiquidity(address, poolID, coin0, coin1, liquidity, amount0, amount1)
+	}
+}
+
+func liquidityCoinSymbol(id uint32) types.CoinSymbol {
+	return types.StrToCoinSymbol(fmt.Sprintf("LP-%d", id))
+}
+
 type pairKey struct {
 	Coin0, Coin1 types.CoinID
+	Fee          uint32
 }
 
 func (pk pairKey) sort() pairKey {
@@ -420,7 +623,7 @@ func (pk *pairKey) isSorted() bool {
 }
 
 func (pk *pairKey) reverse() pairKey {
-	return pairKey{Coin0: pk.Coin1, Coin1: pk.Coin0}
+	return pairKey{Coin0: pk.Coin1, Coin1: pk.Coin0, Fee: pk.Fee}
 }
 
 var (
@@ -428,11 +631,15 @@ var (
 )
 
 func (s *Swap) ReturnPair(coin0, coin1 types.CoinID) *Pair {
+	return s.ReturnPairWithFee(coin0, coin1, DefaultFee)
+}
+
+func (s *Swap) ReturnPairWithFee(coin0, coin1 types.CoinID, fee uint32) *Pair {
 	if coin0 == coin1 {
 		panic(ErrorIdenticalAddresses)
 	}
 
-	pair := s.Pair(coin0, coin1)
+	pair := s.PairWithFee(coin0, coin1, fee)
 	if pair != nil {
 		return pair
 	}
@@ -440,7 +647,7 @@ func (s *Swap) ReturnPair(coin0, coin1 types.CoinID) *Pair {
 	s.muPairs.Lock()
 	defer s.muPairs.Unlock()
 
-	key := pairKey{coin0, coin1}
+	key := pairKey{Coin0: coin0, Coin1: coin1, Fee: fee}
 	pair = s.addPair(key)
 
 	if !key.isSorted() {
@@ -470,6 +677,7 @@ func (s *Swap) addPair(key pairKey) *Pair {
 			Reserve1:  big.NewInt(0),
 			ID:        new(uint32),
 			markDirty: s.markDirty(key),
+			fee:       key.Fee,
 		},
 	}
 
@@ -554,6 +762,17 @@ func (p *Pair) GetID() uint32 {
 	return *p.ID
 }
 
+// Fee returns the fee tier of the pool in basis points
+func (p *Pair) Fee() uint32 {
+	if p == nil {
+		return 0
+	}
+	if p.fee == 0 {
+		return DefaultFee
+	}
+	return p.fee
+}
+
 func (p *Pair) CalculateAddLiquidity(amount0 *big.Int, totalSupply *big.Int) (liquidity *big.Int, amount1 *big.Int) {
 	reserve0, reserve1 := p.Reserves()
 	return new(big.Int).Div(new(big.Int).Mul(totalSupply, amount0), reserve0), new(big.Int).Div(new(big.Int).Mul(amount0, reserve1), reserve0)
@@ -639,12 +858,13 @@ var (
 	ErrorInsufficientLiquidity    = errors.New("INSUFFICIENT_LIQUIDITY")
 )
 
-// reserve1-(reserve0*reserve1)/((amount0+reserve0)-amount0*0.002)
+// reserve1-(reserve0*reserve1)/((amount0+reserve0)-amount0*fee)
 func (p *Pair) CalculateBuyForSell(amount0In *big.Int) (amount1Out *big.Int) {
 	reserve0, reserve1 := p.Reserves()
-	kAdjusted := new(big.Int).Mul(new(big.Int).Mul(reserve0, reserve1), big.NewInt(1000000))
-	balance0Adjusted := new(big.Int).Sub(new(big.Int).Mul(new(big.Int).Add(amount0In, reserve0), big.NewInt(1000)), new(big.Int).Mul(amount0In, big.NewInt(commission)))
-	amount1Out = new(big.Int).Sub(reserve1, new(big.Int).Quo(kAdjusted, new(big.Int).Mul(balance0Adjusted, big.NewInt(1000))))
+	fee, denominator := p.commission()
+	kAdjusted := new(big.Int).Mul(new(big.Int).Mul(reserve0, reserve1), new(big.Int).Mul(denominator, denominator))
+	balance0Adjusted := new(big.Int).Sub(new(big.Int).Mul(new(big.Int).Add(amount0In, reserve0), denominator), new(big.Int).Mul(amount0In, fee))
+	amount1Out = new(big.Int).Sub(reserve1, new(big.Int).Quo(kAdjusted, new(big.Int).Mul(balance0Adjusted, denominator)))
 	amount1Out = new(big.Int).Sub(amount1Out, big.NewInt(1))
 	if amount1Out.Sign() != 1 {
 		return nil
@@ -652,7 +872,7 @@ func (p *Pair) CalculateBuyForSell(amount0In *big.Int) (amount1Out *big.Int) {
 	return amount1Out
 }
 
-// (reserve0*reserve1/(reserve1-amount1)-reserve0)/0.998
+// (reserve0*reserve1/(reserve1-amount1)-reserve0)/(1-fee)
 func (p *Pair) CalculateSellForBuy(amount1Out *big.Int) (amount0In *big.Int) {
 	// for i := 0; true; i++ {
 	reserve0, reserve1 := p.Reserves()
@@ -665,9 +885,10 @@ func (p *Pair) CalculateSellForBuy(amount1Out *big.Int) (amount0In *big.Int) {
 	if amount1Out.Cmp(reserve1) != -1 {
 		return nil
 	}
-	kAdjusted := new(big.Int).Mul(k, big.NewInt(1000000))
-	balance1Adjusted := new(big.Int).Mul(new(big.Int).Add(new(big.Int).Neg(amount1Out), reserve1), big.NewInt(1000))
-	amount0In = new(big.Int).Quo(new(big.Int).Sub(new(big.Int).Quo(kAdjusted, balance1Adjusted), new(big.Int).Mul(reserve0, big.NewInt(1000))), big.NewInt(1000-commission))
+	fee, denominator := p.commission()
+	kAdjusted := new(big.Int).Mul(k, new(big.Int).Mul(denominator, denominator))
+	balance1Adjusted := new(big.Int).Mul(new(big.Int).Add(new(big.Int).Neg(amount1Out), reserve1), denominator)
+	amount0In = new(big.Int).Quo(new(big.Int).Sub(new(big.Int).Quo(kAdjusted, balance1Adjusted), new(big.Int).Mul(reserve0, denominator)), new(big.Int).Sub(denominator, fee))
 	return new(big.Int).Add(amount0In, big.NewInt(1))
 	// }
 }
@@ -690,10 +911,11 @@ func (p *Pair) Swap(amount0In, amount1In, amount0Out, amount1Out *big.Int) (amou
 		panic(ErrorInsufficientInputAmount)
 	}
 
-	balance0Adjusted := new(big.Int).Sub(new(big.Int).Mul(new(big.Int).Add(amount0, reserve0), big.NewInt(1000)), new(big.Int).Mul(amount0In, big.NewInt(commission)))
-	balance1Adjusted := new(big.Int).Sub(new(big.Int).Mul(new(big.Int).Add(amount1, reserve1), big.NewInt(1000)), new(big.Int).Mul(amount1In, big.NewInt(commission)))
+	fee, denominator := p.commission()
+	balance0Adjusted := new(big.Int).Sub(new(big.Int).Mul(new(big.Int).Add(amount0, reserve0), denominator), new(big.Int).Mul(amount0In, fee))
+	balance1Adjusted := new(big.Int).Sub(new(big.Int).Mul(new(big.Int).Add(amount1, reserve1), denominator), new(big.Int).Mul(amount1In, fee))
 
-	if new(big.Int).Mul(balance0Adjusted, balance1Adjusted).Cmp(new(big.Int).Mul(new(big.Int).Mul(reserve0, reserve1), big.NewInt(1000000))) == -1 {
+	if new(big.Int).Mul(balance0Adjusted, balance1Adjusted).Cmp(new(big.Int).Mul(new(big.Int).Mul(reserve0, reserve1), new(big.Int).Mul(denominator, denominator))) == -1 {
 		panic(ErrorK)
 	}
 
@@ -719,10 +941,11 @@ func (p *Pair) checkSwap(amount0In, amount1In, amount0Out, amount1Out *big.Int)
 		return ErrorInsufficientInputAmount
 	}
 
-	balance0Adjusted := new(big.Int).Sub(new(big.Int).Mul(new(big.Int).Add(amount0, reserve0), big.NewInt(1000)), new(big.Int).Mul(amount0In, big.NewInt(commission)))
-	balance1Adjusted := new(big.Int).Sub(new(big.Int).Mul(new(big.Int).Add(amount1, reserve1), big.NewInt(1000)), new(big.Int).Mul(amount1In, big.NewInt(commission)))
+	fee, denominator := p.commission()
+	balance0Adjusted := new(big.Int).Sub(new(big.Int).Mul(new(big.Int).Add(amount0, reserve0), denominator), new(big.Int).Mul(amount0In, fee))
+	balance1Adjusted := new(big.Int).Sub(new(big.Int).Mul(new(big.Int).Add(amount1, reserve1), denominator), new(big.Int).Mul(amount1In, fee))
 
-	if new(big.Int).Mul(balance0Adjusted, balance1Adjusted).Cmp(new(big.Int).Mul(new(big.Int).Mul(reserve0, reserve1), big.NewInt(1000000))) == -1 {
+	if new(big.Int).Mul(balance0Adjusted, balance1Adjusted).Cmp(new(big.Int).Mul(new(big.Int).Mul(reserve0, reserve1), new(big.Int).Mul(denominator, denominator))) == -1 {
 		return ErrorK
 	}
 	return nil
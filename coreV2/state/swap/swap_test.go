@@ -3,6 +3,7 @@ package swap
 import (
 	"github.com/MinterTeam/minter-go-node/coreV2/state/bus"
 	"github.com/MinterTeam/minter-go-node/coreV2/state/checker"
+	"github.com/MinterTeam/minter-go-node/coreV2/types"
 	"github.com/MinterTeam/minter-go-node/tree"
 	db "github.com/tendermint/tm-db"
 	"math/big"
@@ -90,3 +91,65 @@ func TestPair_commission(t *testing.T) {
 	}
 
 }
+
+func TestPair_feeTiers(t *testing.T) {
+	memDB := db.NewMemDB()
+	immutableTree, err := tree.NewMutableTree(0, memDB, 1024, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	newBus := bus.NewBus()
+	checker.NewChecker(newBus)
+	swap := New(newBus, immutableTree.GetLastImmutable())
+	r0 := big.NewInt(1e18)
+	r1 := big.NewInt(1e18)
+	_, _, _, id := swap.PairCreate(0, 1, r0, r1)
+	_, _, _, idWithFee := swap.PairCreateWithFee(0, 1, 5, r0, r1)
+	if id == idWithFee {
+		t.Fatal("pools of different fee tiers should have different ids")
+	}
+
+	_, _, err = immutableTree.Commit(swap)
+	if err != nil {
+		t.Fatal(err)
+	}
+	swap = New(newBus, immutableTree.GetLastImmutable())
+
+	if fees := swap.SwapPoolFees(1, 0); len(fees) != 2 || fees[0] != 5 || fees[1] != DefaultFee {
+		t.Fatalf("fees %v", fees)
+	}
+	if swap.SwapPoolExistWithFee(0, 1, 30) {
+		t.Fatal("pool of tier 30 should not exist")
+	}
+
+	valueSwap := big.NewInt(1e17)
+	amountOut := swap.GetSwapper(0, 1).CalculateBuyForSell(valueSwap)
+	amountOutWithFee := swap.GetSwapperWithFee(0, 1, 5).CalculateBuyForSell(valueSwap)
+	if amountOutWithFee.Cmp(amountOut) != 1 {
+		t.Fatalf("pool with lower fee should return more: %s <= %s", amountOutWithFee, amountOut)
+	}
+	if best := swap.GetBestSwapperForSell(0, 1, valueSwap); best.GetID() != idWithFee || best.Fee() != 5 {
+		t.Fatalf("best pool %d with fee %d", best.GetID(), best.Fee())
+	}
+
+	swap.PairSellWithFee(0, 1, 5, valueSwap, big.NewInt(0))
+	if reserve0, _ := swap.Pair(0, 1).Reserves(); reserve0.Cmp(r0) != 0 {
+		t.Fatal("pool of the default tier should not be changed")
+	}
+	if reserve0, _ := swap.PairWithFee(0, 1, 5).Reserves(); reserve0.Cmp(big.NewInt(0).Add(r0, valueSwap)) != 0 {
+		t.Fatalf("reserve0 %s", reserve0)
+	}
+
+	appState := new(types.AppState)
+	swap.Export(appState)
+	if len(appState.Pools) != 2 {
+		t.Fatalf("exported %d pools", len(appState.Pools))
+	}
+	fees := map[uint32]bool{}
+	for _, pool := range appState.Pools {
+		fees[pool.Fee] = true
+	}
+	if !fees[0] || !fees[5] {
+		t.Fatalf("exported fees %v", fees)
+	}
+}
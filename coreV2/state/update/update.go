@@ -59,6 +59,13 @@ func (c *Update) SetImmutableTree(immutableTree *iavl.ImmutableTree) {
 }
 
 func (c *Update) Export(state *types.AppState) {
+	c.ExportEach(func(vote types.UpdateVote) {
+		state.UpdateVotes = append(state.UpdateVotes, vote)
+	})
+}
+
+// ExportEach calls fn for every update vote in order of heights
+func (c *Update) ExportEach(fn func(vote types.UpdateVote)) {
 	c.immutableTree().IterateRange([]byte{mainPrefix}, []byte{mainPrefix + 1}, true, func(key []byte, value []byte) bool {
 		if len(key) < 8 {
 			return false
@@ -70,7 +77,7 @@ func (c *Update) Export(state *types.AppState) {
 		}
 
 		for _, u := range updates {
-			state.UpdateVotes = append(state.UpdateVotes, types.UpdateVote{
+			fn(types.UpdateVote{
 				Height:  height,
 				Votes:   u.Votes,
 				Version: u.Version,
@@ -79,7 +86,6 @@ func (c *Update) Export(state *types.AppState) {
 
 		return false
 	})
-
 }
 
 // Deprecated
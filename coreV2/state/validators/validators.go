@@ -485,10 +485,17 @@ func (v *Validators) SetValidators(vals []*Validator) {
 
 // Export exports all data to the given state
 func (v *Validators) Export(state *types.AppState) {
+	v.ExportEach(func(validator types.Validator) {
+		state.Validators = append(state.Validators, validator)
+	})
+}
+
+// ExportEach calls fn for every validator
+func (v *Validators) ExportEach(fn func(validator types.Validator)) {
 	v.LoadValidators()
 
 	for _, val := range v.GetValidators() {
-		state.Validators = append(state.Validators, types.Validator{
+		fn(types.Validator{
 			TotalBipStake: val.GetTotalBipStake().String(),
 			PubKey:        val.PubKey,
 			AccumReward:   val.GetAccumReward().String(),
@@ -66,13 +66,32 @@ func (wl *WaitList) SetImmutableTree(immutableTree *iavl.ImmutableTree) {
 }
 
 func (wl *WaitList) Export(state *types.AppState) {
+	wl.ExportEach(func(item types.Waitlist) {
+		state.Waitlist = append(state.Waitlist, item)
+	})
+
+	sort.SliceStable(state.Waitlist, func(i, j int) bool {
+		return bytes.Compare(state.Waitlist[i].Owner.Bytes(), state.Waitlist[j].Owner.Bytes()) == 1
+	})
+}
+
+// ExportEach calls fn for every waitlist item in order of owner addresses.
+// Waitlists loaded only for the export are not kept in memory.
+func (wl *WaitList) ExportEach(fn func(item types.Waitlist)) {
 	wl.immutableTree().IterateRange([]byte{mainPrefix}, []byte{mainPrefix + 1}, true, func(key []byte, value []byte) bool {
 		address := types.BytesToAddress(key[1:])
 
+		cached := wl.getFromMap(address) != nil
 		model := wl.GetByAddress(address)
+		if !cached {
+			wl.lock.Lock()
+			delete(wl.list, address)
+			wl.lock.Unlock()
+		}
+
 		if model != nil && len(model.List) != 0 {
 			for _, w := range model.List {
-				state.Waitlist = append(state.Waitlist, types.Waitlist{
+				fn(types.Waitlist{
 					CandidateID: uint64(w.CandidateId),
 					Owner:       address,
 					Coin:        uint64(w.Coin),
@@ -83,10 +102,6 @@ func (wl *WaitList) Export(state *types.AppState) {
 
 		return false
 	})
-
-	sort.SliceStable(state.Waitlist, func(i, j int) bool {
-		return bytes.Compare(state.Waitlist[i].Owner.Bytes(), state.Waitlist[j].Owner.Bytes()) == 1
-	})
 }
 
 // Deprecated
@@ -0,0 +1,364 @@
+package statediff
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math/big"
+	"sort"
+
+	"github.com/MinterTeam/minter-go-node/coreV2/state"
+	"github.com/MinterTeam/minter-go-node/coreV2/state/candidates"
+	"github.com/MinterTeam/minter-go-node/coreV2/types"
+	"github.com/MinterTeam/minter-go-node/helpers"
+)
+
+// Coin changes
+const (
+	CoinCreated   = "created"
+	CoinRecreated = "recreated"
+)
+
+// Diff contains changes of the state between two heights
+type Diff struct {
+	From       uint64            `json:"from"`
+	To         uint64            `json:"to"`
+	Balances   []BalanceChange   `json:"balances"`
+	Coins      []CoinChange      `json:"coins"`
+	Stakes     []StakeChange     `json:"stakes"`
+	Pools      []PoolChange      `json:"pools"`
+	Candidates []CandidateChange `json:"candidates"`
+}
+
+// BalanceChange is a changed balance of the address in the coin
+type BalanceChange struct {
+	Address types.Address `json:"address"`
+	Coin    uint64        `json:"coin"`
+	From    string        `json:"from"`
+	To      string        `json:"to"`
+	Delta   string        `json:"delta"`
+}
+
+// CoinChange is a created or recreated coin
+type CoinChange struct {
+	ID      uint64           `json:"id"`
+	Symbol  types.CoinSymbol `json:"symbol"`
+	Version uint64           `json:"version"`
+	Change  string           `json:"change"`
+}
+
+// StakeChange is a changed stake of the owner in the coin at the candidate
+type StakeChange struct {
+	PubKey types.Pubkey  `json:"pub_key"`
+	Owner  types.Address `json:"owner"`
+	Coin   uint64        `json:"coin"`
+	From   string        `json:"from"`
+	To     string        `json:"to"`
+	Delta  string        `json:"delta"`
+}
+
+// PoolChange is a pool with changed reserves
+type PoolChange struct {
+	ID           uint64 `json:"id"`
+	Coin0        uint64 `json:"coin0"`
+	Coin1        uint64 `json:"coin1"`
+	FromReserve0 string `json:"from_reserve0"`
+	FromReserve1 string `json:"from_reserve1"`
+	ToReserve0   string `json:"to_reserve0"`
+	ToReserve1   string `json:"to_reserve1"`
+}
+
+// CandidateChange is a candidate with changed status or jail height. Status of a new candidate is empty at From, status of a removed one is empty at To.
+type CandidateChange struct {
+	PubKey          types.Pubkey `json:"pub_key"`
+	FromStatus      string       `json:"from_status"`
+	ToStatus        string       `json:"to_status"`
+	FromJailedUntil uint64       `json:"from_jailed_until"`
+	ToJailedUntil   uint64       `json:"to_jailed_until"`
+}
+
+// ErrTooManyChanges is returned by Compare if count of changes exceeds the limit
+var ErrTooManyChanges = errors.New("too many changes between heights, narrow the range")
+
+// Compare returns changes between states at fromHeight and toHeight.
+// It stops when ctx is done or when count of changes exceeds maxChanges, 0 means no limit.
+func Compare(ctx context.Context, fromHeight uint64, from *state.CheckState, toHeight uint64, to *state.CheckState, maxChanges int) (*Diff, error) {
+	fromState, toState := new(types.AppState), new(types.AppState)
+	for _, s := range []struct {
+		cState   *state.CheckState
+		appState *types.AppState
+	}{{from, fromState}, {to, toState}} {
+		for _, export := range []func(*types.AppState){
+			s.cState.Accounts().Export,
+			s.cState.Coins().Export,
+			s.cState.Candidates().Export,
+			s.cState.Swap().Export,
+		} {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+			export(s.appState)
+		}
+	}
+
+	diff := &Diff{
+		From: fromHeight,
+		To:   toHeight,
+	}
+	count := 0
+	for _, compare := range []func() int{
+		func() int {
+			diff.Balances = compareBalances(fromState.Accounts, toState.Accounts)
+			return len(diff.Balances)
+		},
+		func() int {
+			diff.Coins = compareCoins(fromState.Coins, toState.Coins)
+			return len(diff.Coins)
+		},
+		func() int {
+			diff.Stakes = compareStakes(fromState.Candidates, toState.Candidates)
+			return len(diff.Stakes)
+		},
+		func() int {
+			diff.Pools = comparePools(fromState.Pools, toState.Pools)
+			return len(diff.Pools)
+		},
+		func() int {
+			diff.Candidates = compareCandidates(fromState.Candidates, toState.Candidates)
+			return len(diff.Candidates)
+		},
+	} {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		count += compare()
+		if maxChanges > 0 && count > maxChanges {
+			return nil, ErrTooManyChanges
+		}
+	}
+
+	return diff, nil
+}
+
+type balanceKey struct {
+	address types.Address
+	coin    uint64
+}
+
+func compareBalances(from, to []types.Account) []BalanceChange {
+	balances := map[balanceKey][2]string{}
+	for i, accounts := range [][]types.Account{from, to} {
+		for _, account := range accounts {
+			for _, balance := range account.Balance {
+				key := balanceKey{account.Address, balance.Coin}
+				values := balances[key]
+				values[i] = balance.Value
+				balances[key] = values
+			}
+		}
+	}
+
+	changes := make([]BalanceChange, 0)
+	for key, values := range balances {
+		fromValue, toValue, delta := compareValues(values[0], values[1])
+		if delta == nil {
+			continue
+		}
+
+		changes = append(changes, BalanceChange{
+			Address: key.address,
+			Coin:    key.coin,
+			From:    fromValue.String(),
+			To:      toValue.String(),
+			Delta:   delta.String(),
+		})
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].Address != changes[j].Address {
+			return bytes.Compare(changes[i].Address.Bytes(), changes[j].Address.Bytes()) == -1
+		}
+		return changes[i].Coin < changes[j].Coin
+	})
+
+	return changes
+}
+
+func compareCoins(from, to []types.Coin) []CoinChange {
+	ids := map[uint64]bool{}
+	symbols := map[types.CoinSymbol]bool{}
+	for _, coin := range from {
+		ids[coin.ID] = true
+		symbols[coin.Symbol] = true
+	}
+
+	changes := make([]CoinChange, 0)
+	for _, coin := range to {
+		if ids[coin.ID] {
+			continue
+		}
+
+		change := CoinCreated
+		if symbols[coin.Symbol] {
+			change = CoinRecreated
+		}
+
+		changes = append(changes, CoinChange{
+			ID:      coin.ID,
+			Symbol:  coin.Symbol,
+			Version: coin.Version,
+			Change:  change,
+		})
+	}
+
+	return changes
+}
+
+type stakeKey struct {
+	pubKey types.Pubkey
+	owner  types.Address
+	coin   uint64
+}
+
+func compareStakes(from, to []types.Candidate) []StakeChange {
+	stakes := map[stakeKey][2]string{}
+	for i, list := range [][]types.Candidate{from, to} {
+		for _, candidate := range list {
+			for _, stake := range candidate.Stakes {
+				key := stakeKey{candidate.PubKey, stake.Owner, stake.Coin}
+				values := stakes[key]
+				values[i] = stake.Value
+				stakes[key] = values
+			}
+		}
+	}
+
+	changes := make([]StakeChange, 0)
+	for key, values := range stakes {
+		fromValue, toValue, delta := compareValues(values[0], values[1])
+		if delta == nil {
+			continue
+		}
+
+		changes = append(changes, StakeChange{
+			PubKey: key.pubKey,
+			Owner:  key.owner,
+			Coin:   key.coin,
+			From:   fromValue.String(),
+			To:     toValue.String(),
+			Delta:  delta.String(),
+		})
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].PubKey != changes[j].PubKey {
+			return bytes.Compare(changes[i].PubKey.Bytes(), changes[j].PubKey.Bytes()) == -1
+		}
+		if changes[i].Owner != changes[j].Owner {
+			return bytes.Compare(changes[i].Owner.Bytes(), changes[j].Owner.Bytes()) == -1
+		}
+		return changes[i].Coin < changes[j].Coin
+	})
+
+	return changes
+}
+
+func comparePools(from, to []types.Pool) []PoolChange {
+	pools := map[uint64]types.Pool{}
+	for _, pool := range from {
+		pools[pool.ID] = pool
+	}
+
+	changes := make([]PoolChange, 0)
+	for _, pool := range to {
+		old, ok := pools[pool.ID]
+		if !ok {
+			old = types.Pool{Reserve0: "0", Reserve1: "0"}
+		}
+		if old.Reserve0 == pool.Reserve0 && old.Reserve1 == pool.Reserve1 {
+			continue
+		}
+
+		changes = append(changes, PoolChange{
+			ID:           pool.ID,
+			Coin0:        pool.Coin0,
+			Coin1:        pool.Coin1,
+			FromReserve0: old.Reserve0,
+			FromReserve1: old.Reserve1,
+			ToReserve0:   pool.Reserve0,
+			ToReserve1:   pool.Reserve1,
+		})
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].ID < changes[j].ID
+	})
+
+	return changes
+}
+
+func compareCandidates(from, to []types.Candidate) []CandidateChange {
+	candidatesList := map[types.Pubkey][2]*types.Candidate{}
+	for i, list := range [][]types.Candidate{from, to} {
+		for j := range list {
+			candidate := &list[j]
+			values := candidatesList[candidate.PubKey]
+			values[i] = candidate
+			candidatesList[candidate.PubKey] = values
+		}
+	}
+
+	changes := make([]CandidateChange, 0)
+	for pubKey, values := range candidatesList {
+		old, candidate := values[0], values[1]
+		if old != nil && candidate != nil && old.Status == candidate.Status && old.JailedUntil == candidate.JailedUntil {
+			continue
+		}
+
+		change := CandidateChange{PubKey: pubKey}
+		if old != nil {
+			change.FromStatus = statusName(old.Status)
+			change.FromJailedUntil = old.JailedUntil
+		}
+		if candidate != nil {
+			change.ToStatus = statusName(candidate.Status)
+			change.ToJailedUntil = candidate.JailedUntil
+		}
+
+		changes = append(changes, change)
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return bytes.Compare(changes[i].PubKey.Bytes(), changes[j].PubKey.Bytes()) == -1
+	})
+
+	return changes
+}
+
+func statusName(status uint64) string {
+	switch status {
+	case candidates.CandidateStatusOnline:
+		return "online"
+	case candidates.CandidateStatusOffline:
+		return "offline"
+	default:
+		return ""
+	}
+}
+
+// compareValues returns nil delta if values are equal, empty value is zero
+func compareValues(from, to string) (*big.Int, *big.Int, *big.Int) {
+	fromValue, toValue := big.NewInt(0), big.NewInt(0)
+	if from != "" {
+		fromValue = helpers.StringToBigInt(from)
+	}
+	if to != "" {
+		toValue = helpers.StringToBigInt(to)
+	}
+
+	if fromValue.Cmp(toValue) == 0 {
+		return fromValue, toValue, nil
+	}
+
+	return fromValue, toValue, new(big.Int).Sub(toValue, fromValue)
+}
@@ -0,0 +1,105 @@
+package statediff
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	eventsdb "github.com/MinterTeam/minter-go-node/coreV2/events"
+	"github.com/MinterTeam/minter-go-node/coreV2/state"
+	"github.com/MinterTeam/minter-go-node/coreV2/state/candidates"
+	"github.com/MinterTeam/minter-go-node/coreV2/types"
+	"github.com/MinterTeam/minter-go-node/helpers"
+	db "github.com/tendermint/tm-db"
+)
+
+func TestCompare(t *testing.T) {
+	t.Parallel()
+
+	memDB := db.NewMemDB()
+	s, err := state.NewState(0, memDB, &eventsdb.MockEvents{}, 1, 10, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	address := types.StringToAddress("Mx0000000000000000000000000000000000000001")
+	pubkey := types.Pubkey{1}
+
+	coinID := s.App.GetNextCoinID()
+	s.Coins.Create(coinID, types.StrToCoinSymbol("TEST"), "TEST", helpers.BipToPip(big.NewInt(1000)), 50, helpers.BipToPip(big.NewInt(500)), helpers.BipToPip(big.NewInt(1000)), nil)
+	s.App.SetCoinsCount(coinID.Uint32())
+	s.Accounts.AddBalance(address, types.GetBaseCoinID(), helpers.BipToPip(big.NewInt(10)))
+	s.Candidates.Create(address, address, address, pubkey, 10, 0, 0)
+	s.Swap.ImportPool(types.Pool{Coin0: 0, Coin1: uint64(coinID), Reserve0: "1000", Reserve1: "2000", ID: 1})
+	if _, err := s.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	s.Accounts.SubBalance(address, types.GetBaseCoinID(), helpers.BipToPip(big.NewInt(4)))
+	s.Candidates.SetOnline(pubkey)
+	s.Candidates.SetStakes(pubkey, []types.Stake{{Owner: address, Coin: 0, Value: "4", BipValue: "4"}}, nil)
+	s.Swap.ImportPool(types.Pool{Coin0: 0, Coin1: uint64(coinID), Reserve0: "1500", Reserve1: "1500", ID: 1})
+	s.Coins.Recreate(coinID+1, "TEST", types.StrToCoinSymbol("TEST"), helpers.BipToPip(big.NewInt(1000)), 50, helpers.BipToPip(big.NewInt(500)), helpers.BipToPip(big.NewInt(1000)))
+	if _, err := s.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	from, err := state.NewCheckStateAtHeight(1, memDB)
+	if err != nil {
+		t.Fatal(err)
+	}
+	to, err := state.NewCheckStateAtHeight(2, memDB)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	diff, err := Compare(context.Background(), 1, from, 2, to, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(diff.Balances) != 1 || diff.Balances[0].Address != address || diff.Balances[0].Delta != helpers.BipToPip(big.NewInt(-4)).String() {
+		t.Errorf("wrong balances diff: %+v", diff.Balances)
+	}
+
+	if len(diff.Coins) != 1 || diff.Coins[0].ID != uint64(coinID+1) || diff.Coins[0].Change != CoinRecreated {
+		t.Errorf("wrong coins diff: %+v", diff.Coins)
+	}
+
+	if len(diff.Stakes) != 1 || diff.Stakes[0].From != "0" || diff.Stakes[0].To != "4" {
+		t.Errorf("wrong stakes diff: %+v", diff.Stakes)
+	}
+
+	if len(diff.Pools) != 1 || diff.Pools[0].FromReserve0 != "1000" || diff.Pools[0].ToReserve0 != "1500" {
+		t.Errorf("wrong pools diff: %+v", diff.Pools)
+	}
+
+	if len(diff.Candidates) != 1 || diff.Candidates[0].FromStatus != "offline" || diff.Candidates[0].ToStatus != "online" {
+		t.Errorf("wrong candidates diff: %+v", diff.Candidates)
+	}
+
+	if _, err := Compare(context.Background(), 1, from, 2, to, 4); err != ErrTooManyChanges {
+		t.Errorf("want %v for limited changes, got %v", ErrTooManyChanges, err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := Compare(ctx, 1, from, 2, to, 0); err != context.Canceled {
+		t.Errorf("want %v for canceled context, got %v", context.Canceled, err)
+	}
+}
+
+func TestCompareCandidates_Removed(t *testing.T) {
+	t.Parallel()
+
+	removed := types.Candidate{PubKey: types.Pubkey{1}, Status: candidates.CandidateStatusOnline}
+	kept := types.Candidate{PubKey: types.Pubkey{2}, Status: candidates.CandidateStatusOffline}
+
+	changes := compareCandidates([]types.Candidate{removed, kept}, []types.Candidate{kept})
+	if len(changes) != 1 {
+		t.Fatalf("want 1 change, got %+v", changes)
+	}
+	if changes[0].PubKey != removed.PubKey || changes[0].FromStatus != "online" || changes[0].ToStatus != "" {
+		t.Errorf("wrong change of removed candidate: %+v", changes[0])
+	}
+}
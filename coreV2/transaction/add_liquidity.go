@@ -16,6 +16,8 @@ type AddLiquidityData struct {
 	Coin1          types.CoinID
 	Volume0        *big.Int
 	MaximumVolume1 *big.Int
+
+	fee uint32
 }
 
 func (data AddLiquidityData) Gas() int64 {
@@ -37,7 +39,7 @@ func (data AddLiquidityData) basicCheck(tx *Transaction, context *state.CheckSta
 		}
 	}
 
-	if !context.Swap().SwapPoolExist(data.Coin0, data.Coin1) {
+	if !context.Swap().SwapPoolExistWithFee(data.Coin0, data.Coin1, poolFee(data.fee)) {
 		return &Response{
 			Code: code.PairNotExists,
 			Log:  "swap pool not found",
@@ -65,7 +67,11 @@ func (data AddLiquidityData) basicCheck(tx *Transaction, context *state.CheckSta
 		}
 	}
 
-	return nil
+	sender, _ := tx.Sender()
+	if errResp := checkCoinSpender(context, data.Coin0, sender); errResp != nil {
+		return errResp
+	}
+	return checkCoinSpender(context, data.Coin1, sender)
 }
 
 func (data AddLiquidityData) String() string {
@@ -100,8 +106,8 @@ func (data AddLiquidityData) Run(tx *Transaction, context state.Interface, rewar
 
 	neededAmount1 := new(big.Int).Set(data.MaximumVolume1)
 
-	swapper := checkState.Swap().GetSwapper(data.Coin0, data.Coin1)
-	if isGasCommissionFromPoolSwap {
+	swapper := checkState.Swap().GetSwapperWithFee(data.Coin0, data.Coin1, poolFee(data.fee))
+	if isGasCommissionFromPoolSwap && swapper.Fee() == swap.DefaultFee {
 		if tx.GasCoin == data.Coin0 && data.Coin1.IsBaseCoin() {
 			swapper = swapper.AddLastSwapStep(commission, commissionInBaseCoin)
 		}
@@ -185,12 +191,15 @@ func (data AddLiquidityData) Run(tx *Transaction, context state.Interface, rewar
 		deliverState.Accounts.SubBalance(sender, tx.GasCoin, commission)
 		rewardPool.Add(rewardPool, commissionInBaseCoin)
 
-		amount0, amount1, liquidity := deliverState.Swap.PairMint(data.Coin0, data.Coin1, data.Volume0, data.MaximumVolume1, coinLiquidity.Volume())
+		amount0, amount1, liquidity := deliverState.Swap.PairMintWithFee(data.Coin0, data.Coin1, poolFee(data.fee), data.Volume0, data.MaximumVolume1, coinLiquidity.Volume())
 		deliverState.Accounts.SubBalance(sender, data.Coin0, amount0)
 		deliverState.Accounts.SubBalance(sender, data.Coin1, amount1)
 
 		deliverState.Coins.AddVolume(coinLiquidity.ID(), liquidity)
 		deliverState.Accounts.AddBalance(sender, coinLiquidity.ID(), liquidity)
+		deliverState.Swap.AddProviderLiquidity(sender, data.Coin0, data.Coin1, swapper.GetID(), liquidity, amount0, amount1)
+		event := liquidityEvent(deliverState, sender, data.Coin0, data.Coin1, poolFee(data.fee), liquidity, amount0, amount1)
+		deliverState.AddEvent(&event)
 
 		deliverState.Accounts.SetNonce(sender, tx.Nonce)
 
@@ -219,3 +228,50 @@ func liquidityCoinName(c0, c1 types.CoinID) string {
 	}
 	return fmt.Sprintf("%d-%d", c1, c0)
 }
+
+// AddLiquidityDataV250 is AddLiquidityData with the fee tier of the pool in basis points.
+// Fee is optional and can contain at most one value, the default tier is used if it is empty.
+type AddLiquidityDataV250 struct {
+	Coin0          types.CoinID
+	Coin1          types.CoinID
+	Volume0        *big.Int
+	MaximumVolume1 *big.Int
+	Fee            []uint32 `rlp:"tail"`
+}
+
+func (data AddLiquidityDataV250) data() AddLiquidityData {
+	return AddLiquidityData{
+		Coin0:          data.Coin0,
+		Coin1:          data.Coin1,
+		Volume0:        data.Volume0,
+		MaximumVolume1: data.MaximumVolume1,
+		fee:            optionalFee(data.Fee),
+	}
+}
+
+func (data AddLiquidityDataV250) TxType() TxType {
+	return TypeAddLiquidity
+}
+
+func (data AddLiquidityDataV250) Gas() int64 {
+	return data.data().Gas()
+}
+
+func (data AddLiquidityDataV250) String() string {
+	return data.data().String()
+}
+
+func (data AddLiquidityDataV250) CommissionData(price *commission.Price) *big.Int {
+	return data.data().CommissionData(price)
+}
+
+func (data AddLiquidityDataV250) Run(tx *Transaction, context state.Interface, rewardPool *big.Int, currentBlock uint64, price *big.Int) Response {
+	if len(data.Fee) > 1 {
+		return Response{
+			Code: code.DecodeError,
+			Log:  "Incorrect tx data",
+			Info: EncodeError(code.NewDecodeError()),
+		}
+	}
+	return data.data().Run(tx, context, rewardPool, currentBlock, price)
+}
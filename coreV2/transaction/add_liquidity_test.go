@@ -5,12 +5,14 @@ import (
 	"sync"
 	"testing"
 
+	"github.com/MinterTeam/minter-go-node/coreV2/lpstats"
 	"github.com/MinterTeam/minter-go-node/coreV2/state"
 
 	"github.com/MinterTeam/minter-go-node/coreV2/types"
 	"github.com/MinterTeam/minter-go-node/crypto"
 	"github.com/MinterTeam/minter-go-node/helpers"
 	"github.com/MinterTeam/minter-go-node/rlp"
+	db "github.com/tendermint/tm-db"
 )
 
 func createNonReserveCoin(stateDB *state.State) types.CoinID {
@@ -479,3 +481,72 @@ func TestAddExchangeLiquidityTx_addLiquidity_2(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+func TestAddExchangeLiquidityTx_lpStats(t *testing.T) {
+	t.Parallel()
+	cState := getState()
+	lpStats := lpstats.NewStore(db.NewMemDB())
+	cState.SetLPStats(lpStats)
+
+	coin := createTestCoin(cState)
+	coin1 := createNonReserveCoin(cState)
+
+	privateKey, addr := getAccount()
+	cState.Accounts.AddBalance(addr, types.BasecoinID, helpers.BipToPip(big.NewInt(1000000)))
+	cState.Accounts.SubBalance(types.Address{}, coin, helpers.BipToPip(big.NewInt(100000)))
+	cState.Accounts.AddBalance(addr, coin, helpers.BipToPip(big.NewInt(100000)))
+	cState.Accounts.SubBalance(types.Address{}, coin1, helpers.BipToPip(big.NewInt(100000)))
+	cState.Accounts.AddBalance(addr, coin1, helpers.BipToPip(big.NewInt(100000)))
+
+	txs := []struct {
+		txType TxType
+		data   interface{}
+	}{
+		{TypeCreateSwapPool, CreateSwapPoolDataV250{Coin0: coin, Coin1: coin1, Volume0: helpers.BipToPip(big.NewInt(1000)), Volume1: helpers.BipToPip(big.NewInt(1000))}},
+		{TypeSellSwapPool, SellSwapPoolDataV250{Coins: []types.CoinID{coin1, coin}, ValueToSell: helpers.BipToPip(big.NewInt(100)), MinimumValueToBuy: big.NewInt(1)}},
+		{TypeRemoveLiquidity, RemoveLiquidityV250{Coin0: coin1, Coin1: coin, Liquidity: helpers.BipToPip(big.NewInt(100)), MinimumVolume0: big.NewInt(1), MinimumVolume1: big.NewInt(1)}},
+	}
+	for i, item := range txs {
+		tx, err := makeTestSwapPoolTx(item.txType, item.data, uint64(i+1), privateKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		response := NewExecutor(GetDataV250).RunTx(cState, tx, big.NewInt(0), 0, &sync.Map{}, 0, false)
+		if response.Code != 0 {
+			t.Fatalf("Response code %d is not 0. Error: %s", response.Code, response.Log)
+		}
+	}
+	if err := lpStats.Commit(1); err != nil {
+		t.Fatal(err)
+	}
+
+	poolID := cState.Swap.GetSwapper(coin, coin1).GetID()
+	fees, err := lpStats.PoolFees(poolID, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fee0, fee1 := fees.Amount0, fees.Amount1
+	if coin1 < coin {
+		fee0, fee1 = fee1, fee0
+	}
+	if fee0.Sign() != 0 || fee1.Cmp(big.NewInt(2e17)) != 0 {
+		t.Fatalf("pool fees are %s and %s", fee0, fee1)
+	}
+
+	entries, err := lpStats.History(addr, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	positions := lpstats.Positions(entries)
+	if len(positions) != 1 || len(positions[0].History) != 2 {
+		t.Fatalf("wrong positions %v", positions)
+	}
+	liquidityCoin := cState.Coins.GetCoinBySymbol(LiquidityCoinSymbol(poolID), 0)
+	if balance := cState.Accounts.GetBalance(addr, liquidityCoin.ID()); positions[0].Liquidity.Cmp(balance) != 0 {
+		t.Fatalf("liquidity of position %s is not equal to balance %s", positions[0].Liquidity, balance)
+	}
+
+	if err := checkState(cState); err != nil {
+		t.Error(err)
+	}
+}
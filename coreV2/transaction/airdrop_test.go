@@ -0,0 +1,72 @@
+package transaction
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/MinterTeam/minter-go-node/coreV2/code"
+	"github.com/MinterTeam/minter-go-node/coreV2/state/airdrops"
+	"github.com/MinterTeam/minter-go-node/coreV2/types"
+	"github.com/MinterTeam/minter-go-node/helpers"
+)
+
+func TestAirdropTx(t *testing.T) {
+	t.Parallel()
+	cState := getState()
+
+	creatorKey, creator := getAccount()
+	claimerKey, claimer := getAccount()
+	otherKey, other := getAccount()
+	cState.Accounts.AddBalance(creator, types.GetBaseCoinID(), helpers.BipToPip(big.NewInt(1000000)))
+	cState.Accounts.AddBalance(other, types.GetBaseCoinID(), helpers.BipToPip(big.NewInt(1000000)))
+
+	values := []*big.Int{helpers.BipToPip(big.NewInt(100)), helpers.BipToPip(big.NewInt(200)), helpers.BipToPip(big.NewInt(300))}
+	addresses := []types.Address{claimer, other, {1}}
+	var leaves []types.Hash
+	for i := range values {
+		leaves = append(leaves, airdrops.Leaf(uint32(i), addresses[i], values[i]))
+	}
+	tree := airdrops.NewTree(leaves)
+
+	create := CreateAirdropData{
+		Coin:         types.GetBaseCoinID(),
+		Value:        helpers.BipToPip(big.NewInt(600)),
+		Root:         tree.Root(),
+		ExpireHeight: 100,
+	}
+	runTestTx(t, cState, TypeCreateAirdrop, create, 1, creatorKey, 100, code.WrongAirdropExpireHeight)
+	runTestTx(t, cState, TypeCreateAirdrop, create, 1, creatorKey, 1, code.OK)
+
+	airdrop := cState.Airdrops.GetAirdrop(1)
+	if airdrop == nil || airdrop.GetValue().Cmp(create.Value) != 0 {
+		t.Fatal("airdrop is not created")
+	}
+
+	claim := ClaimAirdropData{Airdrop: 1, Index: 0, Value: values[0], Proof: tree.Proof(0)}
+	runTestTx(t, cState, TypeClaimAirdrop, ClaimAirdropData{Airdrop: 1, Index: 0, Value: values[1], Proof: tree.Proof(0)}, 1, claimerKey, 2, code.InvalidAirdropProof)
+	runTestTx(t, cState, TypeClaimAirdrop, claim, 1, otherKey, 2, code.InvalidAirdropProof)
+	runTestTx(t, cState, TypeClaimAirdrop, claim, 1, claimerKey, 2, code.OK)
+	runTestTx(t, cState, TypeClaimAirdrop, claim, 2, claimerKey, 2, code.AirdropAlreadyClaimed)
+
+	// the commission is paid from the claimed value
+	if balance := cState.Accounts.GetBalance(claimer, types.GetBaseCoinID()); balance.Sign() != 1 || balance.Cmp(values[0]) != -1 {
+		t.Fatalf("Claimer balance is not correct: %s", balance)
+	}
+
+	runTestTx(t, cState, TypeReclaimAirdrop, ReclaimAirdropData{Airdrop: 1}, 2, creatorKey, 50, code.AirdropNotExpired)
+	runTestTx(t, cState, TypeClaimAirdrop, ClaimAirdropData{Airdrop: 1, Index: 1, Value: values[1], Proof: tree.Proof(1)}, 1, otherKey, 100, code.AirdropExpired)
+	runTestTx(t, cState, TypeReclaimAirdrop, ReclaimAirdropData{Airdrop: 1}, 1, otherKey, 100, code.IsNotCreatorOfAirdrop)
+
+	balance := cState.Accounts.GetBalance(creator, types.GetBaseCoinID())
+	runTestTx(t, cState, TypeReclaimAirdrop, ReclaimAirdropData{Airdrop: 1}, 2, creatorKey, 100, code.OK)
+	if diff := big.NewInt(0).Sub(cState.Accounts.GetBalance(creator, types.GetBaseCoinID()), balance); diff.Sign() != 1 || diff.Cmp(helpers.BipToPip(big.NewInt(500))) != -1 {
+		t.Fatalf("Creator did not reclaim the rest: %s", diff)
+	}
+	if value := cState.Airdrops.GetAirdrop(1).GetValue(); value.Sign() != 0 {
+		t.Fatalf("Airdrop value is not zero: %s", value)
+	}
+
+	if err := checkState(cState); err != nil {
+		t.Error(err)
+	}
+}
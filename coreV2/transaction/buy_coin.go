@@ -217,6 +217,8 @@ func (data BuyCoinData) Run(tx *Transaction, context state.Interface, rewardPool
 			deliverState.Coins.AddVolume(data.CoinToBuy, data.ValueToBuy)
 			deliverState.Coins.AddReserve(data.CoinToBuy, diffBipReserve)
 		}
+		addBancorTradeEvent(deliverState, sender, data.CoinToSell, true, value, diffBipReserve)
+		addBancorTradeEvent(deliverState, sender, data.CoinToBuy, false, data.ValueToBuy, diffBipReserve)
 		deliverState.Accounts.SetNonce(sender, tx.Nonce)
 
 		tags = []abcTypes.EventAttribute{
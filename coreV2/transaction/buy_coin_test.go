@@ -1624,6 +1624,20 @@ func getAccount() (*ecdsa.PrivateKey, types.Address) {
 	return privateKey, addr
 }
 
+// runTestTx runs the transaction in the block and fails the test if its response code is not the wanted one
+func runTestTx(t *testing.T, cState *state.State, txType TxType, data interface{}, nonce uint64, key *ecdsa.PrivateKey, block uint64, want uint32) {
+	t.Helper()
+
+	tx, err := makeTestSwapPoolTx(txType, data, nonce, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	response := NewExecutor(GetDataV250).RunTx(cState, tx, big.NewInt(0), block, &sync.Map{}, 0, false)
+	if response.Code != want {
+		t.Fatalf("Response code %d is not %d: %s", response.Code, want, response.Log)
+	}
+}
+
 func createTestCoinWithSymbol(stateDB *state.State, symbol types.CoinSymbol) (types.CoinID, *big.Int, *big.Int, uint32) {
 	volume := helpers.BipToPip(big.NewInt(100000))
 	reserve := helpers.BipToPip(big.NewInt(100000))
@@ -16,6 +16,8 @@ type BuySwapPoolData struct {
 	Coins              []types.CoinID
 	ValueToBuy         *big.Int
 	MaximumValueToSell *big.Int
+
+	fees []uint32
 }
 
 func reverseCoinIds(a []types.CoinID) {
@@ -54,8 +56,12 @@ func (data BuySwapPoolData) basicCheck(tx *Transaction, context *state.CheckStat
 			Info: EncodeError(code.NewCustomCode(code.TooLongSwapRoute)),
 		}
 	}
+	if response := checkSwapRouteFees(data.Coins, data.fees); response != nil {
+		return response
+	}
+	fees := swapRouteFees(data.fees, len(data.Coins)-1)
 	coin0 := data.Coins[0]
-	for _, coin1 := range data.Coins[1:] {
+	for i, coin1 := range data.Coins[1:] {
 		if coin0 == coin1 {
 			return &Response{
 				Code: code.CrossConvert,
@@ -65,7 +71,7 @@ func (data BuySwapPoolData) basicCheck(tx *Transaction, context *state.CheckStat
 					coin1.String(), "")),
 			}
 		}
-		if !context.Swap().SwapPoolExist(coin0, coin1) {
+		if !swapPoolExistWithFee(context, coin0, coin1, fees[i]) {
 			return &Response{
 				Code: code.PairNotExists,
 				Log:  fmt.Sprint("swap pool not exists"),
@@ -74,7 +80,9 @@ func (data BuySwapPoolData) basicCheck(tx *Transaction, context *state.CheckStat
 		}
 		coin0 = coin1
 	}
-	return nil
+
+	sender, _ := tx.Sender()
+	return checkSwapRouteControls(context, data.Coins, sender)
 }
 
 func (data BuySwapPoolData) String() string {
@@ -108,6 +116,8 @@ func (data BuySwapPoolData) Run(tx *Transaction, context state.Interface, reward
 	}
 
 	reverseCoinIds(data.Coins)
+	fees := swapRouteFees(data.fees, len(data.Coins)-1)
+	reverseFees(fees)
 
 	var calculatedAmountToSell *big.Int
 	lastIteration := len(data.Coins[1:]) - 1
@@ -118,7 +128,8 @@ func (data BuySwapPoolData) Run(tx *Transaction, context state.Interface, reward
 		valueToBuy := big.NewInt(0).Set(data.ValueToBuy)
 		valueToSell := maxCoinSupply
 		for i, coinToSell := range data.Coins[1:] {
-			swapper := checkState.Swap().GetSwapper(coinToSell, coinToBuy)
+			swapper := routeSwapper(checkState, coinToSell, coinToBuy, fees[i], valueToBuy, true)
+			fees[i] = swapper.Fee()
 			if _, ok := checkDuplicatePools[swapper.GetID()]; ok {
 				return Response{
 					Code: code.DuplicatePoolInRoute,
@@ -128,7 +139,7 @@ func (data BuySwapPoolData) Run(tx *Transaction, context state.Interface, reward
 			}
 			checkDuplicatePools[swapper.GetID()] = struct{}{}
 
-			if isGasCommissionFromPoolSwap {
+			if isGasCommissionFromPoolSwap && swapper.Fee() == swap.DefaultFee {
 				if tx.GasCoin == coinToSell && coinToBuy.IsBaseCoin() {
 					swapper = swapper.AddLastSwapStep(commission, commissionInBaseCoin)
 				}
@@ -202,7 +213,7 @@ func (data BuySwapPoolData) Run(tx *Transaction, context state.Interface, reward
 
 		for i, coinToSell := range data.Coins[1:] {
 
-			amountIn, amountOut, poolID := deliverState.Swap.PairBuy(coinToSell, coinToBuy, maxCoinSupply, valueToBuy)
+			amountIn, amountOut, poolID := deliverState.Swap.PairBuyWithFee(coinToSell, coinToBuy, fees[i], maxCoinSupply, valueToBuy)
 
 			poolIDs = append(poolIDs, &tagPoolChange{
 				PoolID:   poolID,
@@ -320,3 +331,41 @@ func CheckSwap(rSwap swap.EditableChecker, coinIn CalculateCoin, coinOut Calcula
 	}
 	return nil
 }
+
+// BuySwapPoolDataV250 is BuySwapPoolData with fee tiers of pools for each step of the route.
+// Zero fee selects the pool of the pair with the best price, pools of the default tier are used if Fees are empty.
+type BuySwapPoolDataV250 struct {
+	Coins              []types.CoinID
+	ValueToBuy         *big.Int
+	MaximumValueToSell *big.Int
+	Fees               []uint32 `rlp:"tail"`
+}
+
+func (data BuySwapPoolDataV250) data() BuySwapPoolData {
+	return BuySwapPoolData{
+		Coins:              data.Coins,
+		ValueToBuy:         data.ValueToBuy,
+		MaximumValueToSell: data.MaximumValueToSell,
+		fees:               data.Fees,
+	}
+}
+
+func (data BuySwapPoolDataV250) TxType() TxType {
+	return TypeBuySwapPool
+}
+
+func (data BuySwapPoolDataV250) Gas() int64 {
+	return data.data().Gas()
+}
+
+func (data BuySwapPoolDataV250) String() string {
+	return data.data().String()
+}
+
+func (data BuySwapPoolDataV250) CommissionData(price *commission.Price) *big.Int {
+	return data.data().CommissionData(price)
+}
+
+func (data BuySwapPoolDataV250) Run(tx *Transaction, context state.Interface, rewardPool *big.Int, currentBlock uint64, price *big.Int) Response {
+	return data.data().Run(tx, context, rewardPool, currentBlock, price)
+}
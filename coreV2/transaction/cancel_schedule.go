@@ -0,0 +1,124 @@
+package transaction
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"github.com/MinterTeam/minter-go-node/coreV2/code"
+	"github.com/MinterTeam/minter-go-node/coreV2/state"
+	"github.com/MinterTeam/minter-go-node/coreV2/state/commission"
+	"github.com/MinterTeam/minter-go-node/coreV2/types"
+	abcTypes "github.com/tendermint/tendermint/abci/types"
+)
+
+// CancelScheduleData returns the value of remaining sends of the schedule to its owner
+type CancelScheduleData struct {
+	Schedule uint32
+}
+
+func (data CancelScheduleData) Gas() int64 {
+	return gasCancelSchedule
+}
+func (data CancelScheduleData) TxType() TxType {
+	return TypeCancelSchedule
+}
+
+func (data CancelScheduleData) basicCheck(tx *Transaction, context *state.CheckState) *Response {
+	scheduleID := strconv.FormatUint(uint64(data.Schedule), 10)
+	schedule := context.Schedules().GetSchedule(data.Schedule)
+	if schedule == nil {
+		return &Response{
+			Code: code.ScheduleNotExists,
+			Log:  fmt.Sprintf("Schedule %s not exists", scheduleID),
+			Info: EncodeError(code.NewScheduleNotExists(scheduleID)),
+		}
+	}
+
+	sender, _ := tx.Sender()
+	if schedule.Owner != sender {
+		return &Response{
+			Code: code.IsNotOwnerOfSchedule,
+			Log:  "Sender is not owner of schedule",
+			Info: EncodeError(code.NewIsNotOwnerOfSchedule(scheduleID, schedule.Owner.String())),
+		}
+	}
+
+	return checkCoinRecipient(context, schedule.Coin, sender)
+}
+
+func (data CancelScheduleData) String() string {
+	return fmt.Sprintf("CANCEL SCHEDULE schedule:%d", data.Schedule)
+}
+
+func (data CancelScheduleData) CommissionData(price *commission.Price) *big.Int {
+	return price.Send
+}
+
+func (data CancelScheduleData) Run(tx *Transaction, context state.Interface, rewardPool *big.Int, currentBlock uint64, price *big.Int) Response {
+	sender, _ := tx.Sender()
+
+	var checkState *state.CheckState
+	var isCheck bool
+	if checkState, isCheck = context.(*state.CheckState); !isCheck {
+		checkState = state.NewCheckState(context.(*state.State))
+	}
+
+	response := data.basicCheck(tx, checkState)
+	if response != nil {
+		return *response
+	}
+
+	schedule := checkState.Schedules().GetSchedule(data.Schedule)
+
+	commissionInBaseCoin := tx.Commission(price)
+	commissionPoolSwapper := checkState.Swap().GetSwapper(tx.GasCoin, types.GetBaseCoinID())
+	gasCoin := checkState.Coins().GetCoin(tx.GasCoin)
+	commission, isGasCommissionFromPoolSwap, errResp := CalculateCommission(checkState, commissionPoolSwapper, gasCoin, commissionInBaseCoin)
+	if errResp != nil {
+		return *errResp
+	}
+
+	balance := checkState.Accounts().GetBalance(sender, tx.GasCoin)
+	if tx.GasCoin == schedule.Coin {
+		balance.Add(balance, schedule.GetRemaining())
+	}
+	if balance.Cmp(commission) < 0 {
+		return Response{
+			Code: code.InsufficientFunds,
+			Log:  fmt.Sprintf("Insufficient funds for sender account: %s. Wanted %s %s", sender.String(), commission.String(), gasCoin.GetFullSymbol()),
+			Info: EncodeError(code.NewInsufficientFunds(sender.String(), commission.String(), gasCoin.GetFullSymbol(), gasCoin.ID().String())),
+		}
+	}
+
+	var tags []abcTypes.EventAttribute
+	if deliverState, ok := context.(*state.State); ok {
+		value := deliverState.Schedules.Cancel(data.Schedule)
+		deliverState.Accounts.AddBalance(sender, schedule.Coin, value)
+
+		if isGasCommissionFromPoolSwap {
+			commission, commissionInBaseCoin, _ = deliverState.Swap.PairSell(tx.GasCoin, types.GetBaseCoinID(), commission, commissionInBaseCoin)
+		} else if !tx.GasCoin.IsBaseCoin() {
+			deliverState.Coins.SubVolume(tx.GasCoin, commission)
+			deliverState.Coins.SubReserve(tx.GasCoin, commissionInBaseCoin)
+		}
+		deliverState.Accounts.SubBalance(sender, tx.GasCoin, commission)
+		rewardPool.Add(rewardPool, commissionInBaseCoin)
+
+		deliverState.Accounts.SetNonce(sender, tx.Nonce)
+
+		tags = []abcTypes.EventAttribute{
+			{Key: []byte("tx.commission_in_base_coin"), Value: []byte(commissionInBaseCoin.String())},
+			{Key: []byte("tx.commission_conversion"), Value: []byte(isGasCommissionFromPoolSwap.String()), Index: true},
+			{Key: []byte("tx.commission_amount"), Value: []byte(commission.String())},
+			{Key: []byte("tx.coin_id"), Value: []byte(schedule.Coin.String()), Index: true},
+			{Key: []byte("tx.schedule_id"), Value: []byte(strconv.FormatUint(uint64(data.Schedule), 10)), Index: true},
+			{Key: []byte("tx.return"), Value: []byte(value.String())},
+		}
+	}
+
+	return Response{
+		Code: code.OK,
+		Tags: tags,
+	}
+}
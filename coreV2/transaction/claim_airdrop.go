@@ -0,0 +1,159 @@
+package transaction
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"github.com/MinterTeam/minter-go-node/coreV2/code"
+	"github.com/MinterTeam/minter-go-node/coreV2/state"
+	"github.com/MinterTeam/minter-go-node/coreV2/state/airdrops"
+	"github.com/MinterTeam/minter-go-node/coreV2/state/commission"
+	"github.com/MinterTeam/minter-go-node/coreV2/types"
+	abcTypes "github.com/tendermint/tendermint/abci/types"
+)
+
+// maxAirdropProofLength is the depth of the tree with a leaf for every index of the claim bitmap
+const maxAirdropProofLength = 32
+
+// ClaimAirdropData pays out the entry of the airdrop to the sender.
+// The commission can be paid from the claimed value if the gas coin is the coin of the airdrop.
+type ClaimAirdropData struct {
+	Airdrop uint32
+	Index   uint32
+	Value   *big.Int
+	Proof   []types.Hash
+}
+
+func (data ClaimAirdropData) Gas() int64 {
+	return gasClaimAirdrop
+}
+func (data ClaimAirdropData) TxType() TxType {
+	return TypeClaimAirdrop
+}
+
+func (data ClaimAirdropData) basicCheck(tx *Transaction, context *state.CheckState) *Response {
+	if data.Value == nil {
+		return &Response{
+			Code: code.DecodeError,
+			Log:  "Incorrect tx data",
+			Info: EncodeError(code.NewDecodeError()),
+		}
+	}
+
+	airdropID := strconv.FormatUint(uint64(data.Airdrop), 10)
+	airdrop := context.Airdrops().GetAirdrop(data.Airdrop)
+	if airdrop == nil {
+		return &Response{
+			Code: code.AirdropNotExists,
+			Log:  fmt.Sprintf("Airdrop %s not exists", airdropID),
+			Info: EncodeError(code.NewAirdropNotExists(airdropID)),
+		}
+	}
+
+	if context.Airdrops().IsClaimed(data.Airdrop, data.Index) {
+		return &Response{
+			Code: code.AirdropAlreadyClaimed,
+			Log:  fmt.Sprintf("Index %d of airdrop %s is already claimed", data.Index, airdropID),
+			Info: EncodeError(code.NewAirdropAlreadyClaimed(airdropID, strconv.FormatUint(uint64(data.Index), 10))),
+		}
+	}
+
+	sender, _ := tx.Sender()
+	if len(data.Proof) > maxAirdropProofLength ||
+		!airdrops.VerifyProof(airdrop.Root, airdrops.Leaf(data.Index, sender, data.Value), data.Proof) ||
+		airdrop.GetValue().Cmp(data.Value) < 0 {
+		return &Response{
+			Code: code.InvalidAirdropProof,
+			Log:  fmt.Sprintf("Invalid proof of index %d of airdrop %s", data.Index, airdropID),
+			Info: EncodeError(code.NewInvalidAirdropProof(airdropID, strconv.FormatUint(uint64(data.Index), 10), sender.String(), data.Value.String())),
+		}
+	}
+
+	return checkCoinRecipient(context, airdrop.Coin, sender)
+}
+
+func (data ClaimAirdropData) String() string {
+	return fmt.Sprintf("CLAIM AIRDROP airdrop:%d index:%d value:%s", data.Airdrop, data.Index, data.Value.String())
+}
+
+func (data ClaimAirdropData) CommissionData(price *commission.Price) *big.Int {
+	return price.Send
+}
+
+func (data ClaimAirdropData) Run(tx *Transaction, context state.Interface, rewardPool *big.Int, currentBlock uint64, price *big.Int) Response {
+	sender, _ := tx.Sender()
+
+	var checkState *state.CheckState
+	var isCheck bool
+	if checkState, isCheck = context.(*state.CheckState); !isCheck {
+		checkState = state.NewCheckState(context.(*state.State))
+	}
+
+	response := data.basicCheck(tx, checkState)
+	if response != nil {
+		return *response
+	}
+
+	airdrop := checkState.Airdrops().GetAirdrop(data.Airdrop)
+	if currentBlock >= airdrop.ExpireHeight {
+		airdropID := strconv.FormatUint(uint64(data.Airdrop), 10)
+		return Response{
+			Code: code.AirdropExpired,
+			Log:  fmt.Sprintf("Airdrop %s expired at block %d", airdropID, airdrop.ExpireHeight),
+			Info: EncodeError(code.NewAirdropExpired(airdropID, strconv.FormatUint(airdrop.ExpireHeight, 10), strconv.FormatUint(currentBlock, 10))),
+		}
+	}
+
+	commissionInBaseCoin := tx.Commission(price)
+	commissionPoolSwapper := checkState.Swap().GetSwapper(tx.GasCoin, types.GetBaseCoinID())
+	gasCoin := checkState.Coins().GetCoin(tx.GasCoin)
+	commission, isGasCommissionFromPoolSwap, errResp := CalculateCommission(checkState, commissionPoolSwapper, gasCoin, commissionInBaseCoin)
+	if errResp != nil {
+		return *errResp
+	}
+
+	balance := checkState.Accounts().GetBalance(sender, tx.GasCoin)
+	if tx.GasCoin == airdrop.Coin {
+		balance.Add(balance, data.Value)
+	}
+	if balance.Cmp(commission) < 0 {
+		return Response{
+			Code: code.InsufficientFunds,
+			Log:  fmt.Sprintf("Insufficient funds for sender account: %s. Wanted %s %s", sender.String(), commission.String(), gasCoin.GetFullSymbol()),
+			Info: EncodeError(code.NewInsufficientFunds(sender.String(), commission.String(), gasCoin.GetFullSymbol(), gasCoin.ID().String())),
+		}
+	}
+
+	var tags []abcTypes.EventAttribute
+	if deliverState, ok := context.(*state.State); ok {
+		deliverState.Airdrops.Claim(data.Airdrop, data.Index, data.Value)
+		deliverState.Accounts.AddBalance(sender, airdrop.Coin, data.Value)
+
+		if isGasCommissionFromPoolSwap {
+			commission, commissionInBaseCoin, _ = deliverState.Swap.PairSell(tx.GasCoin, types.GetBaseCoinID(), commission, commissionInBaseCoin)
+		} else if !tx.GasCoin.IsBaseCoin() {
+			deliverState.Coins.SubVolume(tx.GasCoin, commission)
+			deliverState.Coins.SubReserve(tx.GasCoin, commissionInBaseCoin)
+		}
+		deliverState.Accounts.SubBalance(sender, tx.GasCoin, commission)
+		rewardPool.Add(rewardPool, commissionInBaseCoin)
+
+		deliverState.Accounts.SetNonce(sender, tx.Nonce)
+
+		tags = []abcTypes.EventAttribute{
+			{Key: []byte("tx.commission_in_base_coin"), Value: []byte(commissionInBaseCoin.String())},
+			{Key: []byte("tx.commission_conversion"), Value: []byte(isGasCommissionFromPoolSwap.String()), Index: true},
+			{Key: []byte("tx.commission_amount"), Value: []byte(commission.String())},
+			{Key: []byte("tx.coin_id"), Value: []byte(airdrop.Coin.String()), Index: true},
+			{Key: []byte("tx.airdrop_id"), Value: []byte(strconv.FormatUint(uint64(data.Airdrop), 10)), Index: true},
+			{Key: []byte("tx.to"), Value: []byte(hex.EncodeToString(sender[:])), Index: true},
+		}
+	}
+
+	return Response{
+		Code: code.OK,
+		Tags: tags,
+	}
+}
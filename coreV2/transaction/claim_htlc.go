@@ -0,0 +1,147 @@
+package transaction
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"github.com/MinterTeam/minter-go-node/coreV2/code"
+	"github.com/MinterTeam/minter-go-node/coreV2/state"
+	"github.com/MinterTeam/minter-go-node/coreV2/state/commission"
+	"github.com/MinterTeam/minter-go-node/coreV2/state/htlcs"
+	"github.com/MinterTeam/minter-go-node/coreV2/types"
+	abcTypes "github.com/tendermint/tendermint/abci/types"
+)
+
+// ClaimHTLCData pays out the value of the contract to its recipient, who reveals the preimage of the hash lock.
+// The preimage is added to tags, so the sender can use it to claim the counterpart on the other chain.
+// The commission can be paid from the claimed value if the gas coin is the coin of the contract.
+type ClaimHTLCData struct {
+	HTLC     uint32
+	Preimage []byte
+}
+
+func (data ClaimHTLCData) Gas() int64 {
+	return gasClaimHTLC
+}
+func (data ClaimHTLCData) TxType() TxType {
+	return TypeClaimHTLC
+}
+
+func (data ClaimHTLCData) basicCheck(tx *Transaction, context *state.CheckState) *Response {
+	htlcID := strconv.FormatUint(uint64(data.HTLC), 10)
+	htlc := context.HTLCs().GetHTLC(data.HTLC)
+	if htlc == nil {
+		return &Response{
+			Code: code.HTLCNotExists,
+			Log:  fmt.Sprintf("HTLC %s not exists", htlcID),
+			Info: EncodeError(code.NewHTLCNotExists(htlcID)),
+		}
+	}
+
+	sender, _ := tx.Sender()
+	if htlc.Recipient != sender {
+		return &Response{
+			Code: code.IsNotRecipientOfHTLC,
+			Log:  "Sender is not recipient of HTLC",
+			Info: EncodeError(code.NewIsNotRecipientOfHTLC(htlcID, htlc.Recipient.String())),
+		}
+	}
+
+	if len(data.Preimage) != htlcs.PreimageLength || htlcs.HashLock(data.Preimage) != htlc.HashLock {
+		return &Response{
+			Code: code.WrongHTLCPreimage,
+			Log:  fmt.Sprintf("Wrong preimage of HTLC %s", htlcID),
+			Info: EncodeError(code.NewWrongHTLCPreimage(htlcID, hex.EncodeToString(htlc.HashLock[:]))),
+		}
+	}
+
+	return checkCoinRecipient(context, htlc.Coin, sender)
+}
+
+func (data ClaimHTLCData) String() string {
+	return fmt.Sprintf("CLAIM HTLC htlc:%d", data.HTLC)
+}
+
+func (data ClaimHTLCData) CommissionData(price *commission.Price) *big.Int {
+	return price.Send
+}
+
+func (data ClaimHTLCData) Run(tx *Transaction, context state.Interface, rewardPool *big.Int, currentBlock uint64, price *big.Int) Response {
+	sender, _ := tx.Sender()
+
+	var checkState *state.CheckState
+	var isCheck bool
+	if checkState, isCheck = context.(*state.CheckState); !isCheck {
+		checkState = state.NewCheckState(context.(*state.State))
+	}
+
+	response := data.basicCheck(tx, checkState)
+	if response != nil {
+		return *response
+	}
+
+	htlc := checkState.HTLCs().GetHTLC(data.HTLC)
+	if currentBlock >= htlc.Timeout {
+		htlcID := strconv.FormatUint(uint64(data.HTLC), 10)
+		return Response{
+			Code: code.HTLCExpired,
+			Log:  fmt.Sprintf("HTLC %s expired at block %d", htlcID, htlc.Timeout),
+			Info: EncodeError(code.NewHTLCExpired(htlcID, strconv.FormatUint(htlc.Timeout, 10), strconv.FormatUint(currentBlock, 10))),
+		}
+	}
+
+	commissionInBaseCoin := tx.Commission(price)
+	commissionPoolSwapper := checkState.Swap().GetSwapper(tx.GasCoin, types.GetBaseCoinID())
+	gasCoin := checkState.Coins().GetCoin(tx.GasCoin)
+	commission, isGasCommissionFromPoolSwap, errResp := CalculateCommission(checkState, commissionPoolSwapper, gasCoin, commissionInBaseCoin)
+	if errResp != nil {
+		return *errResp
+	}
+
+	balance := checkState.Accounts().GetBalance(sender, tx.GasCoin)
+	if tx.GasCoin == htlc.Coin {
+		balance.Add(balance, htlc.GetValue())
+	}
+	if balance.Cmp(commission) < 0 {
+		return Response{
+			Code: code.InsufficientFunds,
+			Log:  fmt.Sprintf("Insufficient funds for sender account: %s. Wanted %s %s", sender.String(), commission.String(), gasCoin.GetFullSymbol()),
+			Info: EncodeError(code.NewInsufficientFunds(sender.String(), commission.String(), gasCoin.GetFullSymbol(), gasCoin.ID().String())),
+		}
+	}
+
+	var tags []abcTypes.EventAttribute
+	if deliverState, ok := context.(*state.State); ok {
+		value := deliverState.HTLCs.Close(data.HTLC)
+		deliverState.Accounts.AddBalance(sender, htlc.Coin, value)
+
+		if isGasCommissionFromPoolSwap {
+			commission, commissionInBaseCoin, _ = deliverState.Swap.PairSell(tx.GasCoin, types.GetBaseCoinID(), commission, commissionInBaseCoin)
+		} else if !tx.GasCoin.IsBaseCoin() {
+			deliverState.Coins.SubVolume(tx.GasCoin, commission)
+			deliverState.Coins.SubReserve(tx.GasCoin, commissionInBaseCoin)
+		}
+		deliverState.Accounts.SubBalance(sender, tx.GasCoin, commission)
+		rewardPool.Add(rewardPool, commissionInBaseCoin)
+
+		deliverState.Accounts.SetNonce(sender, tx.Nonce)
+
+		tags = []abcTypes.EventAttribute{
+			{Key: []byte("tx.commission_in_base_coin"), Value: []byte(commissionInBaseCoin.String())},
+			{Key: []byte("tx.commission_conversion"), Value: []byte(isGasCommissionFromPoolSwap.String()), Index: true},
+			{Key: []byte("tx.commission_amount"), Value: []byte(commission.String())},
+			{Key: []byte("tx.coin_id"), Value: []byte(htlc.Coin.String()), Index: true},
+			{Key: []byte("tx.htlc_id"), Value: []byte(strconv.FormatUint(uint64(data.HTLC), 10)), Index: true},
+			{Key: []byte("tx.hash_lock"), Value: []byte(hex.EncodeToString(htlc.HashLock[:])), Index: true},
+			{Key: []byte("tx.preimage"), Value: []byte(hex.EncodeToString(data.Preimage))},
+			{Key: []byte("tx.return"), Value: []byte(value.String())},
+		}
+	}
+
+	return Response{
+		Code: code.OK,
+		Tags: tags,
+	}
+}
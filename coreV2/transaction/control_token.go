@@ -0,0 +1,177 @@
+package transaction
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"github.com/MinterTeam/minter-go-node/coreV2/code"
+	"github.com/MinterTeam/minter-go-node/coreV2/state"
+	"github.com/MinterTeam/minter-go-node/coreV2/state/coins"
+	"github.com/MinterTeam/minter-go-node/coreV2/state/commission"
+	"github.com/MinterTeam/minter-go-node/coreV2/types"
+	abcTypes "github.com/tendermint/tendermint/abci/types"
+)
+
+// ControlTokenData sets whether the address is frozen and blocklisted for the controlled token.
+// Frozen address can't spend its balance of the token, blocklisted address can't receive it.
+type ControlTokenData struct {
+	Coin        types.CoinID
+	Address     types.Address
+	Frozen      bool
+	Blocklisted bool
+}
+
+func (data ControlTokenData) Gas() int64 {
+	return gasControlToken
+}
+func (data ControlTokenData) TxType() TxType {
+	return TypeControlToken
+}
+
+func (data ControlTokenData) basicCheck(tx *Transaction, context *state.CheckState) *Response {
+	coin := context.Coins().GetCoin(data.Coin)
+	if coin == nil {
+		return &Response{
+			Code: code.CoinNotExists,
+			Log:  "Coin not exists",
+			Info: EncodeError(code.NewCoinNotExists("", data.Coin.String())),
+		}
+	}
+
+	if !context.Controls().IsControlled(data.Coin) {
+		return &Response{
+			Code: code.CoinNotControlled,
+			Log:  "Coin not controlled",
+			Info: EncodeError(code.NewCoinIsNotControlled(coin.GetFullSymbol(), data.Coin.String())),
+		}
+	}
+
+	sender, _ := tx.Sender()
+	return checkCoinOwner(context, coin, sender)
+}
+
+func (data ControlTokenData) String() string {
+	return fmt.Sprintf("CONTROL TOKEN: %d address:%s frozen:%s blocklisted:%s",
+		data.Coin, data.Address.String(), strconv.FormatBool(data.Frozen), strconv.FormatBool(data.Blocklisted))
+}
+
+func (data ControlTokenData) CommissionData(price *commission.Price) *big.Int {
+	return price.EditTickerOwner
+}
+
+func (data ControlTokenData) Run(tx *Transaction, context state.Interface, rewardPool *big.Int, currentBlock uint64, price *big.Int) Response {
+	sender, _ := tx.Sender()
+
+	var checkState *state.CheckState
+	var isCheck bool
+	if checkState, isCheck = context.(*state.CheckState); !isCheck {
+		checkState = state.NewCheckState(context.(*state.State))
+	}
+
+	response := data.basicCheck(tx, checkState)
+	if response != nil {
+		return *response
+	}
+
+	commissionInBaseCoin := tx.Commission(price)
+	commissionPoolSwapper := checkState.Swap().GetSwapper(tx.GasCoin, types.GetBaseCoinID())
+	gasCoin := checkState.Coins().GetCoin(tx.GasCoin)
+	commission, isGasCommissionFromPoolSwap, errResp := CalculateCommission(checkState, commissionPoolSwapper, gasCoin, commissionInBaseCoin)
+	if errResp != nil {
+		return *errResp
+	}
+
+	if checkState.Accounts().GetBalance(sender, tx.GasCoin).Cmp(commission) == -1 {
+		return Response{
+			Code: code.InsufficientFunds,
+			Log:  fmt.Sprintf("Insufficient funds for sender account: %s. Wanted %s %s", sender.String(), commission.String(), gasCoin.GetFullSymbol()),
+			Info: EncodeError(code.NewInsufficientFunds(sender.String(), commission.String(), gasCoin.GetFullSymbol(), gasCoin.ID().String())),
+		}
+	}
+
+	var tags []abcTypes.EventAttribute
+	if deliverState, ok := context.(*state.State); ok {
+		if isGasCommissionFromPoolSwap {
+			commission, commissionInBaseCoin, _ = deliverState.Swap.PairSell(tx.GasCoin, types.GetBaseCoinID(), commission, commissionInBaseCoin)
+		} else if !tx.GasCoin.IsBaseCoin() {
+			deliverState.Coins.SubVolume(tx.GasCoin, commission)
+			deliverState.Coins.SubReserve(tx.GasCoin, commissionInBaseCoin)
+		}
+		deliverState.Accounts.SubBalance(sender, tx.GasCoin, commission)
+		rewardPool.Add(rewardPool, commissionInBaseCoin)
+
+		deliverState.Controls.SetRestriction(data.Coin, data.Address, data.Frozen, data.Blocklisted)
+
+		deliverState.Accounts.SetNonce(sender, tx.Nonce)
+
+		tags = []abcTypes.EventAttribute{
+			{Key: []byte("tx.commission_in_base_coin"), Value: []byte(commissionInBaseCoin.String())},
+			{Key: []byte("tx.commission_conversion"), Value: []byte(isGasCommissionFromPoolSwap.String()), Index: true},
+			{Key: []byte("tx.commission_amount"), Value: []byte(commission.String())},
+			{Key: []byte("tx.coin_id"), Value: []byte(data.Coin.String()), Index: true},
+			{Key: []byte("tx.address"), Value: []byte(hex.EncodeToString(data.Address[:])), Index: true},
+		}
+	}
+
+	return Response{
+		Code: code.OK,
+		Tags: tags,
+	}
+}
+
+// checkCoinOwner checks that the sender owns the ticker of the coin and the coin is its current version
+func checkCoinOwner(context *state.CheckState, coin *coins.Model, sender types.Address) *Response {
+	symbolInfo := context.Coins().GetSymbolInfo(coin.Symbol())
+	if coin.Version() != 0 || symbolInfo == nil || symbolInfo.OwnerAddress().Compare(sender) != 0 {
+		var owner *string
+		if symbolInfo != nil && symbolInfo.OwnerAddress() != nil {
+			own := symbolInfo.OwnerAddress().String()
+			owner = &own
+		}
+		return &Response{
+			Code: code.IsNotOwnerOfCoin,
+			Log:  "Sender is not owner of coin",
+			Info: EncodeError(code.NewIsNotOwnerOfCoin(coin.Symbol().String(), owner)),
+		}
+	}
+
+	return nil
+}
+
+// checkCoinSpender checks that the address is not frozen by the owner of the controlled coin
+func checkCoinSpender(context *state.CheckState, coin types.CoinID, address types.Address) *Response {
+	if !context.Controls().IsControlled(coin) || !context.Controls().IsFrozen(coin, address) {
+		return nil
+	}
+
+	symbol := context.Coins().GetCoin(coin).GetFullSymbol()
+	return &Response{
+		Code: code.AddressFrozen,
+		Log:  fmt.Sprintf("Address %s is frozen for coin %s", address.String(), symbol),
+		Info: EncodeError(code.NewAddressFrozen(address.String(), symbol, coin.String())),
+	}
+}
+
+// checkCoinRecipient checks that the address is not blocklisted by the owner of the controlled coin
+func checkCoinRecipient(context *state.CheckState, coin types.CoinID, address types.Address) *Response {
+	if !context.Controls().IsControlled(coin) || !context.Controls().IsBlocklisted(coin, address) {
+		return nil
+	}
+
+	symbol := context.Coins().GetCoin(coin).GetFullSymbol()
+	return &Response{
+		Code: code.AddressBlocklisted,
+		Log:  fmt.Sprintf("Address %s is blocklisted for coin %s", address.String(), symbol),
+		Info: EncodeError(code.NewAddressBlocklisted(address.String(), symbol, coin.String())),
+	}
+}
+
+// checkSwapRouteControls checks that the sender can spend the first coin of the route and receive the last one
+func checkSwapRouteControls(context *state.CheckState, coins []types.CoinID, sender types.Address) *Response {
+	if errResp := checkCoinSpender(context, coins[0], sender); errResp != nil {
+		return errResp
+	}
+	return checkCoinRecipient(context, coins[len(coins)-1], sender)
+}
@@ -0,0 +1,63 @@
+package transaction
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/MinterTeam/minter-go-node/coreV2/code"
+	"github.com/MinterTeam/minter-go-node/coreV2/types"
+	"github.com/MinterTeam/minter-go-node/helpers"
+)
+
+func TestControlTokenTx(t *testing.T) {
+	t.Parallel()
+	cState := getState()
+
+	ownerKey, owner := getAccount()
+	holderKey, holder := getAccount()
+	recipient := types.Address{1}
+
+	cState.Accounts.AddBalance(owner, types.GetBaseCoinID(), helpers.BipToPip(big.NewInt(1000000)))
+	cState.Accounts.AddBalance(holder, types.GetBaseCoinID(), helpers.BipToPip(big.NewInt(1000000)))
+
+	runTestTx(t, cState, TypeCreateToken, CreateTokenDataV250{
+		Name:          "Controlled Token",
+		Symbol:        types.StrToCoinSymbol("CONTROL"),
+		InitialAmount: helpers.BipToPip(big.NewInt(1000)),
+		MaxSupply:     helpers.BipToPip(big.NewInt(1000)),
+		Controlled:    []bool{true},
+	}, 1, ownerKey, 0, code.OK)
+	coin := cState.Coins.GetCoinBySymbol(types.StrToCoinSymbol("CONTROL"), 0).ID()
+	if !cState.Controls.IsControlled(coin) {
+		t.Fatal("coin is not controlled")
+	}
+
+	send := func(to types.Address) SendData {
+		return SendData{Coin: coin, To: to, Value: helpers.BipToPip(big.NewInt(10))}
+	}
+	runTestTx(t, cState, TypeSend, send(holder), 2, ownerKey, 0, code.OK)
+
+	freeze := ControlTokenData{Coin: coin, Address: holder, Frozen: true}
+	runTestTx(t, cState, TypeControlToken, freeze, 1, holderKey, 0, code.IsNotOwnerOfCoin)
+	runTestTx(t, cState, TypeControlToken, freeze, 3, ownerKey, 0, code.OK)
+	runTestTx(t, cState, TypeSend, send(recipient), 1, holderKey, 0, code.AddressFrozen)
+	runTestTx(t, cState, TypeMultisend, MultisendData{List: []MultisendDataItem{{Coin: coin, To: recipient, Value: big.NewInt(1)}}}, 1, holderKey, 0, code.AddressFrozen)
+
+	runTestTx(t, cState, TypeControlToken, ControlTokenData{Coin: coin, Address: recipient, Blocklisted: true}, 4, ownerKey, 0, code.OK)
+	runTestTx(t, cState, TypeSend, send(recipient), 5, ownerKey, 0, code.AddressBlocklisted)
+	runTestTx(t, cState, TypeTransferFrom, TransferFromData{Owner: owner, To: recipient, Coin: coin, Value: big.NewInt(0)}, 1, holderKey, 0, code.AddressBlocklisted)
+
+	runTestTx(t, cState, TypeControlToken, ControlTokenData{Coin: coin, Address: holder}, 5, ownerKey, 0, code.OK)
+	runTestTx(t, cState, TypeSend, send(owner), 1, holderKey, 0, code.OK)
+
+	runTestTx(t, cState, TypeControlToken, ControlTokenData{Coin: types.GetBaseCoinID(), Address: holder, Frozen: true}, 6, ownerKey, 0, code.CoinNotControlled)
+
+	if err := checkState(cState); err != nil {
+		t.Error(err)
+	}
+
+	appState := cState.Export()
+	if len(appState.ControlledCoins) != 1 || len(appState.ControlledCoins[0].Frozen) != 0 || len(appState.ControlledCoins[0].Blocklist) != 1 {
+		t.Fatalf("wrong exported controlled coins %+v", appState.ControlledCoins)
+	}
+}
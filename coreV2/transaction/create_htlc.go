@@ -0,0 +1,146 @@
+package transaction
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"github.com/MinterTeam/minter-go-node/coreV2/code"
+	"github.com/MinterTeam/minter-go-node/coreV2/state"
+	"github.com/MinterTeam/minter-go-node/coreV2/state/commission"
+	"github.com/MinterTeam/minter-go-node/coreV2/types"
+	abcTypes "github.com/tendermint/tendermint/abci/types"
+)
+
+// CreateHTLCData locks the value of the coin for the recipient until the timeout height.
+// The recipient can claim it with the preimage of the hash lock before the timeout, the sender can refund it after.
+type CreateHTLCData struct {
+	Recipient types.Address
+	Coin      types.CoinID
+	Value     *big.Int
+	HashLock  types.Hash
+	Timeout   uint64
+}
+
+func (data CreateHTLCData) Gas() int64 {
+	return gasCreateHTLC
+}
+func (data CreateHTLCData) TxType() TxType {
+	return TypeCreateHTLC
+}
+
+func (data CreateHTLCData) basicCheck(tx *Transaction, context *state.CheckState) *Response {
+	if data.Value == nil || data.Value.Sign() != 1 {
+		return &Response{
+			Code: code.DecodeError,
+			Log:  "Incorrect tx data",
+			Info: EncodeError(code.NewDecodeError()),
+		}
+	}
+
+	if !context.Coins().Exists(data.Coin) {
+		return &Response{
+			Code: code.CoinNotExists,
+			Log:  fmt.Sprintf("Coin %s not exists", data.Coin),
+			Info: EncodeError(code.NewCoinNotExists("", data.Coin.String())),
+		}
+	}
+
+	sender, _ := tx.Sender()
+	if errResp := checkCoinSpender(context, data.Coin, sender); errResp != nil {
+		return errResp
+	}
+	return checkCoinRecipient(context, data.Coin, data.Recipient)
+}
+
+func (data CreateHTLCData) String() string {
+	return fmt.Sprintf("CREATE HTLC to:%s coin:%s value:%s hash lock:%s timeout:%d",
+		data.Recipient.String(), data.Coin.String(), data.Value.String(), data.HashLock.String(), data.Timeout)
+}
+
+func (data CreateHTLCData) CommissionData(price *commission.Price) *big.Int {
+	return price.Send
+}
+
+func (data CreateHTLCData) Run(tx *Transaction, context state.Interface, rewardPool *big.Int, currentBlock uint64, price *big.Int) Response {
+	sender, _ := tx.Sender()
+
+	var checkState *state.CheckState
+	var isCheck bool
+	if checkState, isCheck = context.(*state.CheckState); !isCheck {
+		checkState = state.NewCheckState(context.(*state.State))
+	}
+
+	response := data.basicCheck(tx, checkState)
+	if response != nil {
+		return *response
+	}
+
+	if data.Timeout <= currentBlock {
+		return Response{
+			Code: code.WrongHTLCTimeout,
+			Log:  fmt.Sprintf("Timeout should be greater than current block %d", currentBlock),
+			Info: EncodeError(code.NewWrongHTLCTimeout(strconv.FormatUint(data.Timeout, 10), strconv.FormatUint(currentBlock, 10))),
+		}
+	}
+
+	commissionInBaseCoin := tx.Commission(price)
+	commissionPoolSwapper := checkState.Swap().GetSwapper(tx.GasCoin, types.GetBaseCoinID())
+	gasCoin := checkState.Coins().GetCoin(tx.GasCoin)
+	commission, isGasCommissionFromPoolSwap, errResp := CalculateCommission(checkState, commissionPoolSwapper, gasCoin, commissionInBaseCoin)
+	if errResp != nil {
+		return *errResp
+	}
+
+	needValue := big.NewInt(0).Set(commission)
+	if tx.GasCoin == data.Coin {
+		needValue.Add(data.Value, needValue)
+	} else if checkState.Accounts().GetBalance(sender, data.Coin).Cmp(data.Value) < 0 {
+		coin := checkState.Coins().GetCoin(data.Coin)
+		return Response{
+			Code: code.InsufficientFunds,
+			Log:  fmt.Sprintf("Insufficient funds for sender account: %s. Wanted %s %s", sender.String(), data.Value.String(), coin.GetFullSymbol()),
+			Info: EncodeError(code.NewInsufficientFunds(sender.String(), data.Value.String(), coin.GetFullSymbol(), coin.ID().String())),
+		}
+	}
+	if checkState.Accounts().GetBalance(sender, tx.GasCoin).Cmp(needValue) < 0 {
+		return Response{
+			Code: code.InsufficientFunds,
+			Log:  fmt.Sprintf("Insufficient funds for sender account: %s. Wanted %s %s", sender.String(), needValue.String(), gasCoin.GetFullSymbol()),
+			Info: EncodeError(code.NewInsufficientFunds(sender.String(), needValue.String(), gasCoin.GetFullSymbol(), gasCoin.ID().String())),
+		}
+	}
+
+	var tags []abcTypes.EventAttribute
+	if deliverState, ok := context.(*state.State); ok {
+		if isGasCommissionFromPoolSwap {
+			commission, commissionInBaseCoin, _ = deliverState.Swap.PairSell(tx.GasCoin, types.GetBaseCoinID(), commission, commissionInBaseCoin)
+		} else if !tx.GasCoin.IsBaseCoin() {
+			deliverState.Coins.SubVolume(tx.GasCoin, commission)
+			deliverState.Coins.SubReserve(tx.GasCoin, commissionInBaseCoin)
+		}
+		deliverState.Accounts.SubBalance(sender, tx.GasCoin, commission)
+		rewardPool.Add(rewardPool, commissionInBaseCoin)
+
+		deliverState.Accounts.SubBalance(sender, data.Coin, data.Value)
+		id := deliverState.HTLCs.CreateHTLC(sender, data.Recipient, data.Coin, data.Value, data.HashLock, data.Timeout)
+
+		deliverState.Accounts.SetNonce(sender, tx.Nonce)
+
+		tags = []abcTypes.EventAttribute{
+			{Key: []byte("tx.commission_in_base_coin"), Value: []byte(commissionInBaseCoin.String())},
+			{Key: []byte("tx.commission_conversion"), Value: []byte(isGasCommissionFromPoolSwap.String()), Index: true},
+			{Key: []byte("tx.commission_amount"), Value: []byte(commission.String())},
+			{Key: []byte("tx.to"), Value: []byte(hex.EncodeToString(data.Recipient[:])), Index: true},
+			{Key: []byte("tx.coin_id"), Value: []byte(data.Coin.String()), Index: true},
+			{Key: []byte("tx.htlc_id"), Value: []byte(strconv.FormatUint(uint64(id), 10)), Index: true},
+			{Key: []byte("tx.hash_lock"), Value: []byte(hex.EncodeToString(data.HashLock[:])), Index: true},
+		}
+	}
+
+	return Response{
+		Code: code.OK,
+		Tags: tags,
+	}
+}
@@ -0,0 +1,166 @@
+package transaction
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+
+	"github.com/MinterTeam/minter-go-node/coreV2/code"
+	"github.com/MinterTeam/minter-go-node/coreV2/state"
+	"github.com/MinterTeam/minter-go-node/coreV2/state/commission"
+	"github.com/MinterTeam/minter-go-node/coreV2/types"
+	abcTypes "github.com/tendermint/tendermint/abci/types"
+)
+
+const maxScheduleCount = 1000
+
+// CreateScheduleData locks the value of Count sends of the coin to the recipient.
+// Sends are executed at the beginning of blocks from StartHeight every Interval blocks, the rest can be cancelled by the sender.
+type CreateScheduleData struct {
+	Recipient   types.Address
+	Coin        types.CoinID
+	Value       *big.Int
+	StartHeight uint64
+	Interval    uint64
+	Count       uint32
+}
+
+func (data CreateScheduleData) Gas() int64 {
+	return gasCreateSchedule
+}
+func (data CreateScheduleData) TxType() TxType {
+	return TypeCreateSchedule
+}
+
+func (data CreateScheduleData) basicCheck(tx *Transaction, context *state.CheckState) *Response {
+	if data.Value == nil || data.Value.Sign() != 1 {
+		return &Response{
+			Code: code.DecodeError,
+			Log:  "Incorrect tx data",
+			Info: EncodeError(code.NewDecodeError()),
+		}
+	}
+
+	if data.Count == 0 || data.Count > maxScheduleCount {
+		return &Response{
+			Code: code.WrongScheduleCount,
+			Log:  fmt.Sprintf("Count of sends should be from 1 to %d", maxScheduleCount),
+			Info: EncodeError(code.NewWrongScheduleCount(strconv.Itoa(int(data.Count)), strconv.Itoa(maxScheduleCount))),
+		}
+	}
+
+	if data.Count > 1 && (data.Interval == 0 || data.Interval > (math.MaxUint64-data.StartHeight)/uint64(data.Count-1)) {
+		return &Response{
+			Code: code.WrongScheduleInterval,
+			Log:  "Wrong interval between sends",
+			Info: EncodeError(code.NewWrongScheduleInterval(strconv.FormatUint(data.Interval, 10), strconv.Itoa(int(data.Count)))),
+		}
+	}
+
+	if !context.Coins().Exists(data.Coin) {
+		return &Response{
+			Code: code.CoinNotExists,
+			Log:  fmt.Sprintf("Coin %s not exists", data.Coin),
+			Info: EncodeError(code.NewCoinNotExists("", data.Coin.String())),
+		}
+	}
+
+	sender, _ := tx.Sender()
+	if errResp := checkCoinSpender(context, data.Coin, sender); errResp != nil {
+		return errResp
+	}
+	return checkCoinRecipient(context, data.Coin, data.Recipient)
+}
+
+func (data CreateScheduleData) String() string {
+	return fmt.Sprintf("CREATE SCHEDULE to:%s coin:%s value:%s start:%d interval:%d count:%d",
+		data.Recipient.String(), data.Coin.String(), data.Value.String(), data.StartHeight, data.Interval, data.Count)
+}
+
+func (data CreateScheduleData) CommissionData(price *commission.Price) *big.Int {
+	return new(big.Int).Mul(price.Send, big.NewInt(int64(data.Count)))
+}
+
+func (data CreateScheduleData) Run(tx *Transaction, context state.Interface, rewardPool *big.Int, currentBlock uint64, price *big.Int) Response {
+	sender, _ := tx.Sender()
+
+	var checkState *state.CheckState
+	var isCheck bool
+	if checkState, isCheck = context.(*state.CheckState); !isCheck {
+		checkState = state.NewCheckState(context.(*state.State))
+	}
+
+	response := data.basicCheck(tx, checkState)
+	if response != nil {
+		return *response
+	}
+
+	if data.StartHeight <= currentBlock {
+		return Response{
+			Code: code.WrongScheduleStartHeight,
+			Log:  fmt.Sprintf("Start height should be greater than current block %d", currentBlock),
+			Info: EncodeError(code.NewWrongScheduleStartHeight(strconv.FormatUint(data.StartHeight, 10), strconv.FormatUint(currentBlock, 10))),
+		}
+	}
+
+	commissionInBaseCoin := tx.Commission(price)
+	commissionPoolSwapper := checkState.Swap().GetSwapper(tx.GasCoin, types.GetBaseCoinID())
+	gasCoin := checkState.Coins().GetCoin(tx.GasCoin)
+	commission, isGasCommissionFromPoolSwap, errResp := CalculateCommission(checkState, commissionPoolSwapper, gasCoin, commissionInBaseCoin)
+	if errResp != nil {
+		return *errResp
+	}
+
+	value := new(big.Int).Mul(data.Value, big.NewInt(int64(data.Count)))
+	needValue := big.NewInt(0).Set(commission)
+	if tx.GasCoin == data.Coin {
+		needValue.Add(value, needValue)
+	} else if checkState.Accounts().GetBalance(sender, data.Coin).Cmp(value) < 0 {
+		coin := checkState.Coins().GetCoin(data.Coin)
+		return Response{
+			Code: code.InsufficientFunds,
+			Log:  fmt.Sprintf("Insufficient funds for sender account: %s. Wanted %s %s", sender.String(), value.String(), coin.GetFullSymbol()),
+			Info: EncodeError(code.NewInsufficientFunds(sender.String(), value.String(), coin.GetFullSymbol(), coin.ID().String())),
+		}
+	}
+	if checkState.Accounts().GetBalance(sender, tx.GasCoin).Cmp(needValue) < 0 {
+		return Response{
+			Code: code.InsufficientFunds,
+			Log:  fmt.Sprintf("Insufficient funds for sender account: %s. Wanted %s %s", sender.String(), needValue.String(), gasCoin.GetFullSymbol()),
+			Info: EncodeError(code.NewInsufficientFunds(sender.String(), needValue.String(), gasCoin.GetFullSymbol(), gasCoin.ID().String())),
+		}
+	}
+
+	var tags []abcTypes.EventAttribute
+	if deliverState, ok := context.(*state.State); ok {
+		if isGasCommissionFromPoolSwap {
+			commission, commissionInBaseCoin, _ = deliverState.Swap.PairSell(tx.GasCoin, types.GetBaseCoinID(), commission, commissionInBaseCoin)
+		} else if !tx.GasCoin.IsBaseCoin() {
+			deliverState.Coins.SubVolume(tx.GasCoin, commission)
+			deliverState.Coins.SubReserve(tx.GasCoin, commissionInBaseCoin)
+		}
+		deliverState.Accounts.SubBalance(sender, tx.GasCoin, commission)
+		rewardPool.Add(rewardPool, commissionInBaseCoin)
+
+		deliverState.Accounts.SubBalance(sender, data.Coin, value)
+		id := deliverState.Schedules.CreateSchedule(sender, data.Recipient, data.Coin, data.Value, data.StartHeight, data.Interval, data.Count)
+
+		deliverState.Accounts.SetNonce(sender, tx.Nonce)
+
+		tags = []abcTypes.EventAttribute{
+			{Key: []byte("tx.commission_in_base_coin"), Value: []byte(commissionInBaseCoin.String())},
+			{Key: []byte("tx.commission_conversion"), Value: []byte(isGasCommissionFromPoolSwap.String()), Index: true},
+			{Key: []byte("tx.commission_amount"), Value: []byte(commission.String())},
+			{Key: []byte("tx.to"), Value: []byte(hex.EncodeToString(data.Recipient[:])), Index: true},
+			{Key: []byte("tx.coin_id"), Value: []byte(data.Coin.String()), Index: true},
+			{Key: []byte("tx.schedule_id"), Value: []byte(strconv.FormatUint(uint64(id), 10)), Index: true},
+		}
+	}
+
+	return Response{
+		Code: code.OK,
+		Tags: tags,
+	}
+}
@@ -3,6 +3,7 @@ package transaction
 import (
 	"fmt"
 	"github.com/MinterTeam/minter-go-node/coreV2/code"
+	eventsdb "github.com/MinterTeam/minter-go-node/coreV2/events"
 	"github.com/MinterTeam/minter-go-node/coreV2/state"
 	"github.com/MinterTeam/minter-go-node/coreV2/state/commission"
 	"github.com/MinterTeam/minter-go-node/coreV2/state/swap"
@@ -16,6 +17,8 @@ type CreateSwapPoolData struct {
 	Coin1   types.CoinID
 	Volume0 *big.Int
 	Volume1 *big.Int
+
+	fee uint32
 }
 
 func (data CreateSwapPoolData) Gas() int64 {
@@ -36,7 +39,13 @@ func (data CreateSwapPoolData) basicCheck(tx *Transaction, context *state.CheckS
 		}
 	}
 
-	if context.Swap().SwapPoolExist(data.Coin0, data.Coin1) {
+	if data.fee != 0 {
+		if response := checkSwapPoolFee(data.fee); response != nil {
+			return response
+		}
+	}
+
+	if context.Swap().SwapPoolExistWithFee(data.Coin0, data.Coin1, poolFee(data.fee)) {
 		return &Response{
 			Code: code.PairAlreadyExists,
 			Log:  "swap pool already exist",
@@ -64,7 +73,11 @@ func (data CreateSwapPoolData) basicCheck(tx *Transaction, context *state.CheckS
 		}
 	}
 
-	return nil
+	sender, _ := tx.Sender()
+	if errResp := checkCoinSpender(context, data.Coin0, sender); errResp != nil {
+		return errResp
+	}
+	return checkCoinSpender(context, data.Coin1, sender)
 }
 
 func (data CreateSwapPoolData) String() string {
@@ -97,7 +110,7 @@ func (data CreateSwapPoolData) Run(tx *Transaction, context state.Interface, rew
 		return *errResp
 	}
 
-	if err := checkState.Swap().GetSwapper(data.Coin0, data.Coin1).CheckCreate(data.Volume0, data.Volume1); err != nil {
+	if err := checkState.Swap().GetSwapperWithFee(data.Coin0, data.Coin1, poolFee(data.fee)).CheckCreate(data.Volume0, data.Volume1); err != nil {
 		if err == swap.ErrorInsufficientLiquidityMinted {
 			return Response{
 				Code: code.InsufficientLiquidityMinted,
@@ -156,7 +169,7 @@ func (data CreateSwapPoolData) Run(tx *Transaction, context state.Interface, rew
 		deliverState.Accounts.SubBalance(sender, tx.GasCoin, commission)
 		rewardPool.Add(rewardPool, commissionInBaseCoin)
 
-		amount0, amount1, liquidity, id := deliverState.Swap.PairCreate(data.Coin0, data.Coin1, data.Volume0, data.Volume1)
+		amount0, amount1, liquidity, id := deliverState.Swap.PairCreateWithFee(data.Coin0, data.Coin1, poolFee(data.fee), data.Volume0, data.Volume1)
 
 		deliverState.Accounts.SubBalance(sender, data.Coin0, amount0)
 		deliverState.Accounts.SubBalance(sender, data.Coin1, amount1)
@@ -168,6 +181,9 @@ func (data CreateSwapPoolData) Run(tx *Transaction, context state.Interface, rew
 		deliverState.Coins.CreateToken(coinID, liquidityCoinSymbol, "Liquidity Pool "+coins, true, true, big.NewInt(0).Set(liquidity), maxCoinSupply, nil)
 		deliverState.Accounts.AddBalance(sender, coinID, liquidity.Sub(liquidity, swap.Bound))
 		deliverState.Accounts.AddBalance(types.Address{}, coinID, swap.Bound)
+		deliverState.Swap.AddProviderLiquidity(sender, data.Coin0, data.Coin1, id, liquidity, amount0, amount1)
+		event := eventsdb.CreateSwapPoolEvent(liquidityEvent(deliverState, sender, data.Coin0, data.Coin1, poolFee(data.fee), liquidity, amount0, amount1))
+		deliverState.AddEvent(&event)
 
 		deliverState.App.SetCoinsCount(coinID.Uint32())
 
@@ -194,3 +210,50 @@ func (data CreateSwapPoolData) Run(tx *Transaction, context state.Interface, rew
 func LiquidityCoinSymbol(id uint32) types.CoinSymbol {
 	return types.StrToCoinSymbol(fmt.Sprintf("LP-%d", id))
 }
+
+// CreateSwapPoolDataV250 is CreateSwapPoolData with the fee tier of the new pool in basis points.
+// Fee is optional and can contain at most one value, the default tier is used if it is empty.
+type CreateSwapPoolDataV250 struct {
+	Coin0   types.CoinID
+	Coin1   types.CoinID
+	Volume0 *big.Int
+	Volume1 *big.Int
+	Fee     []uint32 `rlp:"tail"`
+}
+
+func (data CreateSwapPoolDataV250) data() CreateSwapPoolData {
+	return CreateSwapPoolData{
+		Coin0:   data.Coin0,
+		Coin1:   data.Coin1,
+		Volume0: data.Volume0,
+		Volume1: data.Volume1,
+		fee:     optionalFee(data.Fee),
+	}
+}
+
+func (data CreateSwapPoolDataV250) TxType() TxType {
+	return TypeCreateSwapPool
+}
+
+func (data CreateSwapPoolDataV250) Gas() int64 {
+	return data.data().Gas()
+}
+
+func (data CreateSwapPoolDataV250) String() string {
+	return data.data().String()
+}
+
+func (data CreateSwapPoolDataV250) CommissionData(price *commission.Price) *big.Int {
+	return data.data().CommissionData(price)
+}
+
+func (data CreateSwapPoolDataV250) Run(tx *Transaction, context state.Interface, rewardPool *big.Int, currentBlock uint64, price *big.Int) Response {
+	if len(data.Fee) > 1 {
+		return Response{
+			Code: code.DecodeError,
+			Log:  "Incorrect tx data",
+			Info: EncodeError(code.NewDecodeError()),
+		}
+	}
+	return data.data().Run(tx, context, rewardPool, currentBlock, price)
+}
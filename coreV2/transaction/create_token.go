@@ -19,6 +19,8 @@ type CreateTokenData struct {
 	MaxSupply     *big.Int
 	Mintable      bool
 	Burnable      bool
+
+	controlled bool
 }
 
 func (data CreateTokenData) Gas() int64 {
@@ -155,6 +157,10 @@ func (data CreateTokenData) Run(tx *Transaction, context state.Interface, reward
 			&sender,
 		)
 
+		if data.controlled {
+			deliverState.Controls.SetControlled(coinId)
+		}
+
 		deliverState.App.SetCoinsCount(coinId.Uint32())
 		deliverState.Accounts.AddBalance(sender, coinId, data.InitialAmount)
 		deliverState.Accounts.SetNonce(sender, tx.Nonce)
@@ -173,3 +179,54 @@ func (data CreateTokenData) Run(tx *Transaction, context state.Interface, reward
 		Tags: tags,
 	}
 }
+
+// CreateTokenDataV250 is CreateTokenData with the optional controlled flag.
+// The owner of a controlled token can freeze and blocklist addresses.
+type CreateTokenDataV250 struct {
+	Name          string
+	Symbol        types.CoinSymbol
+	InitialAmount *big.Int
+	MaxSupply     *big.Int
+	Mintable      bool
+	Burnable      bool
+	Controlled    []bool `rlp:"tail"`
+}
+
+func (data CreateTokenDataV250) data() CreateTokenData {
+	return CreateTokenData{
+		Name:          data.Name,
+		Symbol:        data.Symbol,
+		InitialAmount: data.InitialAmount,
+		MaxSupply:     data.MaxSupply,
+		Mintable:      data.Mintable,
+		Burnable:      data.Burnable,
+		controlled:    len(data.Controlled) != 0 && data.Controlled[0],
+	}
+}
+
+func (data CreateTokenDataV250) Gas() int64 {
+	return data.data().Gas()
+}
+
+func (data CreateTokenDataV250) TxType() TxType {
+	return TypeCreateToken
+}
+
+func (data CreateTokenDataV250) String() string {
+	return data.data().String()
+}
+
+func (data CreateTokenDataV250) CommissionData(price *commission.Price) *big.Int {
+	return data.data().CommissionData(price)
+}
+
+func (data CreateTokenDataV250) Run(tx *Transaction, context state.Interface, rewardPool *big.Int, currentBlock uint64, price *big.Int) Response {
+	if len(data.Controlled) > 1 {
+		return Response{
+			Code: code.DecodeError,
+			Log:  "Incorrect tx data",
+			Info: EncodeError(code.NewDecodeError()),
+		}
+	}
+	return data.data().Run(tx, context, rewardPool, currentBlock, price)
+}
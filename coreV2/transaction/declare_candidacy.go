@@ -7,7 +7,6 @@ import (
 	"github.com/MinterTeam/minter-go-node/coreV2/state"
 	"github.com/MinterTeam/minter-go-node/coreV2/state/commission"
 	"github.com/MinterTeam/minter-go-node/coreV2/types"
-	"github.com/MinterTeam/minter-go-node/coreV2/validators"
 	abcTypes "github.com/tendermint/tendermint/abci/types"
 	"math/big"
 )
@@ -101,7 +100,7 @@ func (data DeclareCandidacyData) Run(tx *Transaction, context state.Interface, r
 		return *response
 	}
 
-	maxCandidatesCount := validators.GetCandidatesCountForBlock(currentBlock)
+	maxCandidatesCount := checkState.Slots().GetCandidatesCount(currentBlock)
 
 	if checkState.Candidates().Count() >= maxCandidatesCount && !checkState.Candidates().IsNewCandidateStakeSufficient(data.Coin, data.Stake, maxCandidatesCount) {
 		return Response{
@@ -149,6 +149,62 @@ func GetData(txType TxType) (Data, bool) {
 	}
 }
 
+// GetDataV250 returns data of transactions available since v250 update
+func GetDataV250(txType TxType) (Data, bool) {
+	switch txType {
+	case TypeVoteSlots:
+		return &VoteSlotsData{}, true
+	case TypeUnjail:
+		return &UnjailData{}, true
+	case TypeApprove:
+		return &ApproveData{}, true
+	case TypeTransferFrom:
+		return &TransferFromData{}, true
+	case TypeControlToken:
+		return &ControlTokenData{}, true
+	case TypeEditCoinMetadata:
+		return &EditCoinMetadataData{}, true
+	case TypeCreateAirdrop:
+		return &CreateAirdropData{}, true
+	case TypeClaimAirdrop:
+		return &ClaimAirdropData{}, true
+	case TypeReclaimAirdrop:
+		return &ReclaimAirdropData{}, true
+	case TypeRegisterBLSKey:
+		return &RegisterBLSKeyData{}, true
+	case TypeSetAccountKey:
+		return &SetAccountKeyData{}, true
+	case TypeCreateSchedule:
+		return &CreateScheduleData{}, true
+	case TypeCancelSchedule:
+		return &CancelScheduleData{}, true
+	case TypeCreateHTLC:
+		return &CreateHTLCData{}, true
+	case TypeClaimHTLC:
+		return &ClaimHTLCData{}, true
+	case TypeRefundHTLC:
+		return &RefundHTLCData{}, true
+	case TypeRedeemCheck:
+		return &RedeemCheckDataV250{}, true
+	case TypeCreateToken:
+		return &CreateTokenDataV250{}, true
+	case TypeCreateSwapPool:
+		return &CreateSwapPoolDataV250{}, true
+	case TypeAddLiquidity:
+		return &AddLiquidityDataV250{}, true
+	case TypeRemoveLiquidity:
+		return &RemoveLiquidityV250{}, true
+	case TypeSellSwapPool:
+		return &SellSwapPoolDataV250{}, true
+	case TypeBuySwapPool:
+		return &BuySwapPoolDataV250{}, true
+	case TypeSellAllSwapPool:
+		return &SellAllSwapPoolDataV250{}, true
+	default:
+		return GetData(txType)
+	}
+}
+
 func (e *Executor) DecodeFromBytes(buf []byte) (*Transaction, error) {
 	tx, err := e.DecodeFromBytesWithoutSig(buf)
 	if err != nil {
@@ -172,6 +228,20 @@ func DecodeSig(tx *Transaction) (*Transaction, error) {
 				return nil, err
 			}
 		}
+	case SigTypeAccount:
+		{
+			tx.accountSig = &SignatureAccount{}
+			if err := rlp.DecodeBytes(tx.SignatureData, tx.accountSig); err != nil {
+				return nil, err
+			}
+		}
+	case SigTypeBLS:
+		{
+			tx.blsSig = &SignatureBLS{}
+			if err := rlp.DecodeBytes(tx.SignatureData, tx.blsSig); err != nil {
+				return nil, err
+			}
+		}
 	case SigTypeSingle:
 		{
 			tx.sig = &Signature{}
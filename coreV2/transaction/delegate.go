@@ -49,6 +49,10 @@ func (data DelegateData) basicCheck(tx *Transaction, context *state.CheckState)
 	}
 
 	sender, _ := tx.Sender()
+	if errResp := checkCoinSpender(context, data.Coin, sender); errResp != nil {
+		return errResp
+	}
+
 	value := big.NewInt(0).Set(data.Value)
 	if waitList := context.WaitList().Get(sender, data.PubKey, data.Coin); waitList != nil {
 		value.Add(value, waitList.Value)
@@ -0,0 +1,135 @@
+package transaction
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"github.com/MinterTeam/minter-go-node/coreV2/code"
+	"github.com/MinterTeam/minter-go-node/coreV2/state"
+	"github.com/MinterTeam/minter-go-node/coreV2/state/commission"
+	"github.com/MinterTeam/minter-go-node/coreV2/types"
+	abcTypes "github.com/tendermint/tendermint/abci/types"
+)
+
+const (
+	maxCoinURLBytes         = 256
+	maxCoinDescriptionBytes = 1024
+	maxCoinIconHashBytes    = 64
+	maxCoinDecimals         = 18
+)
+
+// EditCoinMetadataData sets the description of the coin for wallets and explorers
+type EditCoinMetadataData struct {
+	Coin        types.CoinID
+	URL         string
+	Description string
+	IconHash    []byte
+	Decimals    uint32
+}
+
+func (data EditCoinMetadataData) Gas() int64 {
+	return gasEditCoinMetadata
+}
+func (data EditCoinMetadataData) TxType() TxType {
+	return TypeEditCoinMetadata
+}
+
+func (data EditCoinMetadataData) basicCheck(tx *Transaction, context *state.CheckState) *Response {
+	for _, field := range []struct {
+		name     string
+		got, max int
+	}{
+		{"url", len(data.URL), maxCoinURLBytes},
+		{"description", len(data.Description), maxCoinDescriptionBytes},
+		{"icon_hash", len(data.IconHash), maxCoinIconHashBytes},
+		{"decimals", int(data.Decimals), maxCoinDecimals},
+	} {
+		if field.got > field.max {
+			return &Response{
+				Code: code.InvalidCoinMetadata,
+				Log:  fmt.Sprintf("Coin %s is invalid. Allowed up to %d.", field.name, field.max),
+				Info: EncodeError(code.NewInvalidCoinMetadata(field.name, strconv.Itoa(field.max), strconv.Itoa(field.got))),
+			}
+		}
+	}
+
+	coin := context.Coins().GetCoin(data.Coin)
+	if coin == nil {
+		return &Response{
+			Code: code.CoinNotExists,
+			Log:  "Coin not exists",
+			Info: EncodeError(code.NewCoinNotExists("", data.Coin.String())),
+		}
+	}
+
+	sender, _ := tx.Sender()
+	return checkCoinOwner(context, coin, sender)
+}
+
+func (data EditCoinMetadataData) String() string {
+	return fmt.Sprintf("EDIT COIN METADATA: %d", data.Coin)
+}
+
+func (data EditCoinMetadataData) CommissionData(price *commission.Price) *big.Int {
+	return price.EditTickerOwner
+}
+
+func (data EditCoinMetadataData) Run(tx *Transaction, context state.Interface, rewardPool *big.Int, currentBlock uint64, price *big.Int) Response {
+	sender, _ := tx.Sender()
+
+	var checkState *state.CheckState
+	var isCheck bool
+	if checkState, isCheck = context.(*state.CheckState); !isCheck {
+		checkState = state.NewCheckState(context.(*state.State))
+	}
+
+	response := data.basicCheck(tx, checkState)
+	if response != nil {
+		return *response
+	}
+
+	commissionInBaseCoin := tx.Commission(price)
+	commissionPoolSwapper := checkState.Swap().GetSwapper(tx.GasCoin, types.GetBaseCoinID())
+	gasCoin := checkState.Coins().GetCoin(tx.GasCoin)
+	commission, isGasCommissionFromPoolSwap, errResp := CalculateCommission(checkState, commissionPoolSwapper, gasCoin, commissionInBaseCoin)
+	if errResp != nil {
+		return *errResp
+	}
+
+	if checkState.Accounts().GetBalance(sender, tx.GasCoin).Cmp(commission) == -1 {
+		return Response{
+			Code: code.InsufficientFunds,
+			Log:  fmt.Sprintf("Insufficient funds for sender account: %s. Wanted %s %s", sender.String(), commission.String(), gasCoin.GetFullSymbol()),
+			Info: EncodeError(code.NewInsufficientFunds(sender.String(), commission.String(), gasCoin.GetFullSymbol(), gasCoin.ID().String())),
+		}
+	}
+
+	var tags []abcTypes.EventAttribute
+	if deliverState, ok := context.(*state.State); ok {
+		if isGasCommissionFromPoolSwap {
+			commission, commissionInBaseCoin, _ = deliverState.Swap.PairSell(tx.GasCoin, types.GetBaseCoinID(), commission, commissionInBaseCoin)
+		} else if !tx.GasCoin.IsBaseCoin() {
+			deliverState.Coins.SubVolume(tx.GasCoin, commission)
+			deliverState.Coins.SubReserve(tx.GasCoin, commissionInBaseCoin)
+		}
+		deliverState.Accounts.SubBalance(sender, tx.GasCoin, commission)
+		rewardPool.Add(rewardPool, commissionInBaseCoin)
+
+		deliverState.Coins.SetMetadata(data.Coin, data.URL, data.Description, data.IconHash, data.Decimals)
+
+		deliverState.Accounts.SetNonce(sender, tx.Nonce)
+
+		tags = []abcTypes.EventAttribute{
+			{Key: []byte("tx.commission_in_base_coin"), Value: []byte(commissionInBaseCoin.String())},
+			{Key: []byte("tx.commission_conversion"), Value: []byte(isGasCommissionFromPoolSwap.String()), Index: true},
+			{Key: []byte("tx.commission_amount"), Value: []byte(commission.String())},
+			{Key: []byte("tx.coin_id"), Value: []byte(data.Coin.String()), Index: true},
+		}
+	}
+
+	return Response{
+		Code: code.OK,
+		Tags: tags,
+	}
+}
@@ -0,0 +1,72 @@
+package transaction
+
+import (
+	"math/big"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/MinterTeam/minter-go-node/coreV2/code"
+	"github.com/MinterTeam/minter-go-node/coreV2/types"
+	"github.com/MinterTeam/minter-go-node/helpers"
+)
+
+func TestEditCoinMetadataTx(t *testing.T) {
+	t.Parallel()
+	cState := getState()
+
+	ownerKey, owner := getAccount()
+	otherKey, other := getAccount()
+	coin := createTestCoinWithOwner(cState, owner)
+
+	cState.Accounts.AddBalance(owner, types.GetBaseCoinID(), helpers.BipToPip(big.NewInt(1000000)))
+	cState.Accounts.AddBalance(other, types.GetBaseCoinID(), helpers.BipToPip(big.NewInt(1000000)))
+
+	data := EditCoinMetadataData{
+		Coin:        coin,
+		URL:         "https://example.com",
+		Description: "Test coin",
+		IconHash:    []byte{1, 2, 3},
+		Decimals:    18,
+	}
+
+	tx, err := makeTestSwapPoolTx(TypeEditCoinMetadata, data, 1, otherKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	response := NewExecutor(GetDataV250).RunTx(cState, tx, big.NewInt(0), 0, &sync.Map{}, 0, false)
+	if response.Code != code.IsNotOwnerOfCoin {
+		t.Fatalf("Response code %d is not %d. Error: %s", response.Code, code.IsNotOwnerOfCoin, response.Log)
+	}
+
+	invalid := data
+	invalid.URL = strings.Repeat("a", maxCoinURLBytes+1)
+	tx, err = makeTestSwapPoolTx(TypeEditCoinMetadata, invalid, 1, ownerKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	response = NewExecutor(GetDataV250).RunTx(cState, tx, big.NewInt(0), 0, &sync.Map{}, 0, false)
+	if response.Code != code.InvalidCoinMetadata {
+		t.Fatalf("Response code %d is not %d. Error: %s", response.Code, code.InvalidCoinMetadata, response.Log)
+	}
+
+	tx, err = makeTestSwapPoolTx(TypeEditCoinMetadata, data, 1, ownerKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if response := NewExecutor(GetData).RunTx(cState, tx, big.NewInt(0), 0, &sync.Map{}, 0, false); response.Code != code.DecodeError {
+		t.Fatalf("Response code %d is not %d. Error: %s", response.Code, code.DecodeError, response.Log)
+	}
+	if response := NewExecutor(GetDataV250).RunTx(cState, tx, big.NewInt(0), 0, &sync.Map{}, 0, false); response.Code != code.OK {
+		t.Fatalf("Response code %d is not 0. Error: %s", response.Code, response.Log)
+	}
+
+	metadata := cState.Coins.GetCoin(coin).Metadata()
+	if metadata == nil || metadata.URL() != data.URL || metadata.Description() != data.Description || metadata.Decimals() != data.Decimals {
+		t.Fatalf("Metadata is not correct: %+v", metadata)
+	}
+
+	if err := checkState(cState); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,46 @@
+package transaction
+
+import (
+	"math/big"
+
+	eventsdb "github.com/MinterTeam/minter-go-node/coreV2/events"
+	"github.com/MinterTeam/minter-go-node/coreV2/state"
+	"github.com/MinterTeam/minter-go-node/coreV2/types"
+)
+
+// liquidityEvent returns the event of a change of liquidity of the pool with its reserves after the change.
+// It can be converted to the event of creation or removal, they have the same fields.
+func liquidityEvent(deliverState *state.State, address types.Address, coin0, coin1 types.CoinID, fee uint32, liquidity, volume0, volume1 *big.Int) eventsdb.AddLiquidityEvent {
+	swapper := deliverState.Swap.GetSwapperWithFee(coin0, coin1, fee)
+	reserve0, reserve1 := swapper.Reserves()
+	return eventsdb.AddLiquidityEvent{
+		Address:   address,
+		PoolID:    swapper.GetID(),
+		Fee:       swapper.Fee(),
+		Coin0:     uint64(coin0),
+		Coin1:     uint64(coin1),
+		Volume0:   volume0.String(),
+		Volume1:   volume1.String(),
+		Liquidity: liquidity.String(),
+		Reserve0:  reserve0.String(),
+		Reserve1:  reserve1.String(),
+	}
+}
+
+// addBancorTradeEvent adds the event of a trade of the coin by the bancor formula with its supply and reserve after the trade
+func addBancorTradeEvent(deliverState *state.State, address types.Address, coinID types.CoinID, sell bool, value, valueBase *big.Int) {
+	if coinID.IsBaseCoin() {
+		return
+	}
+
+	coin := deliverState.Coins.GetCoin(coinID)
+	deliverState.AddEvent(&eventsdb.BancorTradeEvent{
+		Address:   address,
+		Coin:      uint64(coinID),
+		Sell:      sell,
+		Value:     value.String(),
+		ValueBase: valueBase.String(),
+		Volume:    coin.Volume().String(),
+		Reserve:   coin.Reserve().String(),
+	})
+}
@@ -0,0 +1,93 @@
+package transaction
+
+import (
+	"math/big"
+	"sync"
+	"testing"
+
+	"github.com/MinterTeam/minter-go-node/coreV2/code"
+	eventsdb "github.com/MinterTeam/minter-go-node/coreV2/events"
+	"github.com/MinterTeam/minter-go-node/coreV2/state"
+	"github.com/MinterTeam/minter-go-node/coreV2/types"
+	"github.com/MinterTeam/minter-go-node/helpers"
+	db "github.com/tendermint/tm-db"
+)
+
+func TestSwapPoolEvents(t *testing.T) {
+	t.Parallel()
+	events := eventsdb.NewEventsStore(db.NewMemDB())
+	cState, err := state.NewState(0, db.NewMemDB(), events, 1, 1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cState.Validators.Create(types.Pubkey{}, big.NewInt(1))
+	cState.Candidates.Create(types.Address{}, types.Address{}, types.Address{}, types.Pubkey{}, 10, 0, 0)
+	cState.Commission.SetNewCommissions(commissionPrice.Encode())
+
+	coin := createTestCoin(cState)
+	coin1 := createNonReserveCoin(cState)
+
+	privateKey, addr := getAccount()
+	cState.Accounts.AddBalance(addr, types.BasecoinID, helpers.BipToPip(big.NewInt(1000000)))
+	cState.Accounts.SubBalance(types.Address{}, coin, helpers.BipToPip(big.NewInt(100000)))
+	cState.Accounts.AddBalance(addr, coin, helpers.BipToPip(big.NewInt(100000)))
+	cState.Accounts.SubBalance(types.Address{}, coin1, helpers.BipToPip(big.NewInt(100000)))
+	cState.Accounts.AddBalance(addr, coin1, helpers.BipToPip(big.NewInt(100000)))
+
+	nonce := uint64(1)
+	run := func(txType TxType, data interface{}) {
+		tx, err := makeTestSwapPoolTx(txType, data, nonce, privateKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		response := NewExecutor(GetDataV250).RunTx(cState, tx, big.NewInt(0), 0, &sync.Map{}, 0, false)
+		if response.Code != code.OK {
+			t.Fatalf("Response code %d is not 0. Error: %s", response.Code, response.Log)
+		}
+		nonce++
+	}
+
+	run(TypeCreateSwapPool, CreateSwapPoolDataV250{
+		Coin0:   coin,
+		Coin1:   coin1,
+		Volume0: helpers.BipToPip(big.NewInt(1000)),
+		Volume1: helpers.BipToPip(big.NewInt(1000)),
+	})
+	run(TypeSellSwapPool, SellSwapPoolDataV250{
+		Coins:             []types.CoinID{coin, coin1},
+		ValueToSell:       helpers.BipToPip(big.NewInt(10)),
+		MinimumValueToBuy: big.NewInt(1),
+	})
+	if err := events.CommitEvents(1); err != nil {
+		t.Fatal(err)
+	}
+
+	var create *eventsdb.CreateSwapPoolEvent
+	var swap *eventsdb.SwapEvent
+	for _, event := range events.LoadEvents(1) {
+		switch e := event.(type) {
+		case *eventsdb.CreateSwapPoolEvent:
+			create = e
+		case *eventsdb.SwapEvent:
+			swap = e
+		}
+	}
+
+	if create == nil {
+		t.Fatal("create swap pool event not found")
+	}
+	if create.Address != addr || create.Volume0 != helpers.BipToPip(big.NewInt(1000)).String() || create.Reserve0 != create.Volume0 {
+		t.Errorf("invalid create swap pool event %#v", create)
+	}
+
+	if swap == nil {
+		t.Fatal("swap event not found")
+	}
+	if swap.PoolID != create.PoolID || swap.CoinIn != uint64(coin) || swap.CoinOut != uint64(coin1) || swap.ValueIn != helpers.BipToPip(big.NewInt(10)).String() {
+		t.Errorf("invalid swap event %#v", swap)
+	}
+	_, reserve1 := cState.Swap.GetSwapperWithFee(coin, coin1, create.Fee).Reserves()
+	if swap.ReserveOut != reserve1.String() {
+		t.Errorf("reserve out %s is not %s", swap.ReserveOut, reserve1)
+	}
+}
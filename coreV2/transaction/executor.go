@@ -119,6 +119,38 @@ func (e *Executor) RunTx(context state.Interface, rawTx []byte, rewardPool *big.
 		}
 	}
 
+	// signatures of authorised account keys are available with transactions of v250 update
+	if tx.SignatureType == SigTypeAccount {
+		if _, ok := e.decodeTxFunc(TypeSetAccountKey); !ok {
+			return Response{
+				Code: code.DecodeError,
+				Log:  "unknown signature type",
+				Info: EncodeError(code.NewDecodeError()),
+			}
+		}
+	}
+
+	if tx.SignatureType == SigTypeSingle || tx.SignatureType == SigTypeAccount {
+		if response := checkAccountSigner(checkState, tx); response != nil {
+			return *response
+		}
+	}
+
+	// aggregated signatures are available with transactions of v250 update
+	if tx.SignatureType == SigTypeBLS {
+		if _, ok := e.decodeTxFunc(TypeRegisterBLSKey); !ok {
+			return Response{
+				Code: code.DecodeError,
+				Log:  "unknown signature type",
+				Info: EncodeError(code.NewDecodeError()),
+			}
+		}
+
+		if response := checkSignatureBLS(checkState, tx); response != nil {
+			return *response
+		}
+	}
+
 	// check multi-signature
 	if tx.SignatureType == SigTypeMulti {
 		multisig := checkState.Accounts().GetAccount(tx.multisig.Multisig)
@@ -141,6 +173,16 @@ func (e *Executor) RunTx(context state.Interface, rawTx []byte, rewardPool *big.
 			}
 		}
 
+		// members with rotated keys sign by their authorised keys
+		members := make(map[types.Address]types.Address, len(multisigData.Addresses))
+		for _, address := range multisigData.Addresses {
+			if authorised := checkState.Accounts().GetSigner(address); authorised != nil {
+				members[*authorised] = address
+				continue
+			}
+			members[address] = address
+		}
+
 		txHash := tx.Hash()
 		var totalWeight uint32
 		var usedAccounts = map[types.Address]bool{}
@@ -155,16 +197,28 @@ func (e *Executor) RunTx(context state.Interface, rawTx []byte, rewardPool *big.
 				}
 			}
 
-			if usedAccounts[signer] {
+			member, ok := members[signer]
+			if !ok {
+				if rotated := checkState.Accounts().GetSigner(signer); rotated != nil {
+					return Response{
+						Code: code.AccountKeyRotated,
+						Log:  fmt.Sprintf("Key of multisig member %s is rotated", signer.String()),
+						Info: EncodeError(code.NewAccountKeyRotated(signer.String(), rotated.String())),
+					}
+				}
+				member = signer
+			}
+
+			if usedAccounts[member] {
 				return Response{
 					Code: code.DuplicatedAddresses,
 					Log:  "Duplicated multisig addresses",
-					Info: EncodeError(code.NewDuplicatedAddresses(signer.String())),
+					Info: EncodeError(code.NewDuplicatedAddresses(member.String())),
 				}
 			}
 
-			usedAccounts[signer] = true
-			totalWeight += multisigData.GetWeight(signer)
+			usedAccounts[member] = true
+			totalWeight += multisigData.GetWeight(member)
 		}
 
 		if totalWeight < multisigData.Threshold {
@@ -185,6 +239,13 @@ func (e *Executor) RunTx(context state.Interface, rawTx []byte, rewardPool *big.
 		}
 	}
 
+	// commission of the check is paid by its issuer
+	if tx.Type != TypeRedeemCheck {
+		if response := checkCoinSpender(checkState, tx.commissionCoin(), sender); response != nil {
+			return *response
+		}
+	}
+
 	commissions := checkState.Commission().GetCommissions()
 	price := tx.Price(commissions)
 	coinCommission := abcTypes.EventAttribute{Key: []byte("tx.commission_price_coin"), Value: []byte(strconv.Itoa(int(commissions.Coin)))}
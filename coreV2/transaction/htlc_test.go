@@ -0,0 +1,62 @@
+package transaction
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/MinterTeam/minter-go-node/coreV2/code"
+	"github.com/MinterTeam/minter-go-node/coreV2/state/htlcs"
+	"github.com/MinterTeam/minter-go-node/coreV2/types"
+	"github.com/MinterTeam/minter-go-node/helpers"
+)
+
+func TestHTLCTx(t *testing.T) {
+	t.Parallel()
+	cState := getState()
+
+	senderKey, sender := getAccount()
+	recipientKey, recipient := getAccount()
+	otherKey, other := getAccount()
+	cState.Accounts.AddBalance(sender, types.GetBaseCoinID(), helpers.BipToPip(big.NewInt(1000000)))
+	cState.Accounts.AddBalance(other, types.GetBaseCoinID(), helpers.BipToPip(big.NewInt(1000000)))
+
+	preimage := make([]byte, htlcs.PreimageLength)
+	preimage[0] = 1
+	value := helpers.BipToPip(big.NewInt(100))
+	create := CreateHTLCData{
+		Recipient: recipient,
+		Coin:      types.GetBaseCoinID(),
+		Value:     value,
+		HashLock:  htlcs.HashLock(preimage),
+		Timeout:   10,
+	}
+	runTestTx(t, cState, TypeCreateHTLC, create, 1, senderKey, 10, code.WrongHTLCTimeout)
+	runTestTx(t, cState, TypeCreateHTLC, create, 1, senderKey, 1, code.OK)
+	runTestTx(t, cState, TypeCreateHTLC, create, 2, senderKey, 1, code.OK)
+
+	runTestTx(t, cState, TypeClaimHTLC, ClaimHTLCData{HTLC: 1, Preimage: preimage}, 1, otherKey, 2, code.IsNotRecipientOfHTLC)
+	runTestTx(t, cState, TypeClaimHTLC, ClaimHTLCData{HTLC: 1, Preimage: make([]byte, htlcs.PreimageLength)}, 1, recipientKey, 2, code.WrongHTLCPreimage)
+	runTestTx(t, cState, TypeClaimHTLC, ClaimHTLCData{HTLC: 1, Preimage: preimage}, 1, recipientKey, 10, code.HTLCExpired)
+	runTestTx(t, cState, TypeRefundHTLC, RefundHTLCData{HTLC: 1}, 3, senderKey, 9, code.HTLCNotExpired)
+
+	// the commission is paid from the claimed value
+	runTestTx(t, cState, TypeClaimHTLC, ClaimHTLCData{HTLC: 1, Preimage: preimage}, 1, recipientKey, 2, code.OK)
+	if balance := cState.Accounts.GetBalance(recipient, types.GetBaseCoinID()); balance.Sign() != 1 || balance.Cmp(value) != -1 {
+		t.Fatalf("Recipient balance is not correct: %s", balance)
+	}
+	runTestTx(t, cState, TypeClaimHTLC, ClaimHTLCData{HTLC: 1, Preimage: preimage}, 2, recipientKey, 2, code.HTLCNotExists)
+
+	runTestTx(t, cState, TypeRefundHTLC, RefundHTLCData{HTLC: 2}, 1, otherKey, 10, code.IsNotSenderOfHTLC)
+	balance := cState.Accounts.GetBalance(sender, types.GetBaseCoinID())
+	runTestTx(t, cState, TypeRefundHTLC, RefundHTLCData{HTLC: 2}, 3, senderKey, 10, code.OK)
+	if diff := big.NewInt(0).Sub(cState.Accounts.GetBalance(sender, types.GetBaseCoinID()), balance); diff.Sign() != 1 || diff.Cmp(value) != -1 {
+		t.Fatalf("Sender did not get the value back: %s", diff)
+	}
+	if cState.HTLCs.GetHTLC(2) != nil {
+		t.Fatal("HTLC is not closed")
+	}
+
+	if err := checkState(cState); err != nil {
+		t.Error(err)
+	}
+}
@@ -39,6 +39,16 @@ func (data MultisendData) basicCheck(tx *Transaction, context *state.CheckState)
 	if errResp := checkCoins(context, data.List); errResp != nil {
 		return errResp
 	}
+
+	sender, _ := tx.Sender()
+	for _, item := range data.List {
+		if errResp := checkCoinSpender(context, item.Coin, sender); errResp != nil {
+			return errResp
+		}
+		if errResp := checkCoinRecipient(context, item.Coin, item.To); errResp != nil {
+			return errResp
+		}
+	}
 	return nil
 }
 
@@ -0,0 +1,132 @@
+package transaction
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"github.com/MinterTeam/minter-go-node/coreV2/code"
+	"github.com/MinterTeam/minter-go-node/coreV2/state"
+	"github.com/MinterTeam/minter-go-node/coreV2/state/commission"
+	"github.com/MinterTeam/minter-go-node/coreV2/types"
+	abcTypes "github.com/tendermint/tendermint/abci/types"
+)
+
+// ReclaimAirdropData returns the unclaimed rest of the expired airdrop to its creator
+type ReclaimAirdropData struct {
+	Airdrop uint32
+}
+
+func (data ReclaimAirdropData) Gas() int64 {
+	return gasReclaimAirdrop
+}
+func (data ReclaimAirdropData) TxType() TxType {
+	return TypeReclaimAirdrop
+}
+
+func (data ReclaimAirdropData) basicCheck(tx *Transaction, context *state.CheckState) *Response {
+	airdropID := strconv.FormatUint(uint64(data.Airdrop), 10)
+	airdrop := context.Airdrops().GetAirdrop(data.Airdrop)
+	if airdrop == nil {
+		return &Response{
+			Code: code.AirdropNotExists,
+			Log:  fmt.Sprintf("Airdrop %s not exists", airdropID),
+			Info: EncodeError(code.NewAirdropNotExists(airdropID)),
+		}
+	}
+
+	sender, _ := tx.Sender()
+	if airdrop.Creator != sender {
+		return &Response{
+			Code: code.IsNotCreatorOfAirdrop,
+			Log:  "Sender is not creator of airdrop",
+			Info: EncodeError(code.NewIsNotCreatorOfAirdrop(airdropID, airdrop.Creator.String())),
+		}
+	}
+
+	return checkCoinRecipient(context, airdrop.Coin, sender)
+}
+
+func (data ReclaimAirdropData) String() string {
+	return fmt.Sprintf("RECLAIM AIRDROP airdrop:%d", data.Airdrop)
+}
+
+func (data ReclaimAirdropData) CommissionData(price *commission.Price) *big.Int {
+	return price.Send
+}
+
+func (data ReclaimAirdropData) Run(tx *Transaction, context state.Interface, rewardPool *big.Int, currentBlock uint64, price *big.Int) Response {
+	sender, _ := tx.Sender()
+
+	var checkState *state.CheckState
+	var isCheck bool
+	if checkState, isCheck = context.(*state.CheckState); !isCheck {
+		checkState = state.NewCheckState(context.(*state.State))
+	}
+
+	response := data.basicCheck(tx, checkState)
+	if response != nil {
+		return *response
+	}
+
+	airdrop := checkState.Airdrops().GetAirdrop(data.Airdrop)
+	if currentBlock < airdrop.ExpireHeight {
+		airdropID := strconv.FormatUint(uint64(data.Airdrop), 10)
+		return Response{
+			Code: code.AirdropNotExpired,
+			Log:  fmt.Sprintf("Airdrop %s expires at block %d", airdropID, airdrop.ExpireHeight),
+			Info: EncodeError(code.NewAirdropNotExpired(airdropID, strconv.FormatUint(airdrop.ExpireHeight, 10), strconv.FormatUint(currentBlock, 10))),
+		}
+	}
+
+	commissionInBaseCoin := tx.Commission(price)
+	commissionPoolSwapper := checkState.Swap().GetSwapper(tx.GasCoin, types.GetBaseCoinID())
+	gasCoin := checkState.Coins().GetCoin(tx.GasCoin)
+	commission, isGasCommissionFromPoolSwap, errResp := CalculateCommission(checkState, commissionPoolSwapper, gasCoin, commissionInBaseCoin)
+	if errResp != nil {
+		return *errResp
+	}
+
+	balance := checkState.Accounts().GetBalance(sender, tx.GasCoin)
+	if tx.GasCoin == airdrop.Coin {
+		balance.Add(balance, airdrop.GetValue())
+	}
+	if balance.Cmp(commission) < 0 {
+		return Response{
+			Code: code.InsufficientFunds,
+			Log:  fmt.Sprintf("Insufficient funds for sender account: %s. Wanted %s %s", sender.String(), commission.String(), gasCoin.GetFullSymbol()),
+			Info: EncodeError(code.NewInsufficientFunds(sender.String(), commission.String(), gasCoin.GetFullSymbol(), gasCoin.ID().String())),
+		}
+	}
+
+	var tags []abcTypes.EventAttribute
+	if deliverState, ok := context.(*state.State); ok {
+		value := deliverState.Airdrops.Reclaim(data.Airdrop)
+		deliverState.Accounts.AddBalance(sender, airdrop.Coin, value)
+
+		if isGasCommissionFromPoolSwap {
+			commission, commissionInBaseCoin, _ = deliverState.Swap.PairSell(tx.GasCoin, types.GetBaseCoinID(), commission, commissionInBaseCoin)
+		} else if !tx.GasCoin.IsBaseCoin() {
+			deliverState.Coins.SubVolume(tx.GasCoin, commission)
+			deliverState.Coins.SubReserve(tx.GasCoin, commissionInBaseCoin)
+		}
+		deliverState.Accounts.SubBalance(sender, tx.GasCoin, commission)
+		rewardPool.Add(rewardPool, commissionInBaseCoin)
+
+		deliverState.Accounts.SetNonce(sender, tx.Nonce)
+
+		tags = []abcTypes.EventAttribute{
+			{Key: []byte("tx.commission_in_base_coin"), Value: []byte(commissionInBaseCoin.String())},
+			{Key: []byte("tx.commission_conversion"), Value: []byte(isGasCommissionFromPoolSwap.String()), Index: true},
+			{Key: []byte("tx.commission_amount"), Value: []byte(commission.String())},
+			{Key: []byte("tx.coin_id"), Value: []byte(airdrop.Coin.String()), Index: true},
+			{Key: []byte("tx.airdrop_id"), Value: []byte(strconv.FormatUint(uint64(data.Airdrop), 10)), Index: true},
+			{Key: []byte("tx.return"), Value: []byte(value.String())},
+		}
+	}
+
+	return Response{
+		Code: code.OK,
+		Tags: tags,
+	}
+}
@@ -21,6 +21,8 @@ import (
 type RedeemCheckData struct {
 	RawCheck []byte
 	Proof    [65]byte
+
+	accountChecks bool
 }
 
 func (data RedeemCheckData) Gas() int64 {
@@ -98,7 +100,7 @@ func (data RedeemCheckData) Run(tx *Transaction, context state.Interface, reward
 		}
 	}
 
-	checkSender, err := decodedCheck.Sender()
+	checkSigner, err := decodedCheck.Sender()
 	if err != nil {
 		return Response{
 			Code: code.DecodeError,
@@ -107,6 +109,32 @@ func (data RedeemCheckData) Run(tx *Transaction, context state.Interface, reward
 		}
 	}
 
+	checkSender := checkSigner
+	if len(decodedCheck.Account) != 0 {
+		if !data.accountChecks {
+			return Response{
+				Code: code.DecodeError,
+				Log:  "Incorrect check issuer",
+				Info: EncodeError(code.NewDecodeError()),
+			}
+		}
+
+		checkSender = decodedCheck.Account[0]
+		if authorised := checkState.Accounts().GetSigner(checkSender); authorised == nil || *authorised != checkSigner {
+			return Response{
+				Code: code.SignerIsNotAuthorised,
+				Log:  fmt.Sprintf("Signer %s is not authorised to sign checks of account %s", checkSigner.String(), checkSender.String()),
+				Info: EncodeError(code.NewSignerIsNotAuthorised(checkSender.String(), checkSigner.String())),
+			}
+		}
+	} else if rotated := checkState.Accounts().GetSigner(checkSigner); rotated != nil {
+		return Response{
+			Code: code.AccountKeyRotated,
+			Log:  fmt.Sprintf("Key of check issuer %s is rotated", checkSigner.String()),
+			Info: EncodeError(code.NewAccountKeyRotated(checkSigner.String(), rotated.String())),
+		}
+	}
+
 	if !checkState.Coins().Exists(decodedCheck.Coin) {
 		return Response{
 			Code: code.CoinNotExists,
@@ -131,6 +159,15 @@ func (data RedeemCheckData) Run(tx *Transaction, context state.Interface, reward
 		}
 	}
 
+	for _, coin := range []types.CoinID{decodedCheck.Coin, decodedCheck.GasCoin} {
+		if errResp := checkCoinSpender(checkState, coin, checkSender); errResp != nil {
+			return *errResp
+		}
+	}
+	if errResp := checkCoinRecipient(checkState, decodedCheck.Coin, sender); errResp != nil {
+		return *errResp
+	}
+
 	if decodedCheck.DueBlock < currentBlock {
 		return Response{
 			Code: code.CheckExpired,
@@ -247,3 +284,38 @@ func (data RedeemCheckData) Run(tx *Transaction, context state.Interface, reward
 		Tags: tags,
 	}
 }
+
+// RedeemCheckDataV250 is RedeemCheckData which also accepts checks signed by the key
+// authorised by SetAccountKey transaction of the issuer, see check.Check.Account
+type RedeemCheckDataV250 struct {
+	RawCheck []byte
+	Proof    [65]byte
+}
+
+func (data RedeemCheckDataV250) data() RedeemCheckData {
+	return RedeemCheckData{
+		RawCheck:      data.RawCheck,
+		Proof:         data.Proof,
+		accountChecks: true,
+	}
+}
+
+func (data RedeemCheckDataV250) TxType() TxType {
+	return TypeRedeemCheck
+}
+
+func (data RedeemCheckDataV250) Gas() int64 {
+	return data.data().Gas()
+}
+
+func (data RedeemCheckDataV250) String() string {
+	return data.data().String()
+}
+
+func (data RedeemCheckDataV250) CommissionData(price *commission.Price) *big.Int {
+	return data.data().CommissionData(price)
+}
+
+func (data RedeemCheckDataV250) Run(tx *Transaction, context state.Interface, rewardPool *big.Int, currentBlock uint64, price *big.Int) Response {
+	return data.data().Run(tx, context, rewardPool, currentBlock, price)
+}
@@ -0,0 +1,134 @@
+package transaction
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"github.com/MinterTeam/minter-go-node/coreV2/code"
+	"github.com/MinterTeam/minter-go-node/coreV2/state"
+	"github.com/MinterTeam/minter-go-node/coreV2/state/commission"
+	"github.com/MinterTeam/minter-go-node/coreV2/types"
+	abcTypes "github.com/tendermint/tendermint/abci/types"
+)
+
+// RefundHTLCData returns the value of the contract, which is not claimed before the timeout, to its sender
+type RefundHTLCData struct {
+	HTLC uint32
+}
+
+func (data RefundHTLCData) Gas() int64 {
+	return gasRefundHTLC
+}
+func (data RefundHTLCData) TxType() TxType {
+	return TypeRefundHTLC
+}
+
+func (data RefundHTLCData) basicCheck(tx *Transaction, context *state.CheckState) *Response {
+	htlcID := strconv.FormatUint(uint64(data.HTLC), 10)
+	htlc := context.HTLCs().GetHTLC(data.HTLC)
+	if htlc == nil {
+		return &Response{
+			Code: code.HTLCNotExists,
+			Log:  fmt.Sprintf("HTLC %s not exists", htlcID),
+			Info: EncodeError(code.NewHTLCNotExists(htlcID)),
+		}
+	}
+
+	sender, _ := tx.Sender()
+	if htlc.Sender != sender {
+		return &Response{
+			Code: code.IsNotSenderOfHTLC,
+			Log:  "Sender is not sender of HTLC",
+			Info: EncodeError(code.NewIsNotSenderOfHTLC(htlcID, htlc.Sender.String())),
+		}
+	}
+
+	return checkCoinRecipient(context, htlc.Coin, sender)
+}
+
+func (data RefundHTLCData) String() string {
+	return fmt.Sprintf("REFUND HTLC htlc:%d", data.HTLC)
+}
+
+func (data RefundHTLCData) CommissionData(price *commission.Price) *big.Int {
+	return price.Send
+}
+
+func (data RefundHTLCData) Run(tx *Transaction, context state.Interface, rewardPool *big.Int, currentBlock uint64, price *big.Int) Response {
+	sender, _ := tx.Sender()
+
+	var checkState *state.CheckState
+	var isCheck bool
+	if checkState, isCheck = context.(*state.CheckState); !isCheck {
+		checkState = state.NewCheckState(context.(*state.State))
+	}
+
+	response := data.basicCheck(tx, checkState)
+	if response != nil {
+		return *response
+	}
+
+	htlc := checkState.HTLCs().GetHTLC(data.HTLC)
+	if currentBlock < htlc.Timeout {
+		htlcID := strconv.FormatUint(uint64(data.HTLC), 10)
+		return Response{
+			Code: code.HTLCNotExpired,
+			Log:  fmt.Sprintf("HTLC %s expires at block %d", htlcID, htlc.Timeout),
+			Info: EncodeError(code.NewHTLCNotExpired(htlcID, strconv.FormatUint(htlc.Timeout, 10), strconv.FormatUint(currentBlock, 10))),
+		}
+	}
+
+	commissionInBaseCoin := tx.Commission(price)
+	commissionPoolSwapper := checkState.Swap().GetSwapper(tx.GasCoin, types.GetBaseCoinID())
+	gasCoin := checkState.Coins().GetCoin(tx.GasCoin)
+	commission, isGasCommissionFromPoolSwap, errResp := CalculateCommission(checkState, commissionPoolSwapper, gasCoin, commissionInBaseCoin)
+	if errResp != nil {
+		return *errResp
+	}
+
+	balance := checkState.Accounts().GetBalance(sender, tx.GasCoin)
+	if tx.GasCoin == htlc.Coin {
+		balance.Add(balance, htlc.GetValue())
+	}
+	if balance.Cmp(commission) < 0 {
+		return Response{
+			Code: code.InsufficientFunds,
+			Log:  fmt.Sprintf("Insufficient funds for sender account: %s. Wanted %s %s", sender.String(), commission.String(), gasCoin.GetFullSymbol()),
+			Info: EncodeError(code.NewInsufficientFunds(sender.String(), commission.String(), gasCoin.GetFullSymbol(), gasCoin.ID().String())),
+		}
+	}
+
+	var tags []abcTypes.EventAttribute
+	if deliverState, ok := context.(*state.State); ok {
+		value := deliverState.HTLCs.Close(data.HTLC)
+		deliverState.Accounts.AddBalance(sender, htlc.Coin, value)
+
+		if isGasCommissionFromPoolSwap {
+			commission, commissionInBaseCoin, _ = deliverState.Swap.PairSell(tx.GasCoin, types.GetBaseCoinID(), commission, commissionInBaseCoin)
+		} else if !tx.GasCoin.IsBaseCoin() {
+			deliverState.Coins.SubVolume(tx.GasCoin, commission)
+			deliverState.Coins.SubReserve(tx.GasCoin, commissionInBaseCoin)
+		}
+		deliverState.Accounts.SubBalance(sender, tx.GasCoin, commission)
+		rewardPool.Add(rewardPool, commissionInBaseCoin)
+
+		deliverState.Accounts.SetNonce(sender, tx.Nonce)
+
+		tags = []abcTypes.EventAttribute{
+			{Key: []byte("tx.commission_in_base_coin"), Value: []byte(commissionInBaseCoin.String())},
+			{Key: []byte("tx.commission_conversion"), Value: []byte(isGasCommissionFromPoolSwap.String()), Index: true},
+			{Key: []byte("tx.commission_amount"), Value: []byte(commission.String())},
+			{Key: []byte("tx.coin_id"), Value: []byte(htlc.Coin.String()), Index: true},
+			{Key: []byte("tx.htlc_id"), Value: []byte(strconv.FormatUint(uint64(data.HTLC), 10)), Index: true},
+			{Key: []byte("tx.hash_lock"), Value: []byte(hex.EncodeToString(htlc.HashLock[:])), Index: true},
+			{Key: []byte("tx.return"), Value: []byte(value.String())},
+		}
+	}
+
+	return Response{
+		Code: code.OK,
+		Tags: tags,
+	}
+}
@@ -0,0 +1,106 @@
+package transaction
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/MinterTeam/minter-go-node/coreV2/code"
+	"github.com/MinterTeam/minter-go-node/coreV2/state"
+	"github.com/MinterTeam/minter-go-node/coreV2/state/commission"
+	"github.com/MinterTeam/minter-go-node/coreV2/types"
+	"github.com/MinterTeam/minter-go-node/crypto/bls"
+	abcTypes "github.com/tendermint/tendermint/abci/types"
+)
+
+// RegisterBLSKeyData binds the BLS public key to the sender, so the sender can take part in aggregated multisig signatures.
+// Proof is the signature of the key over the sender address and protects against rogue key attacks.
+type RegisterBLSKeyData struct {
+	PublicKey []byte
+	Proof     []byte
+}
+
+func (data RegisterBLSKeyData) Gas() int64 {
+	return gasRegisterBLSKey
+}
+func (data RegisterBLSKeyData) TxType() TxType {
+	return TypeRegisterBLSKey
+}
+
+func (data RegisterBLSKeyData) basicCheck(tx *Transaction, context *state.CheckState) *Response {
+	sender, _ := tx.Sender()
+	if len(data.PublicKey) != types.BLSPublicKeyLength || !bls.VerifyProofOfPossession(data.PublicKey, sender.Bytes(), data.Proof) {
+		return &Response{
+			Code: code.InvalidBLSKey,
+			Log:  "Invalid BLS public key or proof of possession",
+			Info: EncodeError(code.NewInvalidBLSKey(hex.EncodeToString(data.PublicKey))),
+		}
+	}
+
+	return nil
+}
+
+func (data RegisterBLSKeyData) String() string {
+	return fmt.Sprintf("REGISTER BLS KEY key:%x", data.PublicKey)
+}
+
+func (data RegisterBLSKeyData) CommissionData(price *commission.Price) *big.Int {
+	return price.EditMultisig
+}
+
+func (data RegisterBLSKeyData) Run(tx *Transaction, context state.Interface, rewardPool *big.Int, currentBlock uint64, price *big.Int) Response {
+	sender, _ := tx.Sender()
+
+	var checkState *state.CheckState
+	var isCheck bool
+	if checkState, isCheck = context.(*state.CheckState); !isCheck {
+		checkState = state.NewCheckState(context.(*state.State))
+	}
+
+	response := data.basicCheck(tx, checkState)
+	if response != nil {
+		return *response
+	}
+
+	commissionInBaseCoin := tx.Commission(price)
+	commissionPoolSwapper := checkState.Swap().GetSwapper(tx.GasCoin, types.GetBaseCoinID())
+	gasCoin := checkState.Coins().GetCoin(tx.GasCoin)
+	commission, isGasCommissionFromPoolSwap, errResp := CalculateCommission(checkState, commissionPoolSwapper, gasCoin, commissionInBaseCoin)
+	if errResp != nil {
+		return *errResp
+	}
+
+	if checkState.Accounts().GetBalance(sender, tx.GasCoin).Cmp(commission) < 0 {
+		return Response{
+			Code: code.InsufficientFunds,
+			Log:  fmt.Sprintf("Insufficient funds for sender account: %s. Wanted %s %s", sender.String(), commission.String(), gasCoin.GetFullSymbol()),
+			Info: EncodeError(code.NewInsufficientFunds(sender.String(), commission.String(), gasCoin.GetFullSymbol(), gasCoin.ID().String())),
+		}
+	}
+
+	var tags []abcTypes.EventAttribute
+	if deliverState, ok := context.(*state.State); ok {
+		if isGasCommissionFromPoolSwap {
+			commission, commissionInBaseCoin, _ = deliverState.Swap.PairSell(tx.GasCoin, types.GetBaseCoinID(), commission, commissionInBaseCoin)
+		} else if !tx.GasCoin.IsBaseCoin() {
+			deliverState.Coins.SubVolume(tx.GasCoin, commission)
+			deliverState.Coins.SubReserve(tx.GasCoin, commissionInBaseCoin)
+		}
+		deliverState.Accounts.SubBalance(sender, tx.GasCoin, commission)
+		rewardPool.Add(rewardPool, commissionInBaseCoin)
+
+		deliverState.BLSKeys.SetKey(sender, data.PublicKey)
+		deliverState.Accounts.SetNonce(sender, tx.Nonce)
+
+		tags = []abcTypes.EventAttribute{
+			{Key: []byte("tx.commission_in_base_coin"), Value: []byte(commissionInBaseCoin.String())},
+			{Key: []byte("tx.commission_conversion"), Value: []byte(isGasCommissionFromPoolSwap.String()), Index: true},
+			{Key: []byte("tx.commission_amount"), Value: []byte(commission.String())},
+		}
+	}
+
+	return Response{
+		Code: code.OK,
+		Tags: tags,
+	}
+}
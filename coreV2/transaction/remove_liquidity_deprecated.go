@@ -5,6 +5,7 @@ import (
 	"math/big"
 
 	"github.com/MinterTeam/minter-go-node/coreV2/code"
+	eventsdb "github.com/MinterTeam/minter-go-node/coreV2/events"
 	"github.com/MinterTeam/minter-go-node/coreV2/state"
 	"github.com/MinterTeam/minter-go-node/coreV2/state/commission"
 	"github.com/MinterTeam/minter-go-node/coreV2/state/swap"
@@ -146,6 +147,9 @@ func (data RemoveLiquidityDeprecated) Run(tx *Transaction, context state.Interfa
 
 		deliverState.Coins.SubVolume(coinLiquidity.ID(), data.Liquidity)
 		deliverState.Accounts.SubBalance(sender, coinLiquidity.ID(), data.Liquidity)
+		deliverState.Swap.RemoveProviderLiquidity(sender, data.Coin0, data.Coin1, swapper.GetID(), data.Liquidity, amount0, amount1)
+		event := eventsdb.RemoveLiquidityEvent(liquidityEvent(deliverState, sender, data.Coin0, data.Coin1, swap.DefaultFee, data.Liquidity, amount0, amount1))
+		deliverState.AddEvent(&event)
 
 		deliverState.Accounts.SetNonce(sender, tx.Nonce)
 
@@ -5,6 +5,7 @@ import (
 	"math/big"
 
 	"github.com/MinterTeam/minter-go-node/coreV2/code"
+	eventsdb "github.com/MinterTeam/minter-go-node/coreV2/events"
 	"github.com/MinterTeam/minter-go-node/coreV2/state"
 	"github.com/MinterTeam/minter-go-node/coreV2/state/commission"
 	"github.com/MinterTeam/minter-go-node/coreV2/state/swap"
@@ -18,6 +19,8 @@ type RemoveLiquidity struct {
 	Liquidity      *big.Int
 	MinimumVolume0 *big.Int
 	MinimumVolume1 *big.Int
+
+	fee uint32
 }
 
 func (data RemoveLiquidity) Gas() int64 {
@@ -46,7 +49,11 @@ func (data RemoveLiquidity) basicCheck(tx *Transaction, context *state.CheckStat
 		}
 	}
 
-	return nil
+	sender, _ := tx.Sender()
+	if errResp := checkCoinRecipient(context, data.Coin0, sender); errResp != nil {
+		return errResp
+	}
+	return checkCoinRecipient(context, data.Coin1, sender)
 }
 
 func (data RemoveLiquidity) String() string {
@@ -79,7 +86,7 @@ func (data RemoveLiquidity) Run(tx *Transaction, context state.Interface, reward
 		return *errResp
 	}
 
-	swapper := checkState.Swap().GetSwapper(data.Coin0, data.Coin1)
+	swapper := checkState.Swap().GetSwapperWithFee(data.Coin0, data.Coin1, poolFee(data.fee))
 	if !swapper.Exists() {
 		return Response{
 			Code: code.PairNotExists,
@@ -88,7 +95,7 @@ func (data RemoveLiquidity) Run(tx *Transaction, context state.Interface, reward
 		}
 	}
 
-	if isGasCommissionFromPoolSwap {
+	if isGasCommissionFromPoolSwap && swapper.Fee() == swap.DefaultFee {
 		if tx.GasCoin == data.Coin0 && data.Coin1.IsBaseCoin() {
 			swapper = swapper.AddLastSwapStep(commission, commissionInBaseCoin)
 		}
@@ -146,12 +153,15 @@ func (data RemoveLiquidity) Run(tx *Transaction, context state.Interface, reward
 		deliverState.Accounts.SubBalance(sender, tx.GasCoin, commission)
 		rewardPool.Add(rewardPool, commissionInBaseCoin)
 
-		amount0, amount1 := deliverState.Swap.PairBurn(data.Coin0, data.Coin1, data.Liquidity, data.MinimumVolume0, data.MinimumVolume1, coinLiquidity.Volume())
+		amount0, amount1 := deliverState.Swap.PairBurnWithFee(data.Coin0, data.Coin1, poolFee(data.fee), data.Liquidity, data.MinimumVolume0, data.MinimumVolume1, coinLiquidity.Volume())
 		deliverState.Accounts.AddBalance(sender, data.Coin0, amount0)
 		deliverState.Accounts.AddBalance(sender, data.Coin1, amount1)
 
 		deliverState.Coins.SubVolume(coinLiquidity.ID(), data.Liquidity)
 		deliverState.Accounts.SubBalance(sender, coinLiquidity.ID(), data.Liquidity)
+		deliverState.Swap.RemoveProviderLiquidity(sender, data.Coin0, data.Coin1, swapper.GetID(), data.Liquidity, amount0, amount1)
+		event := eventsdb.RemoveLiquidityEvent(liquidityEvent(deliverState, sender, data.Coin0, data.Coin1, poolFee(data.fee), data.Liquidity, amount0, amount1))
+		deliverState.AddEvent(&event)
 
 		deliverState.Accounts.SetNonce(sender, tx.Nonce)
 
@@ -173,3 +183,52 @@ func (data RemoveLiquidity) Run(tx *Transaction, context state.Interface, reward
 		Tags: tags,
 	}
 }
+
+// RemoveLiquidityV250 is RemoveLiquidity with the fee tier of the pool in basis points.
+// Fee is optional and can contain at most one value, the default tier is used if it is empty.
+type RemoveLiquidityV250 struct {
+	Coin0          types.CoinID
+	Coin1          types.CoinID
+	Liquidity      *big.Int
+	MinimumVolume0 *big.Int
+	MinimumVolume1 *big.Int
+	Fee            []uint32 `rlp:"tail"`
+}
+
+func (data RemoveLiquidityV250) data() RemoveLiquidity {
+	return RemoveLiquidity{
+		Coin0:          data.Coin0,
+		Coin1:          data.Coin1,
+		Liquidity:      data.Liquidity,
+		MinimumVolume0: data.MinimumVolume0,
+		MinimumVolume1: data.MinimumVolume1,
+		fee:            optionalFee(data.Fee),
+	}
+}
+
+func (data RemoveLiquidityV250) TxType() TxType {
+	return TypeRemoveLiquidity
+}
+
+func (data RemoveLiquidityV250) Gas() int64 {
+	return data.data().Gas()
+}
+
+func (data RemoveLiquidityV250) String() string {
+	return data.data().String()
+}
+
+func (data RemoveLiquidityV250) CommissionData(price *commission.Price) *big.Int {
+	return data.data().CommissionData(price)
+}
+
+func (data RemoveLiquidityV250) Run(tx *Transaction, context state.Interface, rewardPool *big.Int, currentBlock uint64, price *big.Int) Response {
+	if len(data.Fee) > 1 {
+		return Response{
+			Code: code.DecodeError,
+			Log:  "Incorrect tx data",
+			Info: EncodeError(code.NewDecodeError()),
+		}
+	}
+	return data.data().Run(tx, context, rewardPool, currentBlock, price)
+}
@@ -0,0 +1,71 @@
+package transaction
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/MinterTeam/minter-go-node/coreV2/code"
+	"github.com/MinterTeam/minter-go-node/coreV2/types"
+	"github.com/MinterTeam/minter-go-node/helpers"
+)
+
+func TestScheduleTx(t *testing.T) {
+	t.Parallel()
+	cState := getState()
+
+	ownerKey, owner := getAccount()
+	otherKey, other := getAccount()
+	_, recipient := getAccount()
+	cState.Accounts.AddBalance(owner, types.GetBaseCoinID(), helpers.BipToPip(big.NewInt(1000000)))
+	cState.Accounts.AddBalance(other, types.GetBaseCoinID(), helpers.BipToPip(big.NewInt(1000000)))
+
+	value := helpers.BipToPip(big.NewInt(100))
+	create := CreateScheduleData{
+		Recipient:   recipient,
+		Coin:        types.GetBaseCoinID(),
+		Value:       value,
+		StartHeight: 10,
+		Interval:    5,
+		Count:       3,
+	}
+	runTestTx(t, cState, TypeCreateSchedule, create, 1, ownerKey, 10, code.WrongScheduleStartHeight)
+	runTestTx(t, cState, TypeCreateSchedule, CreateScheduleData{Recipient: recipient, Value: value, StartHeight: 10, Count: 3}, 1, ownerKey, 1, code.WrongScheduleInterval)
+	runTestTx(t, cState, TypeCreateSchedule, CreateScheduleData{Recipient: recipient, Value: value, StartHeight: 10, Count: maxScheduleCount + 1}, 1, ownerKey, 1, code.WrongScheduleCount)
+
+	balance := cState.Accounts.GetBalance(owner, types.GetBaseCoinID())
+	runTestTx(t, cState, TypeCreateSchedule, create, 1, ownerKey, 1, code.OK)
+	if diff := big.NewInt(0).Sub(balance, cState.Accounts.GetBalance(owner, types.GetBaseCoinID())); diff.Cmp(helpers.BipToPip(big.NewInt(300))) != 1 {
+		t.Fatalf("Value of all sends is not locked: %s", diff)
+	}
+
+	if due := cState.Schedules.GetDue(10); len(due) != 1 || due[0] != 1 {
+		t.Fatalf("Schedule is not due at start height: %v", due)
+	}
+
+	// executed at the beginning of the block by the blockchain
+	schedule := cState.Schedules.Pay(1)
+	cState.Accounts.AddBalance(schedule.Recipient, schedule.Coin, schedule.Value)
+	cState.Schedules.DeleteDue(10)
+	if err := checkState(cState); err != nil {
+		t.Fatal(err)
+	}
+
+	runTestTx(t, cState, TypeCancelSchedule, CancelScheduleData{Schedule: 2}, 2, ownerKey, 11, code.ScheduleNotExists)
+	runTestTx(t, cState, TypeCancelSchedule, CancelScheduleData{Schedule: 1}, 1, otherKey, 11, code.IsNotOwnerOfSchedule)
+
+	balance = cState.Accounts.GetBalance(owner, types.GetBaseCoinID())
+	runTestTx(t, cState, TypeCancelSchedule, CancelScheduleData{Schedule: 1}, 2, ownerKey, 11, code.OK)
+	if diff := big.NewInt(0).Sub(cState.Accounts.GetBalance(owner, types.GetBaseCoinID()), balance); diff.Sign() != 1 || diff.Cmp(helpers.BipToPip(big.NewInt(200))) != -1 {
+		t.Fatalf("Owner did not get the rest back: %s", diff)
+	}
+	if balance := cState.Accounts.GetBalance(recipient, types.GetBaseCoinID()); balance.Cmp(value) != 0 {
+		t.Fatalf("Recipient balance is not correct: %s", balance)
+	}
+	if cState.Schedules.GetSchedule(1) != nil || len(cState.Schedules.GetDue(15)) != 0 {
+		t.Fatal("Schedule is not cancelled")
+	}
+
+	if err := checkState(cState); err != nil {
+		t.Error(err)
+	}
+}
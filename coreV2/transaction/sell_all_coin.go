@@ -193,6 +193,8 @@ func (data SellAllCoinData) Run(tx *Transaction, context state.Interface, reward
 			deliverState.Coins.AddVolume(data.CoinToBuy, value)
 			deliverState.Coins.AddReserve(data.CoinToBuy, diffBipReserve)
 		}
+		addBancorTradeEvent(deliverState, sender, data.CoinToSell, true, valueToSell, diffBipReserve)
+		addBancorTradeEvent(deliverState, sender, data.CoinToBuy, false, value, diffBipReserve)
 		deliverState.Accounts.SetNonce(sender, tx.Nonce)
 
 		tags = []abcTypes.EventAttribute{
@@ -38,6 +38,8 @@ type tagPoolChange struct {
 type SellAllSwapPoolData struct {
 	Coins             []types.CoinID
 	MinimumValueToBuy *big.Int
+
+	fees []uint32
 }
 
 type dataCommission interface {
@@ -81,8 +83,12 @@ func (data SellAllSwapPoolData) basicCheck(tx *Transaction, context *state.Check
 			Info: EncodeError(code.NewCustomCode(code.TooLongSwapRoute)),
 		}
 	}
+	if response := checkSwapRouteFees(data.Coins, data.fees); response != nil {
+		return response
+	}
+	fees := swapRouteFees(data.fees, len(data.Coins)-1)
 	coin0 := data.Coins[0]
-	for _, coin1 := range data.Coins[1:] {
+	for i, coin1 := range data.Coins[1:] {
 		if coin0 == coin1 {
 			return &Response{
 				Code: code.CrossConvert,
@@ -92,7 +98,7 @@ func (data SellAllSwapPoolData) basicCheck(tx *Transaction, context *state.Check
 					coin1.String(), "")),
 			}
 		}
-		if !context.Swap().SwapPoolExist(coin0, coin1) {
+		if !swapPoolExistWithFee(context, coin0, coin1, fees[i]) {
 			return &Response{
 				Code: code.PairNotExists,
 				Log:  fmt.Sprint("swap pool not exists"),
@@ -101,7 +107,9 @@ func (data SellAllSwapPoolData) basicCheck(tx *Transaction, context *state.Check
 		}
 		coin0 = coin1
 	}
-	return nil
+
+	sender, _ := tx.Sender()
+	return checkSwapRouteControls(context, data.Coins, sender)
 }
 
 func (data SellAllSwapPoolData) String() string {
@@ -148,6 +156,7 @@ func (data SellAllSwapPoolData) Run(tx *Transaction, context state.Interface, re
 		}
 	}
 	lastIteration := len(data.Coins[1:]) - 1
+	fees := swapRouteFees(data.fees, len(data.Coins)-1)
 	{
 		checkDuplicatePools := map[uint32]struct{}{}
 		coinToSell := data.Coins[0]
@@ -155,7 +164,8 @@ func (data SellAllSwapPoolData) Run(tx *Transaction, context state.Interface, re
 		valueToSell := big.NewInt(0).Set(balance)
 		valueToBuy := big.NewInt(0)
 		for i, coinToBuy := range data.Coins[1:] {
-			swapper := checkState.Swap().GetSwapper(coinToSell, coinToBuy)
+			swapper := routeSwapper(checkState, coinToSell, coinToBuy, fees[i], valueToSell, false)
+			fees[i] = swapper.Fee()
 			if _, ok := checkDuplicatePools[swapper.GetID()]; ok {
 				return Response{
 					Code: code.DuplicatePoolInRoute,
@@ -164,7 +174,7 @@ func (data SellAllSwapPoolData) Run(tx *Transaction, context state.Interface, re
 				}
 			}
 			checkDuplicatePools[swapper.GetID()] = struct{}{}
-			if isGasCommissionFromPoolSwap == true && coinToBuy.IsBaseCoin() {
+			if isGasCommissionFromPoolSwap == true && coinToBuy.IsBaseCoin() && swapper.Fee() == swap.DefaultFee {
 				swapper = commissionPoolSwapper.AddLastSwapStep(commission, commissionInBaseCoin)
 			}
 
@@ -209,7 +219,7 @@ func (data SellAllSwapPoolData) Run(tx *Transaction, context state.Interface, re
 		var poolIDs tagPoolsChange
 
 		for i, coinToBuy := range data.Coins[1:] {
-			amountIn, amountOut, poolID := deliverState.Swap.PairSell(coinToSell, coinToBuy, valueToSell, big.NewInt(0))
+			amountIn, amountOut, poolID := deliverState.Swap.PairSellWithFee(coinToSell, coinToBuy, fees[i], valueToSell, big.NewInt(0))
 
 			poolIDs = append(poolIDs, &tagPoolChange{
 				PoolID:   poolID,
@@ -370,3 +380,46 @@ func commissionFromReserve(gasCoin CalculateCoin, commissionInBaseCoin *big.Int)
 
 	return formula.CalculateSaleAmount(gasCoin.Volume(), gasCoin.Reserve(), gasCoin.Crr(), commissionInBaseCoin), nil
 }
+
+// SellAllSwapPoolDataV250 is SellAllSwapPoolData with fee tiers of pools for each step of the route.
+// Zero fee selects the pool of the pair with the best price, pools of the default tier are used if Fees are empty.
+type SellAllSwapPoolDataV250 struct {
+	Coins             []types.CoinID
+	MinimumValueToBuy *big.Int
+	Fees              []uint32 `rlp:"tail"`
+}
+
+func (data SellAllSwapPoolDataV250) data() SellAllSwapPoolData {
+	return SellAllSwapPoolData{
+		Coins:             data.Coins,
+		MinimumValueToBuy: data.MinimumValueToBuy,
+		fees:              data.Fees,
+	}
+}
+
+func (data *SellAllSwapPoolDataV250) commissionCoin() types.CoinID {
+	if len(data.Coins) == 0 {
+		return 0
+	}
+	return data.Coins[0]
+}
+
+func (data SellAllSwapPoolDataV250) TxType() TxType {
+	return TypeSellAllSwapPool
+}
+
+func (data SellAllSwapPoolDataV250) Gas() int64 {
+	return data.data().Gas()
+}
+
+func (data SellAllSwapPoolDataV250) String() string {
+	return data.data().String()
+}
+
+func (data SellAllSwapPoolDataV250) CommissionData(price *commission.Price) *big.Int {
+	return data.data().CommissionData(price)
+}
+
+func (data SellAllSwapPoolDataV250) Run(tx *Transaction, context state.Interface, rewardPool *big.Int, currentBlock uint64, price *big.Int) Response {
+	return data.data().Run(tx, context, rewardPool, currentBlock, price)
+}
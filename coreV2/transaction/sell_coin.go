@@ -216,6 +216,8 @@ func (data SellCoinData) Run(tx *Transaction, context state.Interface, rewardPoo
 			deliverState.Coins.AddVolume(data.CoinToBuy, value)
 			deliverState.Coins.AddReserve(data.CoinToBuy, diffBipReserve)
 		}
+		addBancorTradeEvent(deliverState, sender, data.CoinToSell, true, data.ValueToSell, diffBipReserve)
+		addBancorTradeEvent(deliverState, sender, data.CoinToBuy, false, value, diffBipReserve)
 		deliverState.Accounts.SetNonce(sender, tx.Nonce)
 
 		tags = []abcTypes.EventAttribute{
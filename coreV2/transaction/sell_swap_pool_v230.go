@@ -7,6 +7,7 @@ import (
 	"github.com/MinterTeam/minter-go-node/coreV2/code"
 	"github.com/MinterTeam/minter-go-node/coreV2/state"
 	"github.com/MinterTeam/minter-go-node/coreV2/state/commission"
+	"github.com/MinterTeam/minter-go-node/coreV2/state/swap"
 	"github.com/MinterTeam/minter-go-node/coreV2/types"
 	abcTypes "github.com/tendermint/tendermint/abci/types"
 )
@@ -15,6 +16,8 @@ type SellSwapPoolData struct {
 	Coins             []types.CoinID
 	ValueToSell       *big.Int
 	MinimumValueToBuy *big.Int
+
+	fees []uint32
 }
 
 func (data SellSwapPoolData) TxType() TxType {
@@ -40,8 +43,12 @@ func (data SellSwapPoolData) basicCheck(tx *Transaction, context *state.CheckSta
 			Info: EncodeError(code.NewCustomCode(code.TooLongSwapRoute)),
 		}
 	}
+	if response := checkSwapRouteFees(data.Coins, data.fees); response != nil {
+		return response
+	}
+	fees := swapRouteFees(data.fees, len(data.Coins)-1)
 	coin0 := data.Coins[0]
-	for _, coin1 := range data.Coins[1:] {
+	for i, coin1 := range data.Coins[1:] {
 		if coin0 == coin1 {
 			return &Response{
 				Code: code.CrossConvert,
@@ -51,7 +58,7 @@ func (data SellSwapPoolData) basicCheck(tx *Transaction, context *state.CheckSta
 					coin1.String(), "")),
 			}
 		}
-		if !context.Swap().SwapPoolExist(coin0, coin1) {
+		if !swapPoolExistWithFee(context, coin0, coin1, fees[i]) {
 			return &Response{
 				Code: code.PairNotExists,
 				Log:  fmt.Sprint("swap pool not exists"),
@@ -61,7 +68,8 @@ func (data SellSwapPoolData) basicCheck(tx *Transaction, context *state.CheckSta
 		coin0 = coin1
 	}
 
-	return nil
+	sender, _ := tx.Sender()
+	return checkSwapRouteControls(context, data.Coins, sender)
 }
 
 func (data SellSwapPoolData) String() string {
@@ -95,6 +103,7 @@ func (data SellSwapPoolData) Run(tx *Transaction, context state.Interface, rewar
 	}
 
 	lastIteration := len(data.Coins[1:]) - 1
+	fees := swapRouteFees(data.fees, len(data.Coins)-1)
 	{
 		checkDuplicatePools := map[uint32]struct{}{}
 		coinToSell := data.Coins[0]
@@ -102,7 +111,8 @@ func (data SellSwapPoolData) Run(tx *Transaction, context state.Interface, rewar
 		valueToSell := data.ValueToSell
 		valueToBuy := big.NewInt(0)
 		for i, coinToBuy := range data.Coins[1:] {
-			swapper := checkState.Swap().GetSwapper(coinToSell, coinToBuy)
+			swapper := routeSwapper(checkState, coinToSell, coinToBuy, fees[i], valueToSell, false)
+			fees[i] = swapper.Fee()
 			if _, ok := checkDuplicatePools[swapper.GetID()]; ok {
 				return Response{
 					Code: code.DuplicatePoolInRoute,
@@ -111,7 +121,7 @@ func (data SellSwapPoolData) Run(tx *Transaction, context state.Interface, rewar
 				}
 			}
 			checkDuplicatePools[swapper.GetID()] = struct{}{}
-			if isGasCommissionFromPoolSwap {
+			if isGasCommissionFromPoolSwap && swapper.Fee() == swap.DefaultFee {
 				if tx.GasCoin == coinToSell && coinToBuy.IsBaseCoin() {
 					swapper = swapper.AddLastSwapStep(commission, commissionInBaseCoin)
 				}
@@ -184,7 +194,7 @@ func (data SellSwapPoolData) Run(tx *Transaction, context state.Interface, rewar
 		var poolIDs tagPoolsChange
 
 		for i, coinToBuy := range data.Coins[1:] {
-			amountIn, amountOut, poolID := deliverState.Swap.PairSell(coinToSell, coinToBuy, valueToSell, big.NewInt(0))
+			amountIn, amountOut, poolID := deliverState.Swap.PairSellWithFee(coinToSell, coinToBuy, fees[i], valueToSell, big.NewInt(0))
 
 			poolIDs = append(poolIDs, &tagPoolChange{
 				PoolID:   poolID,
@@ -225,3 +235,41 @@ func (data SellSwapPoolData) Run(tx *Transaction, context state.Interface, rewar
 		Tags: tags,
 	}
 }
+
+// SellSwapPoolDataV250 is SellSwapPoolData with fee tiers of pools for each step of the route.
+// Zero fee selects the pool of the pair with the best price, pools of the default tier are used if Fees are empty.
+type SellSwapPoolDataV250 struct {
+	Coins             []types.CoinID
+	ValueToSell       *big.Int
+	MinimumValueToBuy *big.Int
+	Fees              []uint32 `rlp:"tail"`
+}
+
+func (data SellSwapPoolDataV250) data() SellSwapPoolData {
+	return SellSwapPoolData{
+		Coins:             data.Coins,
+		ValueToSell:       data.ValueToSell,
+		MinimumValueToBuy: data.MinimumValueToBuy,
+		fees:              data.Fees,
+	}
+}
+
+func (data SellSwapPoolDataV250) TxType() TxType {
+	return TypeSellSwapPool
+}
+
+func (data SellSwapPoolDataV250) Gas() int64 {
+	return data.data().Gas()
+}
+
+func (data SellSwapPoolDataV250) String() string {
+	return data.data().String()
+}
+
+func (data SellSwapPoolDataV250) CommissionData(price *commission.Price) *big.Int {
+	return data.data().CommissionData(price)
+}
+
+func (data SellSwapPoolDataV250) Run(tx *Transaction, context state.Interface, rewardPool *big.Int, currentBlock uint64, price *big.Int) Response {
+	return data.data().Run(tx, context, rewardPool, currentBlock, price)
+}
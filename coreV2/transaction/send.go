@@ -39,6 +39,14 @@ func (data SendData) basicCheck(tx *Transaction, context *state.CheckState) *Res
 		}
 	}
 
+	sender, _ := tx.Sender()
+	if errResp := checkCoinSpender(context, data.Coin, sender); errResp != nil {
+		return errResp
+	}
+	if errResp := checkCoinRecipient(context, data.Coin, data.To); errResp != nil {
+		return errResp
+	}
+
 	return nil
 }
 
@@ -0,0 +1,107 @@
+package transaction
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/MinterTeam/minter-go-node/coreV2/code"
+	"github.com/MinterTeam/minter-go-node/coreV2/state"
+	"github.com/MinterTeam/minter-go-node/coreV2/state/commission"
+	"github.com/MinterTeam/minter-go-node/coreV2/types"
+	abcTypes "github.com/tendermint/tendermint/abci/types"
+)
+
+// SetAccountKeyData authorises the key of the signer address to sign transactions of the sender instead of its current key,
+// so the leaked key can be replaced without moving balances, stakes and ownerships to a new address.
+// The own address of the sender as the signer restores its own key.
+// Rotated keys do not sign checks and multisig votes of the account, such multisigs should be edited.
+type SetAccountKeyData struct {
+	Signer types.Address
+}
+
+func (data SetAccountKeyData) Gas() int64 {
+	return gasSetAccountKey
+}
+func (data SetAccountKeyData) TxType() TxType {
+	return TypeSetAccountKey
+}
+
+func (data SetAccountKeyData) basicCheck(tx *Transaction, context *state.CheckState) *Response {
+	sender, _ := tx.Sender()
+	if data.Signer == (types.Address{}) || context.Accounts().GetAccount(sender).IsMultisig() || context.Accounts().GetAccount(data.Signer).IsMultisig() {
+		return &Response{
+			Code: code.WrongAccountSigner,
+			Log:  "Signer should be regular address and can not be set for multisig",
+			Info: EncodeError(code.NewWrongAccountSigner(sender.String(), data.Signer.String())),
+		}
+	}
+
+	return nil
+}
+
+func (data SetAccountKeyData) String() string {
+	return fmt.Sprintf("SET ACCOUNT KEY signer:%s", data.Signer.String())
+}
+
+func (data SetAccountKeyData) CommissionData(price *commission.Price) *big.Int {
+	return price.EditMultisig
+}
+
+func (data SetAccountKeyData) Run(tx *Transaction, context state.Interface, rewardPool *big.Int, currentBlock uint64, price *big.Int) Response {
+	sender, _ := tx.Sender()
+
+	var checkState *state.CheckState
+	var isCheck bool
+	if checkState, isCheck = context.(*state.CheckState); !isCheck {
+		checkState = state.NewCheckState(context.(*state.State))
+	}
+
+	response := data.basicCheck(tx, checkState)
+	if response != nil {
+		return *response
+	}
+
+	commissionInBaseCoin := tx.Commission(price)
+	commissionPoolSwapper := checkState.Swap().GetSwapper(tx.GasCoin, types.GetBaseCoinID())
+	gasCoin := checkState.Coins().GetCoin(tx.GasCoin)
+	commission, isGasCommissionFromPoolSwap, errResp := CalculateCommission(checkState, commissionPoolSwapper, gasCoin, commissionInBaseCoin)
+	if errResp != nil {
+		return *errResp
+	}
+
+	if checkState.Accounts().GetBalance(sender, tx.GasCoin).Cmp(commission) < 0 {
+		return Response{
+			Code: code.InsufficientFunds,
+			Log:  fmt.Sprintf("Insufficient funds for sender account: %s. Wanted %s %s", sender.String(), commission.String(), gasCoin.GetFullSymbol()),
+			Info: EncodeError(code.NewInsufficientFunds(sender.String(), commission.String(), gasCoin.GetFullSymbol(), gasCoin.ID().String())),
+		}
+	}
+
+	var tags []abcTypes.EventAttribute
+	if deliverState, ok := context.(*state.State); ok {
+		if isGasCommissionFromPoolSwap {
+			commission, commissionInBaseCoin, _ = deliverState.Swap.PairSell(tx.GasCoin, types.GetBaseCoinID(), commission, commissionInBaseCoin)
+		} else if !tx.GasCoin.IsBaseCoin() {
+			deliverState.Coins.SubVolume(tx.GasCoin, commission)
+			deliverState.Coins.SubReserve(tx.GasCoin, commissionInBaseCoin)
+		}
+		deliverState.Accounts.SubBalance(sender, tx.GasCoin, commission)
+		rewardPool.Add(rewardPool, commissionInBaseCoin)
+
+		deliverState.Accounts.SetSigner(sender, data.Signer)
+		deliverState.Accounts.SetNonce(sender, tx.Nonce)
+
+		tags = []abcTypes.EventAttribute{
+			{Key: []byte("tx.commission_in_base_coin"), Value: []byte(commissionInBaseCoin.String())},
+			{Key: []byte("tx.commission_conversion"), Value: []byte(isGasCommissionFromPoolSwap.String()), Index: true},
+			{Key: []byte("tx.commission_amount"), Value: []byte(commission.String())},
+			{Key: []byte("tx.signer"), Value: []byte(hex.EncodeToString(data.Signer[:])), Index: true},
+		}
+	}
+
+	return Response{
+		Code: code.OK,
+		Tags: tags,
+	}
+}
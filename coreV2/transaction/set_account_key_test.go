@@ -0,0 +1,263 @@
+package transaction
+
+import (
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"math/big"
+	"sync"
+	"testing"
+
+	c "github.com/MinterTeam/minter-go-node/coreV2/check"
+	"github.com/MinterTeam/minter-go-node/coreV2/code"
+	"github.com/MinterTeam/minter-go-node/coreV2/types"
+	"github.com/MinterTeam/minter-go-node/crypto"
+	"github.com/MinterTeam/minter-go-node/helpers"
+	"github.com/MinterTeam/minter-go-node/rlp"
+	"golang.org/x/crypto/sha3"
+)
+
+func makeTestAccountTx(txType TxType, data interface{}, nonce uint64, account types.Address, privateKey *ecdsa.PrivateKey) ([]byte, error) {
+	encodedData, err := rlp.EncodeToBytes(data)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := Transaction{
+		Nonce:         nonce,
+		GasPrice:      1,
+		ChainID:       types.CurrentChainID,
+		GasCoin:       types.GetBaseCoinID(),
+		Type:          txType,
+		Data:          encodedData,
+		SignatureType: SigTypeAccount,
+	}
+
+	if err := tx.SignAccount(account, privateKey); err != nil {
+		return nil, err
+	}
+
+	return rlp.EncodeToBytes(tx)
+}
+
+func TestSetAccountKeyTx(t *testing.T) {
+	t.Parallel()
+	cState := getState()
+
+	ownKey, account := getAccount()
+	newKey, signer := getAccount()
+	otherKey, _ := getAccount()
+	cState.Accounts.AddBalance(account, types.GetBaseCoinID(), helpers.BipToPip(big.NewInt(1000000)))
+
+	run := func(tx []byte) Response {
+		return NewExecutor(GetDataV250).RunTx(cState, tx, big.NewInt(0), 0, &sync.Map{}, 0, false)
+	}
+
+	tx, err := makeTestSwapPoolTx(TypeSetAccountKey, SetAccountKeyData{Signer: signer}, 1, ownKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if response := run(tx); response.Code != code.OK {
+		t.Fatalf("Response code %d is not 0. Error: %s", response.Code, response.Log)
+	}
+
+	to := types.Address{1}
+	send := SendData{
+		Coin:  types.GetBaseCoinID(),
+		To:    to,
+		Value: helpers.BipToPip(big.NewInt(10)),
+	}
+
+	tx, err = makeTestSwapPoolTx(TypeSend, send, 2, ownKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if response := run(tx); response.Code != code.AccountKeyRotated {
+		t.Fatalf("Response code %d is not %d. Error: %s", response.Code, code.AccountKeyRotated, response.Log)
+	}
+
+	tx, err = makeTestAccountTx(TypeSend, send, 2, account, otherKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if response := run(tx); response.Code != code.SignerIsNotAuthorised {
+		t.Fatalf("Response code %d is not %d. Error: %s", response.Code, code.SignerIsNotAuthorised, response.Log)
+	}
+
+	tx, err = makeTestAccountTx(TypeSend, send, 2, account, newKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if response := NewExecutor(GetData).RunTx(cState, tx, big.NewInt(0), 0, &sync.Map{}, 0, false); response.Code != code.DecodeError {
+		t.Fatalf("Response code %d is not %d. Error: %s", response.Code, code.DecodeError, response.Log)
+	}
+	if response := run(tx); response.Code != code.OK {
+		t.Fatalf("Response code %d is not 0. Error: %s", response.Code, response.Log)
+	}
+	if balance := cState.Accounts.GetBalance(to, types.GetBaseCoinID()); balance.Cmp(send.Value) != 0 {
+		t.Fatalf("Target %s balance is not correct. Expected %s, got %s", to.String(), send.Value, balance)
+	}
+
+	if err := checkState(cState); err != nil {
+		t.Error(err)
+	}
+
+	tx, err = makeTestAccountTx(TypeSetAccountKey, SetAccountKeyData{Signer: account}, 3, account, newKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if response := run(tx); response.Code != code.OK {
+		t.Fatalf("Response code %d is not 0. Error: %s", response.Code, response.Log)
+	}
+
+	tx, err = makeTestSwapPoolTx(TypeSend, send, 4, ownKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if response := run(tx); response.Code != code.OK {
+		t.Fatalf("Response code %d is not 0. Error: %s", response.Code, response.Log)
+	}
+
+	if err := checkState(cState); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSetAccountKeyTx_Multisig(t *testing.T) {
+	t.Parallel()
+	cState := getState()
+
+	ownKey, member := getAccount()
+	newKey, signer := getAccount()
+	otherMemberKey, otherMember := getAccount()
+	cState.Accounts.SetSigner(member, signer)
+
+	multisig := cState.Accounts.CreateMultisig([]uint32{1, 1}, []types.Address{member, otherMember}, 2, types.Address{1})
+	cState.Accounts.AddBalance(multisig, types.GetBaseCoinID(), helpers.BipToPip(big.NewInt(1000000)))
+
+	send := SendData{
+		Coin:  types.GetBaseCoinID(),
+		To:    types.Address{2},
+		Value: helpers.BipToPip(big.NewInt(10)),
+	}
+	encodedData, err := rlp.EncodeToBytes(send)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	run := func(keys ...*ecdsa.PrivateKey) Response {
+		tx := Transaction{
+			Nonce:         1,
+			GasPrice:      1,
+			ChainID:       types.CurrentChainID,
+			GasCoin:       types.GetBaseCoinID(),
+			Type:          TypeSend,
+			Data:          encodedData,
+			SignatureType: SigTypeMulti,
+		}
+		tx.SetMultisigAddress(multisig)
+		for _, key := range keys {
+			if err := tx.Sign(key); err != nil {
+				t.Fatal(err)
+			}
+		}
+		encodedTx, err := rlp.EncodeToBytes(tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return NewExecutor(GetDataV250).RunTx(cState, encodedTx, big.NewInt(0), 0, &sync.Map{}, 0, false)
+	}
+
+	if response := run(ownKey, otherMemberKey); response.Code != code.AccountKeyRotated {
+		t.Fatalf("Response code %d is not %d. Error: %s", response.Code, code.AccountKeyRotated, response.Log)
+	}
+	if response := run(newKey, otherMemberKey); response.Code != code.OK {
+		t.Fatalf("Response code %d is not 0. Error: %s", response.Code, response.Log)
+	}
+
+	if err := checkState(cState); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSetAccountKeyTx_Check(t *testing.T) {
+	t.Parallel()
+	cState := getState()
+
+	ownKey, issuer := getAccount()
+	newKey, signer := getAccount()
+	receiverKey, receiver := getAccount()
+	cState.Accounts.AddBalance(issuer, types.GetBaseCoinID(), helpers.BipToPip(big.NewInt(1000000)))
+	cState.Accounts.SetSigner(issuer, signer)
+
+	passphraseHash := sha256.Sum256([]byte("password"))
+	passphraseKey, err := crypto.ToECDSA(passphraseHash[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkValue := helpers.BipToPip(big.NewInt(10))
+
+	redeem := func(getData func(TxType) (Data, bool), nonce uint64, sign func(check *c.Check) error) Response {
+		check := c.Check{
+			Nonce:    []byte{byte(nonce)},
+			ChainID:  types.CurrentChainID,
+			DueBlock: 1,
+			Coin:     types.GetBaseCoinID(),
+			Value:    checkValue,
+			GasCoin:  types.GetBaseCoinID(),
+		}
+		lock, err := crypto.Sign(check.HashWithoutLock().Bytes(), passphraseKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		check.Lock = big.NewInt(0).SetBytes(lock)
+		if err := sign(&check); err != nil {
+			t.Fatal(err)
+		}
+		rawCheck, err := rlp.EncodeToBytes(check)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var receiverHash types.Hash
+		hw := sha3.NewLegacyKeccak256()
+		_ = rlp.Encode(hw, []interface{}{receiver})
+		hw.Sum(receiverHash[:0])
+		sig, err := crypto.Sign(receiverHash.Bytes(), passphraseKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var proof [65]byte
+		copy(proof[:], sig)
+
+		tx, err := makeTestSwapPoolTx(TypeRedeemCheck, RedeemCheckData{RawCheck: rawCheck, Proof: proof}, nonce, receiverKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return NewExecutor(getData).RunTx(cState, tx, big.NewInt(0), 0, &sync.Map{}, 0, false)
+	}
+
+	signOwn := func(check *c.Check) error { return check.Sign(ownKey) }
+	signAuthorised := func(check *c.Check) error { return check.SignAccount(issuer, newKey) }
+	signOther := func(check *c.Check) error { return check.SignAccount(issuer, receiverKey) }
+
+	if response := redeem(GetDataV250, 1, signOwn); response.Code != code.AccountKeyRotated {
+		t.Fatalf("Response code %d is not %d. Error: %s", response.Code, code.AccountKeyRotated, response.Log)
+	}
+	if response := redeem(GetDataV250, 1, signOther); response.Code != code.SignerIsNotAuthorised {
+		t.Fatalf("Response code %d is not %d. Error: %s", response.Code, code.SignerIsNotAuthorised, response.Log)
+	}
+	if response := redeem(GetData, 1, signAuthorised); response.Code != code.DecodeError {
+		t.Fatalf("Response code %d is not %d. Error: %s", response.Code, code.DecodeError, response.Log)
+	}
+	if response := redeem(GetDataV250, 1, signAuthorised); response.Code != code.OK {
+		t.Fatalf("Response code %d is not 0. Error: %s", response.Code, response.Log)
+	}
+
+	if balance := cState.Accounts.GetBalance(receiver, types.GetBaseCoinID()); balance.Cmp(checkValue) != 0 {
+		t.Fatalf("Receiver balance is not correct. Expected %s, got %s", checkValue, balance)
+	}
+
+	if err := checkState(cState); err != nil {
+		t.Error(err)
+	}
+}
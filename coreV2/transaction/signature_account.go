@@ -0,0 +1,99 @@
+package transaction
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/MinterTeam/minter-go-node/coreV2/code"
+	"github.com/MinterTeam/minter-go-node/coreV2/state"
+	"github.com/MinterTeam/minter-go-node/coreV2/types"
+	"github.com/MinterTeam/minter-go-node/crypto"
+	"github.com/MinterTeam/minter-go-node/rlp"
+)
+
+// SignatureAccount is a signature of the key authorised by SetAccountKey transaction to sign transactions of the account
+type SignatureAccount struct {
+	Account types.Address
+	V       *big.Int
+	R       *big.Int
+	S       *big.Int
+}
+
+// accountHash is signed by the authorised key, it commits to the account,
+// so the signature can not be replayed for another account with the same signer
+func (tx *Transaction) accountHash(account types.Address) types.Hash {
+	return rlpHash([]interface{}{tx.Hash(), account})
+}
+
+// SignAccount signs the transaction of the account with the key authorised by SetAccountKey transaction
+func (tx *Transaction) SignAccount(account types.Address, prv *ecdsa.PrivateKey) error {
+	h := tx.accountHash(account)
+	sig, err := crypto.Sign(h[:], prv)
+	if err != nil {
+		return err
+	}
+
+	tx.accountSig = &SignatureAccount{
+		Account: account,
+		R:       new(big.Int).SetBytes(sig[:32]),
+		S:       new(big.Int).SetBytes(sig[32:64]),
+		V:       new(big.Int).SetBytes([]byte{sig[64] + 27}),
+	}
+
+	data, err := rlp.EncodeToBytes(tx.accountSig)
+	if err != nil {
+		return err
+	}
+
+	tx.SignatureData = data
+	return nil
+}
+
+// Signer returns the address of the key which signed single signature or account signature transaction
+func (tx *Transaction) Signer() (types.Address, error) {
+	switch tx.SignatureType {
+	case SigTypeSingle:
+		return tx.Sender()
+	case SigTypeAccount:
+		return RecoverPlain(tx.accountHash(tx.accountSig.Account), tx.accountSig.R, tx.accountSig.S, tx.accountSig.V)
+	}
+
+	return types.Address{}, errors.New("signer is defined only for single and account signatures")
+}
+
+// checkAccountSigner verifies that the transaction is signed by the key which is authorised to sign transactions of the sender
+func checkAccountSigner(context *state.CheckState, tx *Transaction) *Response {
+	sender, _ := tx.Sender()
+	signer, err := tx.Signer()
+	if err != nil {
+		return &Response{
+			Code: code.DecodeError,
+			Log:  err.Error(),
+			Info: EncodeError(code.NewDecodeError()),
+		}
+	}
+
+	authorised := context.Accounts().GetSigner(sender)
+	if tx.SignatureType == SigTypeSingle {
+		if authorised != nil {
+			return &Response{
+				Code: code.AccountKeyRotated,
+				Log:  fmt.Sprintf("Key of account %s is rotated, transactions should be signed by %s", sender.String(), authorised.String()),
+				Info: EncodeError(code.NewAccountKeyRotated(sender.String(), authorised.String())),
+			}
+		}
+		return nil
+	}
+
+	if authorised == nil || *authorised != signer {
+		return &Response{
+			Code: code.SignerIsNotAuthorised,
+			Log:  fmt.Sprintf("Signer %s is not authorised to sign transactions of account %s", signer.String(), sender.String()),
+			Info: EncodeError(code.NewSignerIsNotAuthorised(sender.String(), signer.String())),
+		}
+	}
+
+	return nil
+}
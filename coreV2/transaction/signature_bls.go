@@ -0,0 +1,111 @@
+package transaction
+
+import (
+	"fmt"
+
+	"github.com/MinterTeam/minter-go-node/coreV2/code"
+	"github.com/MinterTeam/minter-go-node/coreV2/state"
+	"github.com/MinterTeam/minter-go-node/coreV2/types"
+	"github.com/MinterTeam/minter-go-node/crypto/bls"
+	"github.com/MinterTeam/minter-go-node/rlp"
+)
+
+// SignatureBLS is one BLS signature aggregated from signatures of multisig members.
+// Signers is a bitmap over addresses of the multisig, bit i of byte i/8 is set if the member i signed.
+type SignatureBLS struct {
+	Multisig  types.Address
+	Signers   []byte
+	Signature []byte
+}
+
+// SetSignatureBLS sets the aggregated signature of members of the multisig with given indexes
+func (tx *Transaction) SetSignatureBLS(multisig types.Address, signers []int, signature []byte) {
+	bitmap := make([]byte, 0)
+	for _, i := range signers {
+		for len(bitmap) <= i/8 {
+			bitmap = append(bitmap, 0)
+		}
+		bitmap[i/8] |= 1 << (i % 8)
+	}
+
+	tx.blsSig = &SignatureBLS{
+		Multisig:  multisig,
+		Signers:   bitmap,
+		Signature: signature,
+	}
+
+	data, err := rlp.EncodeToBytes(tx.blsSig)
+	if err != nil {
+		panic(err)
+	}
+
+	tx.SignatureData = data
+}
+
+// checkSignatureBLS verifies the aggregated signature against registered keys and weights of the multisig members
+func checkSignatureBLS(context *state.CheckState, tx *Transaction) *Response {
+	multisig := context.Accounts().GetAccount(tx.blsSig.Multisig)
+	if !multisig.IsMultisig() {
+		return &Response{
+			Code: code.MultisigNotExists,
+			Log:  "Multisig does not exists",
+			Info: EncodeError(code.NewMultisigNotExists(tx.blsSig.Multisig.String())),
+		}
+	}
+
+	multisigData := multisig.Multisig()
+	if len(tx.blsSig.Signers) > (len(multisigData.Addresses)+7)/8 {
+		return &Response{
+			Code: code.IncorrectMultiSignature,
+			Log:  "Incorrect multi-signature",
+			Info: EncodeError(code.NewIncorrectMultiSignature()),
+		}
+	}
+
+	var totalWeight uint32
+	var publicKeys [][]byte
+	for i := 0; i < len(tx.blsSig.Signers)*8; i++ {
+		if tx.blsSig.Signers[i/8]&(1<<(i%8)) == 0 {
+			continue
+		}
+		if i >= len(multisigData.Addresses) {
+			return &Response{
+				Code: code.IncorrectMultiSignature,
+				Log:  "Incorrect multi-signature",
+				Info: EncodeError(code.NewIncorrectMultiSignature()),
+			}
+		}
+
+		signer := multisigData.Addresses[i]
+		publicKey := context.BLSKeys().GetKey(signer)
+		if publicKey == nil {
+			return &Response{
+				Code: code.BLSKeyNotRegistered,
+				Log:  fmt.Sprintf("BLS key of %s is not registered", signer.String()),
+				Info: EncodeError(code.NewBLSKeyNotRegistered(signer.String())),
+			}
+		}
+
+		publicKeys = append(publicKeys, publicKey)
+		totalWeight += multisigData.Weights[i]
+	}
+
+	txHash := tx.Hash()
+	if !bls.Verify(publicKeys, txHash[:], tx.blsSig.Signature) {
+		return &Response{
+			Code: code.IncorrectMultiSignature,
+			Log:  "Incorrect multi-signature",
+			Info: EncodeError(code.NewIncorrectMultiSignature()),
+		}
+	}
+
+	if totalWeight < multisigData.Threshold {
+		return &Response{
+			Code: code.NotEnoughMultisigVotes,
+			Log:  fmt.Sprintf("Not enough multisig votes. Needed %d, has %d", multisigData.Threshold, totalWeight),
+			Info: EncodeError(code.NewNotEnoughMultisigVotes(fmt.Sprintf("%d", multisigData.Threshold), fmt.Sprintf("%d", totalWeight))),
+		}
+	}
+
+	return nil
+}
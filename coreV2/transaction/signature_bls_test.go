@@ -0,0 +1,149 @@
+package transaction
+
+import (
+	"crypto/rand"
+	"math/big"
+	"sync"
+	"testing"
+
+	"github.com/MinterTeam/minter-go-node/coreV2/code"
+	"github.com/MinterTeam/minter-go-node/coreV2/state/accounts"
+	"github.com/MinterTeam/minter-go-node/coreV2/types"
+	"github.com/MinterTeam/minter-go-node/crypto/bls"
+	"github.com/MinterTeam/minter-go-node/helpers"
+	"github.com/MinterTeam/minter-go-node/rlp"
+)
+
+func makeTestBLSTx(multisig types.Address, nonce uint64, data SendData, secrets map[int]*big.Int) ([]byte, error) {
+	encodedData, err := rlp.EncodeToBytes(data)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := Transaction{
+		Nonce:         nonce,
+		GasPrice:      1,
+		ChainID:       types.CurrentChainID,
+		GasCoin:       types.GetBaseCoinID(),
+		Type:          TypeSend,
+		Data:          encodedData,
+		SignatureType: SigTypeBLS,
+	}
+
+	txHash := tx.Hash()
+	var signers []int
+	var signatures [][]byte
+	for i, secret := range secrets {
+		signers = append(signers, i)
+		signatures = append(signatures, bls.Sign(secret, txHash[:]))
+	}
+	signature, err := bls.Aggregate(signatures)
+	if err != nil {
+		return nil, err
+	}
+	tx.SetSignatureBLS(multisig, signers, signature)
+
+	return rlp.EncodeToBytes(tx)
+}
+
+func TestSignatureBLSTx(t *testing.T) {
+	t.Parallel()
+	cState := getState()
+
+	var addresses []types.Address
+	secrets := map[int]*big.Int{}
+	for i := 0; i < 3; i++ {
+		privateKey, addr := getAccount()
+		cState.Accounts.AddBalance(addr, types.GetBaseCoinID(), helpers.BipToPip(big.NewInt(1000000)))
+		addresses = append(addresses, addr)
+
+		secret, err := bls.GenerateKey(rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		secrets[i] = secret
+		if i == 2 {
+			continue
+		}
+
+		data := RegisterBLSKeyData{
+			PublicKey: bls.PublicKey(secret),
+			Proof:     bls.ProofOfPossession(secret, addresses[0].Bytes()),
+		}
+		tx, err := makeTestSwapPoolTx(TypeRegisterBLSKey, data, 1, privateKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if i == 1 {
+			response := NewExecutor(GetDataV250).RunTx(cState, tx, big.NewInt(0), 0, &sync.Map{}, 0, false)
+			if response.Code != code.InvalidBLSKey {
+				t.Fatalf("Response code %d is not %d. Error: %s", response.Code, code.InvalidBLSKey, response.Log)
+			}
+
+			data.Proof = bls.ProofOfPossession(secret, addr.Bytes())
+			if tx, err = makeTestSwapPoolTx(TypeRegisterBLSKey, data, 1, privateKey); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if response := NewExecutor(GetDataV250).RunTx(cState, tx, big.NewInt(0), 0, &sync.Map{}, 0, false); response.Code != code.OK {
+			t.Fatalf("Response code %d is not 0. Error: %s", response.Code, response.Log)
+		}
+	}
+
+	multisig := accounts.CreateMultisigAddress(addresses[0], 1)
+	cState.Accounts.CreateMultisig([]uint32{1, 1, 1}, addresses, 2, multisig)
+	cState.Accounts.AddBalance(multisig, types.GetBaseCoinID(), helpers.BipToPip(big.NewInt(1000000)))
+
+	to := types.Address{1}
+	data := SendData{
+		Coin:  types.GetBaseCoinID(),
+		To:    to,
+		Value: helpers.BipToPip(big.NewInt(10)),
+	}
+
+	tx, err := makeTestBLSTx(multisig, 1, data, map[int]*big.Int{0: secrets[0]})
+	if err != nil {
+		t.Fatal(err)
+	}
+	response := NewExecutor(GetDataV250).RunTx(cState, tx, big.NewInt(0), 0, &sync.Map{}, 0, false)
+	if response.Code != code.NotEnoughMultisigVotes {
+		t.Fatalf("Response code %d is not %d. Error: %s", response.Code, code.NotEnoughMultisigVotes, response.Log)
+	}
+
+	tx, err = makeTestBLSTx(multisig, 1, data, map[int]*big.Int{0: secrets[0], 2: secrets[2]})
+	if err != nil {
+		t.Fatal(err)
+	}
+	response = NewExecutor(GetDataV250).RunTx(cState, tx, big.NewInt(0), 0, &sync.Map{}, 0, false)
+	if response.Code != code.BLSKeyNotRegistered {
+		t.Fatalf("Response code %d is not %d. Error: %s", response.Code, code.BLSKeyNotRegistered, response.Log)
+	}
+
+	tx, err = makeTestBLSTx(multisig, 1, data, map[int]*big.Int{0: secrets[0], 1: secrets[2]})
+	if err != nil {
+		t.Fatal(err)
+	}
+	response = NewExecutor(GetDataV250).RunTx(cState, tx, big.NewInt(0), 0, &sync.Map{}, 0, false)
+	if response.Code != code.IncorrectMultiSignature {
+		t.Fatalf("Response code %d is not %d. Error: %s", response.Code, code.IncorrectMultiSignature, response.Log)
+	}
+
+	tx, err = makeTestBLSTx(multisig, 1, data, map[int]*big.Int{0: secrets[0], 1: secrets[1]})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if response := NewExecutor(GetData).RunTx(cState, tx, big.NewInt(0), 0, &sync.Map{}, 0, false); response.Code != code.DecodeError {
+		t.Fatalf("Response code %d is not %d. Error: %s", response.Code, code.DecodeError, response.Log)
+	}
+	if response := NewExecutor(GetDataV250).RunTx(cState, tx, big.NewInt(0), 0, &sync.Map{}, 0, false); response.Code != code.OK {
+		t.Fatalf("Response code %d is not 0. Error: %s", response.Code, response.Log)
+	}
+
+	if balance := cState.Accounts.GetBalance(to, types.GetBaseCoinID()); balance.Cmp(data.Value) != 0 {
+		t.Fatalf("Target %s balance is not correct. Expected %s, got %s", to.String(), data.Value, balance)
+	}
+
+	if err := checkState(cState); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,112 @@
+package transaction
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/MinterTeam/minter-go-node/coreV2/code"
+	"github.com/MinterTeam/minter-go-node/coreV2/state"
+	"github.com/MinterTeam/minter-go-node/coreV2/state/swap"
+	"github.com/MinterTeam/minter-go-node/coreV2/types"
+)
+
+// checkSwapPoolFee checks that fee is one of swap pool fee tiers
+func checkSwapPoolFee(fee uint32) *Response {
+	if swap.IsFeeTier(fee) {
+		return nil
+	}
+
+	feeTiers := make([]string, 0, len(swap.FeeTiers))
+	for _, tier := range swap.FeeTiers {
+		feeTiers = append(feeTiers, strconv.Itoa(int(tier)))
+	}
+	return &Response{
+		Code: code.WrongSwapPoolFee,
+		Log:  fmt.Sprintf("Swap pool fee %d is not one of fee tiers %s", fee, strings.Join(feeTiers, ", ")),
+		Info: EncodeError(code.NewWrongSwapPoolFee(strconv.Itoa(int(fee)), strings.Join(feeTiers, ","))),
+	}
+}
+
+// checkSwapRouteFees checks fee tiers chosen for each step of the route, zero fee is allowed to choose the best pool
+func checkSwapRouteFees(coins []types.CoinID, fees []uint32) *Response {
+	if len(fees) == 0 {
+		return nil
+	}
+
+	if len(fees) != len(coins)-1 {
+		return &Response{
+			Code: code.DecodeError,
+			Log:  "Count of fees should be equal to count of steps in the route",
+			Info: EncodeError(code.NewDecodeError()),
+		}
+	}
+
+	for _, fee := range fees {
+		if fee == 0 {
+			continue
+		}
+		if response := checkSwapPoolFee(fee); response != nil {
+			return response
+		}
+	}
+
+	return nil
+}
+
+// swapRouteFees returns a copy of fee tiers for each step of the route, pools of the default tier are used if fees are not set
+func swapRouteFees(fees []uint32, steps int) []uint32 {
+	result := make([]uint32, steps)
+	for i := range result {
+		if len(fees) == 0 {
+			result[i] = swap.DefaultFee
+			continue
+		}
+		result[i] = fees[i]
+	}
+	return result
+}
+
+func reverseFees(a []uint32) {
+	for i := len(a)/2 - 1; i >= 0; i-- {
+		opp := len(a) - 1 - i
+		a[i], a[opp] = a[opp], a[i]
+	}
+}
+
+// swapPoolExistWithFee checks if the pool of given fee tier exists, or any pool of the pair if fee is zero
+func swapPoolExistWithFee(context *state.CheckState, coin0, coin1 types.CoinID, fee uint32) bool {
+	if fee == 0 {
+		return len(context.Swap().SwapPoolFees(coin0, coin1)) != 0
+	}
+	return context.Swap().SwapPoolExistWithFee(coin0, coin1, fee)
+}
+
+// routeSwapper returns the pool of given fee tier or the pool with the best price for the value if fee is zero.
+// The value is the amount to sell or the amount to buy if isBuy is true.
+func routeSwapper(context *state.CheckState, coinToSell, coinToBuy types.CoinID, fee uint32, value *big.Int, isBuy bool) swap.EditableChecker {
+	if fee != 0 {
+		return context.Swap().GetSwapperWithFee(coinToSell, coinToBuy, fee)
+	}
+	if isBuy {
+		return context.Swap().GetBestSwapperForBuy(coinToSell, coinToBuy, value)
+	}
+	return context.Swap().GetBestSwapperForSell(coinToSell, coinToBuy, value)
+}
+
+// optionalFee returns the fee from an optional tail of tx data or zero if it is empty
+func optionalFee(fee []uint32) uint32 {
+	if len(fee) == 0 {
+		return 0
+	}
+	return fee[0]
+}
+
+// poolFee returns the fee tier of the pool, the default tier if fee is not set
+func poolFee(fee uint32) uint32 {
+	if fee == 0 {
+		return swap.DefaultFee
+	}
+	return fee
+}
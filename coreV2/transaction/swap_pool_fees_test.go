@@ -0,0 +1,132 @@
+package transaction
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"sync"
+	"testing"
+
+	"github.com/MinterTeam/minter-go-node/coreV2/code"
+	"github.com/MinterTeam/minter-go-node/coreV2/state/swap"
+	"github.com/MinterTeam/minter-go-node/coreV2/types"
+	"github.com/MinterTeam/minter-go-node/helpers"
+	"github.com/MinterTeam/minter-go-node/rlp"
+)
+
+func makeTestSwapPoolTx(txType TxType, data interface{}, nonce uint64, privateKey *ecdsa.PrivateKey) ([]byte, error) {
+	encodedData, err := rlp.EncodeToBytes(data)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := Transaction{
+		Nonce:         nonce,
+		GasPrice:      1,
+		ChainID:       types.CurrentChainID,
+		GasCoin:       types.GetBaseCoinID(),
+		Type:          txType,
+		Data:          encodedData,
+		SignatureType: SigTypeSingle,
+	}
+
+	if err := tx.Sign(privateKey); err != nil {
+		return nil, err
+	}
+
+	return rlp.EncodeToBytes(tx)
+}
+
+func TestSwapPoolFeeTiers(t *testing.T) {
+	t.Parallel()
+	cState := getState()
+
+	coin := createTestCoin(cState)
+	coin1 := createNonReserveCoin(cState)
+
+	privateKey, addr := getAccount()
+	cState.Accounts.AddBalance(addr, types.BasecoinID, helpers.BipToPip(big.NewInt(1000000)))
+	cState.Accounts.SubBalance(types.Address{}, coin, helpers.BipToPip(big.NewInt(100000)))
+	cState.Accounts.AddBalance(addr, coin, helpers.BipToPip(big.NewInt(100000)))
+	cState.Accounts.SubBalance(types.Address{}, coin1, helpers.BipToPip(big.NewInt(100000)))
+	cState.Accounts.AddBalance(addr, coin1, helpers.BipToPip(big.NewInt(100000)))
+
+	nonce := uint64(1)
+	run := func(txType TxType, data interface{}, getData func(TxType) (Data, bool)) Response {
+		tx, err := makeTestSwapPoolTx(txType, data, nonce, privateKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		response := NewExecutor(getData).RunTx(cState, tx, big.NewInt(0), 0, &sync.Map{}, 0, false)
+		if response.Code == code.OK {
+			nonce++
+		}
+		return response
+	}
+
+	createData := CreateSwapPoolDataV250{
+		Coin0:   coin,
+		Coin1:   coin1,
+		Volume0: helpers.BipToPip(big.NewInt(1000)),
+		Volume1: helpers.BipToPip(big.NewInt(1000)),
+	}
+	if response := run(TypeCreateSwapPool, createData, GetDataV250); response.Code != code.OK {
+		t.Fatalf("Response code %d is not 0. Error: %s", response.Code, response.Log)
+	}
+
+	createData.Fee = []uint32{5}
+	if response := run(TypeCreateSwapPool, createData, GetData); response.Code != code.DecodeError {
+		t.Fatalf("Response code %d is not %d. Error: %s", response.Code, code.DecodeError, response.Log)
+	}
+	if response := run(TypeCreateSwapPool, createData, GetDataV250); response.Code != code.OK {
+		t.Fatalf("Response code %d is not 0. Error: %s", response.Code, response.Log)
+	}
+	if response := run(TypeCreateSwapPool, createData, GetDataV250); response.Code != code.PairAlreadyExists {
+		t.Fatalf("Response code %d is not %d. Error: %s", response.Code, code.PairAlreadyExists, response.Log)
+	}
+
+	createData.Fee = []uint32{7}
+	if response := run(TypeCreateSwapPool, createData, GetDataV250); response.Code != code.WrongSwapPoolFee {
+		t.Fatalf("Response code %d is not %d. Error: %s", response.Code, code.WrongSwapPoolFee, response.Log)
+	}
+
+	if fees := cState.Swap.SwapPoolFees(coin, coin1); len(fees) != 2 || fees[0] != 5 || fees[1] != swap.DefaultFee {
+		t.Fatalf("wrong fee tiers of pools: %v", fees)
+	}
+
+	defaultReserve0, _ := cState.Swap.PairWithFee(coin, coin1, swap.DefaultFee).Reserves()
+	lowReserve0, _ := cState.Swap.PairWithFee(coin, coin1, 5).Reserves()
+
+	sellData := SellSwapPoolDataV250{
+		Coins:             []types.CoinID{coin, coin1},
+		ValueToSell:       helpers.BipToPip(big.NewInt(10)),
+		MinimumValueToBuy: big.NewInt(1),
+		Fees:              []uint32{0},
+	}
+	if response := run(TypeSellSwapPool, sellData, GetDataV250); response.Code != code.OK {
+		t.Fatalf("Response code %d is not 0. Error: %s", response.Code, response.Log)
+	}
+
+	if reserve0, _ := cState.Swap.PairWithFee(coin, coin1, swap.DefaultFee).Reserves(); reserve0.Cmp(defaultReserve0) != 0 {
+		t.Fatalf("pool of the default tier should not be used")
+	}
+	if reserve0, _ := cState.Swap.PairWithFee(coin, coin1, 5).Reserves(); reserve0.Cmp(new(big.Int).Add(lowReserve0, sellData.ValueToSell)) != 0 {
+		t.Fatalf("pool with the lowest fee should be used, reserve is %s", reserve0)
+	}
+
+	sellData.Fees = []uint32{swap.DefaultFee}
+	if response := run(TypeSellSwapPool, sellData, GetDataV250); response.Code != code.OK {
+		t.Fatalf("Response code %d is not 0. Error: %s", response.Code, response.Log)
+	}
+	if reserve0, _ := cState.Swap.PairWithFee(coin, coin1, swap.DefaultFee).Reserves(); reserve0.Cmp(new(big.Int).Add(defaultReserve0, sellData.ValueToSell)) != 0 {
+		t.Fatalf("pool of the default tier should be used, reserve is %s", reserve0)
+	}
+
+	sellData.Fees = []uint32{5, 5}
+	if response := run(TypeSellSwapPool, sellData, GetDataV250); response.Code != code.DecodeError {
+		t.Fatalf("Response code %d is not %d. Error: %s", response.Code, code.DecodeError, response.Log)
+	}
+
+	if err := checkState(cState); err != nil {
+		t.Error(err)
+	}
+}
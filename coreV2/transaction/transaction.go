@@ -61,12 +61,31 @@ const (
 	TypeVoteCommission          TxType = 0x20
 	TypeVoteUpdate              TxType = 0x21
 	TypeCreateSwapPool          TxType = 0x22
+	TypeVoteSlots               TxType = 0x23
+	TypeUnjail                  TxType = 0x24
+	TypeApprove                 TxType = 0x25
+	TypeTransferFrom            TxType = 0x26
+	TypeControlToken            TxType = 0x27
+	TypeEditCoinMetadata        TxType = 0x28
+	TypeCreateAirdrop           TxType = 0x29
+	TypeClaimAirdrop            TxType = 0x2A
+	TypeReclaimAirdrop          TxType = 0x2B
+	TypeRegisterBLSKey          TxType = 0x2C
+	TypeSetAccountKey           TxType = 0x2D
+	TypeCreateSchedule          TxType = 0x2E
+	TypeCancelSchedule          TxType = 0x2F
+	TypeCreateHTLC              TxType = 0x30
+	TypeClaimHTLC               TxType = 0x31
+	TypeRefundHTLC              TxType = 0x32
 )
 
 const (
 	gasBase           = 15
 	gasSign           = 20
+	gasSignBLS        = 40
 	gasSend           = 1
+	gasApprove        = 1
+	gasTransferFrom   = 1
 	gasMultisendBase  = 1
 	gasMultisendDelta = 1
 
@@ -82,14 +101,16 @@ const (
 	gasSellAllCoin     = 2
 	gasBuyCoin         = 2
 
-	gasCreateCoin    = 3
-	gasRecreateCoin  = 5
-	gasCreateToken   = 3
-	gasRecreateToken = 5
-	gasEditCoinOwner = 5
+	gasCreateCoin       = 3
+	gasRecreateCoin     = 5
+	gasCreateToken      = 3
+	gasRecreateToken    = 5
+	gasEditCoinOwner    = 5
+	gasEditCoinMetadata = 5
 
-	gasMintToken = 1
-	gasBurnToken = 1
+	gasMintToken    = 1
+	gasBurnToken    = 1
+	gasControlToken = 1
 
 	gasRedeemCheck = 20
 
@@ -102,20 +123,37 @@ const (
 	gasEditCandidate           = 5
 	gasEditCandidatePublicKey  = 10
 	gasEditCandidateCommission = 1
+	gasUnjail                  = 1
 
 	gasCreateMultisig = 20
 	gasEditMultisig   = 5
+	gasRegisterBLSKey = 10
+	gasSetAccountKey  = 10
 
 	gasSetHaltBlock   = 5
 	gasVoteCommission = 5
 	gasVoteUpdate     = 5
+	gasVoteSlots      = 5
+
+	gasCreateAirdrop  = 10
+	gasClaimAirdrop   = 5
+	gasReclaimAirdrop = 1
+
+	gasCreateSchedule = 10
+	gasCancelSchedule = 1
+
+	gasCreateHTLC = 10
+	gasClaimHTLC  = 5
+	gasRefundHTLC = 1
 )
 
 type SigType byte
 
 const (
-	SigTypeSingle SigType = 0x01
-	SigTypeMulti  SigType = 0x02
+	SigTypeSingle  SigType = 0x01
+	SigTypeMulti   SigType = 0x02
+	SigTypeBLS     SigType = 0x03
+	SigTypeAccount SigType = 0x04
 )
 
 var (
@@ -137,6 +175,8 @@ type Transaction struct {
 	decodedData Data
 	sig         *Signature
 	multisig    *SignatureMulti
+	blsSig      *SignatureBLS
+	accountSig  *SignatureAccount
 	sender      *types.Address
 }
 
@@ -206,6 +246,9 @@ func (tx *Transaction) Gas() int64 {
 	if tx.SignatureType == SigTypeMulti {
 		base += int64(len(tx.multisig.Signatures)) * gasSign
 	}
+	if tx.SignatureType == SigTypeBLS {
+		base += gasSignBLS
+	}
 	return base + tx.decodedData.Gas()
 }
 
@@ -309,6 +352,10 @@ func (tx *Transaction) Sender() (types.Address, error) {
 		return sender, nil
 	case SigTypeMulti:
 		return tx.multisig.Multisig, nil
+	case SigTypeBLS:
+		return tx.blsSig.Multisig, nil
+	case SigTypeAccount:
+		return tx.accountSig.Account, nil
 	}
 
 	return types.Address{}, errors.New("unknown signature type")
@@ -352,13 +399,42 @@ func (tx *Transaction) SetMultisigAddress(address types.Address) {
 	tx.SignatureData = data
 }
 
+// PublicKey recovers the public key of the signer of single signature or account signature transaction
+func (tx *Transaction) PublicKey() (*ecdsa.PublicKey, error) {
+	var pub []byte
+	var err error
+	switch tx.SignatureType {
+	case SigTypeSingle:
+		pub, err = recoverPublicKey(tx.Hash(), tx.sig.R, tx.sig.S, tx.sig.V)
+	case SigTypeAccount:
+		pub, err = recoverPublicKey(tx.accountHash(tx.accountSig.Account), tx.accountSig.R, tx.accountSig.S, tx.accountSig.V)
+	default:
+		return nil, errors.New("public key can be recovered only from single signature or account signature transaction")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return crypto.UnmarshalPubkey(pub)
+}
+
 func RecoverPlain(sighash types.Hash, R, S, Vb *big.Int) (types.Address, error) {
+	pub, err := recoverPublicKey(sighash, R, S, Vb)
+	if err != nil {
+		return types.Address{}, err
+	}
+	var addr types.Address
+	copy(addr[:], crypto.Keccak256(pub[1:])[12:])
+	return addr, nil
+}
+
+func recoverPublicKey(sighash types.Hash, R, S, Vb *big.Int) ([]byte, error) {
 	if Vb.BitLen() > 8 {
-		return types.Address{}, ErrInvalidSig
+		return nil, ErrInvalidSig
 	}
 	V := byte(Vb.Uint64() - 27)
 	if !crypto.ValidateSignatureValues(V, R, S, true) {
-		return types.Address{}, ErrInvalidSig
+		return nil, ErrInvalidSig
 	}
 	// encode the snature in uncompressed format
 	r, s := R.Bytes(), S.Bytes()
@@ -370,14 +446,12 @@ func RecoverPlain(sighash types.Hash, R, S, Vb *big.Int) (types.Address, error)
 	// recover the public key from the snature
 	pub, err := crypto.Ecrecover(sighash[:], sig)
 	if err != nil {
-		return types.Address{}, err
+		return nil, err
 	}
 	if len(pub) == 0 || pub[0] != 4 {
-		return types.Address{}, errors.New("invalid public key")
+		return nil, errors.New("invalid public key")
 	}
-	var addr types.Address
-	copy(addr[:], crypto.Keccak256(pub[1:])[12:])
-	return addr, nil
+	return pub, nil
 }
 
 func rlpHash(x interface{}) (h types.Hash) {
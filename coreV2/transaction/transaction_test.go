@@ -214,3 +214,25 @@ func TestCommissionFromPool(t *testing.T) {
 		}
 	}
 }
+
+func TestTransactionPublicKey(t *testing.T) {
+	t.Parallel()
+	privateKey, addr := getAccount()
+
+	encodedTx, err := makeTestSwapPoolTx(TypeSend, SendData{Coin: types.GetBaseCoinID(), To: types.Address{1}, Value: big.NewInt(1)}, 1, privateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx, err := NewExecutor(GetDataV250).DecodeFromBytes(encodedTx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pub, err := tx.PublicKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if crypto.PubkeyToAddress(*pub) != addr || !pub.Equal(&privateKey.PublicKey) {
+		t.Fatal("recovered public key is not correct")
+	}
+}
@@ -0,0 +1,145 @@
+package transaction
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/MinterTeam/minter-go-node/coreV2/code"
+	"github.com/MinterTeam/minter-go-node/coreV2/state"
+	"github.com/MinterTeam/minter-go-node/coreV2/state/commission"
+	"github.com/MinterTeam/minter-go-node/coreV2/types"
+	abcTypes "github.com/tendermint/tendermint/abci/types"
+)
+
+// TransferFromData transfers the coin from the account of the owner within the allowance given to the sender.
+// The commission is paid by the sender.
+type TransferFromData struct {
+	Owner types.Address
+	To    types.Address
+	Coin  types.CoinID
+	Value *big.Int
+}
+
+func (data TransferFromData) TxType() TxType {
+	return TypeTransferFrom
+}
+
+func (data TransferFromData) Gas() int64 {
+	return gasTransferFrom
+}
+
+func (data TransferFromData) basicCheck(tx *Transaction, context *state.CheckState) *Response {
+	if data.Value == nil {
+		return &Response{
+			Code: code.DecodeError,
+			Log:  "Incorrect tx data",
+			Info: EncodeError(code.NewDecodeError()),
+		}
+	}
+
+	coin := context.Coins().GetCoin(data.Coin)
+	if coin == nil {
+		return &Response{
+			Code: code.CoinNotExists,
+			Log:  fmt.Sprintf("Coin %s not exists", data.Coin),
+			Info: EncodeError(code.NewCoinNotExists("", data.Coin.String())),
+		}
+	}
+
+	sender, _ := tx.Sender()
+	allowance := context.Allowances().GetAllowance(data.Owner, sender, data.Coin)
+	if allowance.Cmp(data.Value) < 0 {
+		return &Response{
+			Code: code.InsufficientAllowance,
+			Log:  fmt.Sprintf("Insufficient allowance of %s for spender %s. Wanted %s %s, allowed %s", data.Owner.String(), sender.String(), data.Value.String(), coin.GetFullSymbol(), allowance.String()),
+			Info: EncodeError(code.NewInsufficientAllowance(data.Owner.String(), sender.String(), data.Value.String(), allowance.String(), data.Coin.String())),
+		}
+	}
+
+	if context.Accounts().GetBalance(data.Owner, data.Coin).Cmp(data.Value) < 0 {
+		return &Response{
+			Code: code.InsufficientFunds,
+			Log:  fmt.Sprintf("Insufficient funds for owner account: %s. Wanted %s %s", data.Owner.String(), data.Value.String(), coin.GetFullSymbol()),
+			Info: EncodeError(code.NewInsufficientFunds(data.Owner.String(), data.Value.String(), coin.GetFullSymbol(), coin.ID().String())),
+		}
+	}
+
+	if errResp := checkCoinSpender(context, data.Coin, data.Owner); errResp != nil {
+		return errResp
+	}
+	return checkCoinRecipient(context, data.Coin, data.To)
+}
+
+func (data TransferFromData) String() string {
+	return fmt.Sprintf("TRANSFER FROM owner:%s to:%s coin:%s value:%s",
+		data.Owner.String(), data.To.String(), data.Coin.String(), data.Value.String())
+}
+
+func (data TransferFromData) CommissionData(price *commission.Price) *big.Int {
+	return price.Send
+}
+
+func (data TransferFromData) Run(tx *Transaction, context state.Interface, rewardPool *big.Int, currentBlock uint64, price *big.Int) Response {
+	sender, _ := tx.Sender()
+	var checkState *state.CheckState
+	var isCheck bool
+	if checkState, isCheck = context.(*state.CheckState); !isCheck {
+		checkState = state.NewCheckState(context.(*state.State))
+	}
+
+	response := data.basicCheck(tx, checkState)
+	if response != nil {
+		return *response
+	}
+
+	commissionInBaseCoin := tx.Commission(price)
+	commissionPoolSwapper := checkState.Swap().GetSwapper(tx.GasCoin, types.GetBaseCoinID())
+	gasCoin := checkState.Coins().GetCoin(tx.GasCoin)
+	commission, isGasCommissionFromPoolSwap, errResp := CalculateCommission(checkState, commissionPoolSwapper, gasCoin, commissionInBaseCoin)
+	if errResp != nil {
+		return *errResp
+	}
+
+	needValue := big.NewInt(0).Set(commission)
+	if sender == data.Owner && tx.GasCoin == data.Coin {
+		needValue.Add(data.Value, needValue)
+	}
+	if checkState.Accounts().GetBalance(sender, tx.GasCoin).Cmp(needValue) < 0 {
+		return Response{
+			Code: code.InsufficientFunds,
+			Log:  fmt.Sprintf("Insufficient funds for sender account: %s. Wanted %s %s", sender.String(), needValue.String(), gasCoin.GetFullSymbol()),
+			Info: EncodeError(code.NewInsufficientFunds(sender.String(), needValue.String(), gasCoin.GetFullSymbol(), gasCoin.ID().String())),
+		}
+	}
+
+	var tags []abcTypes.EventAttribute
+	if deliverState, ok := context.(*state.State); ok {
+		if isGasCommissionFromPoolSwap {
+			commission, commissionInBaseCoin, _ = deliverState.Swap.PairSell(tx.GasCoin, types.GetBaseCoinID(), commission, commissionInBaseCoin)
+		} else if !tx.GasCoin.IsBaseCoin() {
+			deliverState.Coins.SubVolume(tx.GasCoin, commission)
+			deliverState.Coins.SubReserve(tx.GasCoin, commissionInBaseCoin)
+		}
+		deliverState.Accounts.SubBalance(sender, tx.GasCoin, commission)
+		rewardPool.Add(rewardPool, commissionInBaseCoin)
+		deliverState.Allowances.SubAllowance(data.Owner, sender, data.Coin, data.Value)
+		deliverState.Accounts.SubBalance(data.Owner, data.Coin, data.Value)
+		deliverState.Accounts.AddBalance(data.To, data.Coin, data.Value)
+		deliverState.Accounts.SetNonce(sender, tx.Nonce)
+
+		tags = []abcTypes.EventAttribute{
+			{Key: []byte("tx.commission_in_base_coin"), Value: []byte(commissionInBaseCoin.String())},
+			{Key: []byte("tx.commission_conversion"), Value: []byte(isGasCommissionFromPoolSwap.String()), Index: true},
+			{Key: []byte("tx.commission_amount"), Value: []byte(commission.String())},
+			{Key: []byte("tx.owner"), Value: []byte(hex.EncodeToString(data.Owner[:])), Index: true},
+			{Key: []byte("tx.to"), Value: []byte(hex.EncodeToString(data.To[:])), Index: true},
+			{Key: []byte("tx.coin_id"), Value: []byte(data.Coin.String()), Index: true},
+		}
+	}
+
+	return Response{
+		Code: code.OK,
+		Tags: tags,
+	}
+}
@@ -0,0 +1,83 @@
+package transaction
+
+import (
+	"math/big"
+	"sync"
+	"testing"
+
+	"github.com/MinterTeam/minter-go-node/coreV2/code"
+	"github.com/MinterTeam/minter-go-node/coreV2/types"
+	"github.com/MinterTeam/minter-go-node/helpers"
+)
+
+func TestTransferFromTx(t *testing.T) {
+	t.Parallel()
+	cState := getState()
+
+	coin := createNonReserveCoin(cState)
+
+	ownerKey, owner := getAccount()
+	spenderKey, spender := getAccount()
+	to := types.Address{1}
+
+	cState.Accounts.AddBalance(owner, types.GetBaseCoinID(), helpers.BipToPip(big.NewInt(1000000)))
+	cState.Accounts.AddBalance(spender, types.GetBaseCoinID(), helpers.BipToPip(big.NewInt(1000000)))
+	cState.Accounts.SubBalance(types.Address{}, coin, helpers.BipToPip(big.NewInt(100)))
+	cState.Accounts.AddBalance(owner, coin, helpers.BipToPip(big.NewInt(100)))
+
+	transferData := TransferFromData{
+		Owner: owner,
+		To:    to,
+		Coin:  coin,
+		Value: helpers.BipToPip(big.NewInt(30)),
+	}
+	tx, err := makeTestSwapPoolTx(TypeTransferFrom, transferData, 1, spenderKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	response := NewExecutor(GetDataV250).RunTx(cState, tx, big.NewInt(0), 0, &sync.Map{}, 0, false)
+	if response.Code != code.InsufficientAllowance {
+		t.Fatalf("Response code %d is not %d. Error: %s", response.Code, code.InsufficientAllowance, response.Log)
+	}
+
+	tx, err = makeTestSwapPoolTx(TypeApprove, ApproveData{Spender: spender, Coin: coin, Value: helpers.BipToPip(big.NewInt(50))}, 1, ownerKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if response := NewExecutor(GetData).RunTx(cState, tx, big.NewInt(0), 0, &sync.Map{}, 0, false); response.Code != code.DecodeError {
+		t.Fatalf("Response code %d is not %d. Error: %s", response.Code, code.DecodeError, response.Log)
+	}
+	if response := NewExecutor(GetDataV250).RunTx(cState, tx, big.NewInt(0), 0, &sync.Map{}, 0, false); response.Code != code.OK {
+		t.Fatalf("Response code %d is not 0. Error: %s", response.Code, response.Log)
+	}
+
+	tx, err = makeTestSwapPoolTx(TypeTransferFrom, transferData, 1, spenderKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if response := NewExecutor(GetDataV250).RunTx(cState, tx, big.NewInt(0), 0, &sync.Map{}, 0, false); response.Code != code.OK {
+		t.Fatalf("Response code %d is not 0. Error: %s", response.Code, response.Log)
+	}
+
+	if balance := cState.Accounts.GetBalance(to, coin); balance.Cmp(helpers.BipToPip(big.NewInt(30))) != 0 {
+		t.Fatalf("Target balance is not correct. Expected %s, got %s", helpers.BipToPip(big.NewInt(30)), balance)
+	}
+	if balance := cState.Accounts.GetBalance(owner, coin); balance.Cmp(helpers.BipToPip(big.NewInt(70))) != 0 {
+		t.Fatalf("Owner balance is not correct. Expected %s, got %s", helpers.BipToPip(big.NewInt(70)), balance)
+	}
+	if allowance := cState.Allowances.GetAllowance(owner, spender, coin); allowance.Cmp(helpers.BipToPip(big.NewInt(20))) != 0 {
+		t.Fatalf("Allowance is not correct. Expected %s, got %s", helpers.BipToPip(big.NewInt(20)), allowance)
+	}
+
+	tx, err = makeTestSwapPoolTx(TypeTransferFrom, transferData, 2, spenderKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if response := NewExecutor(GetDataV250).RunTx(cState, tx, big.NewInt(0), 0, &sync.Map{}, 0, false); response.Code != code.InsufficientAllowance {
+		t.Fatalf("Response code %d is not %d. Error: %s", response.Code, code.InsufficientAllowance, response.Log)
+	}
+
+	if err := checkState(cState); err != nil {
+		t.Error(err)
+	}
+}
@@ -51,6 +51,9 @@ func (data UnbondData) basicCheck(tx *Transaction, context *state.CheckState) *R
 	}
 
 	sender, _ := tx.Sender()
+	if errResp := checkCoinRecipient(context, data.Coin, sender); errResp != nil {
+		return errResp
+	}
 
 	if waitlist := context.WaitList().Get(sender, data.PubKey, data.Coin); waitlist != nil {
 		if data.Value.Cmp(waitlist.Value) != 1 {
@@ -0,0 +1,120 @@
+package transaction
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"github.com/MinterTeam/minter-go-node/coreV2/code"
+	"github.com/MinterTeam/minter-go-node/coreV2/state"
+	"github.com/MinterTeam/minter-go-node/coreV2/state/commission"
+	"github.com/MinterTeam/minter-go-node/coreV2/types"
+	abcTypes "github.com/tendermint/tendermint/abci/types"
+)
+
+type UnjailData struct {
+	PubKey types.Pubkey
+}
+
+func (data UnjailData) Gas() int64 {
+	return gasUnjail
+}
+
+func (data UnjailData) TxType() TxType {
+	return TypeUnjail
+}
+
+func (data UnjailData) GetPubKey() types.Pubkey {
+	return data.PubKey
+}
+
+func (data UnjailData) basicCheck(tx *Transaction, context *state.CheckState, block uint64) *Response {
+	if response := checkCandidateControl(data, tx, context); response != nil {
+		return response
+	}
+
+	candidate := context.Candidates().GetCandidate(data.PubKey)
+	if candidate.GetJail() == nil {
+		return &Response{
+			Code: code.CandidateNotJailed,
+			Log:  fmt.Sprintf("Candidate %s is not jailed", data.PubKey.String()),
+			Info: EncodeError(code.NewCandidateNotJailed(data.PubKey.String())),
+		}
+	}
+
+	if candidate.JailedUntil >= block {
+		return &Response{
+			Code: code.CandidateJailed,
+			Log:  fmt.Sprintf("Candidate is jailed until block %d", candidate.JailedUntil),
+			Info: EncodeError(code.NewCandidateJailed(data.PubKey.String(), strconv.FormatUint(candidate.JailedUntil, 10))),
+		}
+	}
+
+	return nil
+}
+
+func (data UnjailData) String() string {
+	return fmt.Sprintf("UNJAIL pubkey: %x", data.PubKey)
+}
+
+func (data UnjailData) CommissionData(price *commission.Price) *big.Int {
+	return price.SetCandidateOn
+}
+
+func (data UnjailData) Run(tx *Transaction, context state.Interface, rewardPool *big.Int, currentBlock uint64, price *big.Int) Response {
+	sender, _ := tx.Sender()
+
+	var checkState *state.CheckState
+	var isCheck bool
+	if checkState, isCheck = context.(*state.CheckState); !isCheck {
+		checkState = state.NewCheckState(context.(*state.State))
+	}
+
+	response := data.basicCheck(tx, checkState, currentBlock)
+	if response != nil {
+		return *response
+	}
+
+	commissionInBaseCoin := tx.Commission(price)
+	commissionPoolSwapper := checkState.Swap().GetSwapper(tx.GasCoin, types.GetBaseCoinID())
+	gasCoin := checkState.Coins().GetCoin(tx.GasCoin)
+	commission, isGasCommissionFromPoolSwap, errResp := CalculateCommission(checkState, commissionPoolSwapper, gasCoin, commissionInBaseCoin)
+	if errResp != nil {
+		return *errResp
+	}
+
+	if checkState.Accounts().GetBalance(sender, tx.GasCoin).Cmp(commission) < 0 {
+		return Response{
+			Code: code.InsufficientFunds,
+			Log:  fmt.Sprintf("Insufficient funds for sender account: %s. Wanted %s %s", sender.String(), commission, gasCoin.GetFullSymbol()),
+			Info: EncodeError(code.NewInsufficientFunds(sender.String(), commission.String(), gasCoin.GetFullSymbol(), gasCoin.ID().String())),
+		}
+	}
+
+	var tags []abcTypes.EventAttribute
+	if deliverState, ok := context.(*state.State); ok {
+		if isGasCommissionFromPoolSwap {
+			commission, commissionInBaseCoin, _ = deliverState.Swap.PairSell(tx.GasCoin, types.GetBaseCoinID(), commission, commissionInBaseCoin)
+		} else if !tx.GasCoin.IsBaseCoin() {
+			deliverState.Coins.SubVolume(tx.GasCoin, commission)
+			deliverState.Coins.SubReserve(tx.GasCoin, commissionInBaseCoin)
+		}
+		deliverState.Accounts.SubBalance(sender, tx.GasCoin, commission)
+		rewardPool.Add(rewardPool, commissionInBaseCoin)
+		deliverState.Candidates.Unjail(data.PubKey)
+		deliverState.Accounts.SetNonce(sender, tx.Nonce)
+
+		tags = []abcTypes.EventAttribute{
+			{Key: []byte("tx.commission_in_base_coin"), Value: []byte(commissionInBaseCoin.String())},
+			{Key: []byte("tx.commission_conversion"), Value: []byte(isGasCommissionFromPoolSwap.String()), Index: true},
+			{Key: []byte("tx.commission_amount"), Value: []byte(commission.String())},
+			{Key: []byte("tx.public_key"), Value: []byte(hex.EncodeToString(data.PubKey[:])), Index: true},
+		}
+	}
+
+	return Response{
+		Code: code.OK,
+		Tags: tags,
+	}
+}
@@ -0,0 +1,86 @@
+package transaction
+
+import (
+	"math/big"
+	"math/rand"
+	"sync"
+	"testing"
+
+	"github.com/MinterTeam/minter-go-node/coreV2/code"
+	eventsdb "github.com/MinterTeam/minter-go-node/coreV2/events"
+	"github.com/MinterTeam/minter-go-node/coreV2/state"
+	"github.com/MinterTeam/minter-go-node/coreV2/state/candidates"
+	"github.com/MinterTeam/minter-go-node/coreV2/types"
+	"github.com/MinterTeam/minter-go-node/helpers"
+	"github.com/MinterTeam/minter-go-node/rlp"
+	db "github.com/tendermint/tm-db"
+)
+
+func TestUnjailTx(t *testing.T) {
+	t.Parallel()
+	cState, err := state.NewState(0, db.NewMemDB(), &eventsdb.MockEvents{}, 1, 1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cState.Validators.Create(types.Pubkey{}, big.NewInt(1))
+	cState.Candidates.Create(types.Address{}, types.Address{}, types.Address{}, types.Pubkey{}, 10, 0, 0)
+	cState.Commission.SetNewCommissions(commissionPrice.Encode())
+
+	privateKey, addr := getAccount()
+	cState.Accounts.AddBalance(addr, types.GetBaseCoinID(), helpers.BipToPip(big.NewInt(1000000)))
+
+	pubkey := types.Pubkey{}
+	rand.Read(pubkey[:])
+
+	cState.Candidates.Create(addr, addr, addr, pubkey, 10, 0, 100)
+	cState.Candidates.SetJail(pubkey, candidates.JailReasonDoubleSign, 10)
+
+	encodedData, err := rlp.EncodeToBytes(UnjailData{PubKey: pubkey})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	makeTx := func(nonce uint64) []byte {
+		tx := Transaction{
+			Nonce:         nonce,
+			GasPrice:      1,
+			ChainID:       types.CurrentChainID,
+			GasCoin:       types.GetBaseCoinID(),
+			Type:          TypeUnjail,
+			Data:          encodedData,
+			SignatureType: SigTypeSingle,
+		}
+		if err := tx.Sign(privateKey); err != nil {
+			t.Fatal(err)
+		}
+		encodedTx, err := rlp.EncodeToBytes(tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return encodedTx
+	}
+
+	response := NewExecutor(GetDataV250).RunTx(cState, makeTx(1), big.NewInt(0), 100, &sync.Map{}, 0, false)
+	if response.Code != code.CandidateJailed {
+		t.Fatalf("Response code is not %d. Error: %s", code.CandidateJailed, response.Log)
+	}
+
+	response = NewExecutor(GetDataV250).RunTx(cState, makeTx(1), big.NewInt(0), 101, &sync.Map{}, 0, false)
+	if response.Code != code.OK {
+		t.Fatalf("Response code is not 0. Error: %s", response.Log)
+	}
+
+	candidate := cState.Candidates.GetCandidate(pubkey)
+	if candidate.GetJail() != nil || candidate.GetStatus() != candidates.CandidateStatusOnline {
+		t.Fatal("candidate is not unjailed")
+	}
+
+	response = NewExecutor(GetDataV250).RunTx(cState, makeTx(2), big.NewInt(0), 102, &sync.Map{}, 0, false)
+	if response.Code != code.CandidateNotJailed {
+		t.Fatalf("Response code is not %d. Error: %s", code.CandidateNotJailed, response.Log)
+	}
+
+	if err := checkState(cState); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,148 @@
+package transaction
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"math/rand"
+	"sync"
+	"testing"
+
+	"github.com/MinterTeam/minter-go-node/coreV2/code"
+	"github.com/MinterTeam/minter-go-node/coreV2/types"
+	"github.com/MinterTeam/minter-go-node/helpers"
+	"github.com/MinterTeam/minter-go-node/rlp"
+)
+
+func makeTestVoteSlotsTx(data VoteSlotsData, nonce uint64, privateKey *ecdsa.PrivateKey) ([]byte, error) {
+	encodedData, err := rlp.EncodeToBytes(data)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := Transaction{
+		Nonce:         nonce,
+		GasPrice:      1,
+		ChainID:       types.CurrentChainID,
+		GasCoin:       types.GetBaseCoinID(),
+		Type:          TypeVoteSlots,
+		Data:          encodedData,
+		SignatureType: SigTypeSingle,
+	}
+
+	if err := tx.Sign(privateKey); err != nil {
+		return nil, err
+	}
+
+	return rlp.EncodeToBytes(tx)
+}
+
+func TestVoteSlotsTx(t *testing.T) {
+	t.Parallel()
+	cState := getState()
+	privateKey, addr := getAccount()
+	cState.Accounts.AddBalance(addr, types.GetBaseCoinID(), helpers.BipToPip(big.NewInt(1000000)))
+
+	pubkey := types.Pubkey{}
+	rand.Read(pubkey[:])
+
+	cState.Candidates.Create(addr, addr, addr, pubkey, 10, 0, 0)
+	cState.Validators.Create(pubkey, helpers.BipToPip(big.NewInt(1)))
+
+	data := VoteSlotsData{
+		PubKey:     pubkey,
+		Height:     100500,
+		Validators: 10,
+		Candidates: 30,
+	}
+
+	tx, err := makeTestVoteSlotsTx(data, 1, privateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	response := NewExecutor(GetData).RunTx(cState, tx, big.NewInt(0), 0, &sync.Map{}, 0, false)
+	if response.Code != code.DecodeError {
+		t.Fatalf("Response code is not %d. Error: %s", code.DecodeError, response.Log)
+	}
+
+	response = NewExecutor(GetDataV250).RunTx(cState, tx, big.NewInt(0), 0, &sync.Map{}, 0, false)
+	if response.Code != code.OK {
+		t.Fatalf("Response code is not 0. Error: %s", response.Log)
+	}
+
+	votes := cState.Slots.GetVotes(data.Height)
+	if len(votes) != 1 || votes[0].Validators != 10 || votes[0].Candidates != 30 || len(votes[0].Votes) != 1 || votes[0].Votes[0] != pubkey {
+		t.Fatalf("wrong slots votes: %+v", votes)
+	}
+
+	tx, err = makeTestVoteSlotsTx(data, 2, privateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	response = NewExecutor(GetDataV250).RunTx(cState, tx, big.NewInt(0), 0, &sync.Map{}, 0, false)
+	if response.Code != code.VoteAlreadyExists {
+		t.Fatalf("Response code is not %d. Error: %s", code.VoteAlreadyExists, response.Log)
+	}
+
+	if err := checkState(cState); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestVoteSlotsTxToWrongCounts(t *testing.T) {
+	t.Parallel()
+	cState := getState()
+	privateKey, addr := getAccount()
+	cState.Accounts.AddBalance(addr, types.GetBaseCoinID(), helpers.BipToPip(big.NewInt(1000000)))
+
+	pubkey := types.Pubkey{}
+	rand.Read(pubkey[:])
+
+	cState.Candidates.Create(addr, addr, addr, pubkey, 10, 0, 0)
+
+	for _, data := range []VoteSlotsData{
+		{PubKey: pubkey, Height: 100500, Validators: 0, Candidates: 30},
+		{PubKey: pubkey, Height: 100500, Validators: 10, Candidates: 5},
+	} {
+		tx, err := makeTestVoteSlotsTx(data, 1, privateKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		response := NewExecutor(GetDataV250).RunTx(cState, tx, big.NewInt(0), 0, &sync.Map{}, 0, false)
+		if response.Code != code.WrongSlotsCount {
+			t.Fatalf("Response code is not %d. Error: %s", code.WrongSlotsCount, response.Log)
+		}
+	}
+
+	if err := checkState(cState); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestVoteSlotsTxToExpiredHeight(t *testing.T) {
+	t.Parallel()
+	cState := getState()
+	privateKey, addr := getAccount()
+	cState.Accounts.AddBalance(addr, types.GetBaseCoinID(), helpers.BipToPip(big.NewInt(1000000)))
+
+	pubkey := types.Pubkey{}
+	rand.Read(pubkey[:])
+
+	cState.Candidates.Create(addr, addr, addr, pubkey, 10, 0, 0)
+
+	tx, err := makeTestVoteSlotsTx(VoteSlotsData{PubKey: pubkey, Height: 10, Validators: 10, Candidates: 30}, 1, privateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	response := NewExecutor(GetDataV250).RunTx(cState, tx, big.NewInt(0), 100, &sync.Map{}, 0, false)
+	if response.Code != code.VoteExpired {
+		t.Fatalf("Response code is not %d. Error: %s", code.VoteExpired, response.Log)
+	}
+
+	if err := checkState(cState); err != nil {
+		t.Error(err)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"github.com/MinterTeam/minter-go-node/helpers"
+	"math"
 	"math/big"
 )
 
@@ -21,6 +22,14 @@ type AppState struct {
 	Commission          Commission       `json:"commission,omitempty"`
 	CommissionVotes     []CommissionVote `json:"commission_votes,omitempty"`
 	UpdateVotes         []UpdateVote     `json:"update_votes,omitempty"`
+	Slots               []Slots          `json:"slots,omitempty"`
+	SlotsVotes          []SlotsVote      `json:"slots_votes,omitempty"`
+	Allowances          []Allowance      `json:"allowances,omitempty"`
+	ControlledCoins     []ControlledCoin `json:"controlled_coins,omitempty"`
+	Airdrops            []Airdrop        `json:"airdrops,omitempty"`
+	BLSKeys             []BLSKey         `json:"bls_keys,omitempty"`
+	Schedules           []Schedule       `json:"schedules,omitempty"`
+	HTLCs               []HTLC           `json:"htlcs,omitempty"`
 	UsedChecks          []UsedCheck      `json:"used_checks,omitempty"`
 	MaxGas              uint64           `json:"max_gas"`
 	TotalSlashed        string           `json:"total_slashed"`
@@ -80,6 +89,10 @@ func (s *AppState) Verify() error {
 
 		accounts[acc.Address] = struct{}{}
 
+		if acc.Signer != nil && (*acc.Signer == acc.Address || *acc.Signer == (Address{}) || acc.MultisigData != nil) {
+			return fmt.Errorf("not valid signer of account %s", acc.Address.String())
+		}
+
 		for _, bal := range acc.Balance {
 			if !helpers.IsValidBigInt(bal.Value) {
 				return fmt.Errorf("not valid balance for account %s", acc.Address.String())
@@ -144,6 +157,12 @@ func (s *AppState) Verify() error {
 			return fmt.Errorf("duplicated coin %s", coin.Symbol)
 		}
 
+		if coin.Metadata != nil {
+			if _, err := hex.DecodeString(coin.Metadata.IconHash); err != nil {
+				return fmt.Errorf("wrong icon hash of coin %s: %s", coin.Symbol, err)
+			}
+		}
+
 		coins[coin.ID] = struct{}{}
 
 		// check coins' volume
@@ -166,6 +185,25 @@ func (s *AppState) Verify() error {
 			}
 		}
 
+		for _, airdrop := range s.Airdrops {
+			if airdrop.Coin == coin.ID {
+				volume.Add(volume, helpers.StringToBigInt(airdrop.Value))
+			}
+		}
+
+		for _, schedule := range s.Schedules {
+			if schedule.Coin == coin.ID {
+				value := helpers.StringToBigInt(schedule.Value)
+				volume.Add(volume, value.Mul(value, new(big.Int).SetUint64(schedule.Count)))
+			}
+		}
+
+		for _, htlc := range s.HTLCs {
+			if htlc.Coin == coin.ID {
+				volume.Add(volume, helpers.StringToBigInt(htlc.Value))
+			}
+		}
+
 		if coin.Crr == 0 {
 			if volume.Cmp(helpers.StringToBigInt(coin.Volume)) != 0 {
 				return fmt.Errorf("wrong token %s volume (%s)", coin.Symbol.String(), big.NewInt(0).Sub(volume, helpers.StringToBigInt(coin.Volume)))
@@ -249,6 +287,167 @@ func (s *AppState) Verify() error {
 		}
 	}
 
+	// check slots schedule
+	for i, slots := range s.Slots {
+		if slots.Validators == 0 {
+			return fmt.Errorf("wrong validators slots count at height %d", slots.Height)
+		}
+
+		if slots.Candidates < slots.Validators {
+			return fmt.Errorf("candidates slots count is less than validators at height %d", slots.Height)
+		}
+
+		if i > 0 && slots.Height <= s.Slots[i-1].Height {
+			return fmt.Errorf("slots schedule is not sorted by height at height %d", slots.Height)
+		}
+	}
+
+	allowances := map[string]struct{}{}
+	for _, allowance := range s.Allowances {
+		if !helpers.IsValidBigInt(allowance.Value) || helpers.StringToBigInt(allowance.Value).Sign() != 1 {
+			return fmt.Errorf("wrong allowance value: %s", allowance.Value)
+		}
+
+		// check duplicated allowances
+		coinID := CoinID(allowance.Coin)
+		key := fmt.Sprintf("%s:%s:%s", allowance.Owner.String(), allowance.Spender.String(), coinID.String())
+		if _, exists := allowances[key]; exists {
+			return fmt.Errorf("duplicated allowance %s", key)
+		}
+		allowances[key] = struct{}{}
+
+		// check not existing coins
+		if !coinID.IsBaseCoin() {
+			foundCoin := false
+			for _, coin := range s.Coins {
+				if CoinID(coin.ID) == coinID {
+					foundCoin = true
+					break
+				}
+			}
+
+			if !foundCoin {
+				return fmt.Errorf("coin %s not found", coinID)
+			}
+		}
+	}
+
+	controlledCoins := map[CoinID]struct{}{}
+	for _, controlled := range s.ControlledCoins {
+		coinID := CoinID(controlled.Coin)
+		if _, exists := controlledCoins[coinID]; exists {
+			return fmt.Errorf("duplicated controlled coin %s", coinID)
+		}
+		controlledCoins[coinID] = struct{}{}
+
+		// check not existing and not token coins
+		foundCoin := false
+		for _, coin := range s.Coins {
+			if CoinID(coin.ID) == coinID && coin.Crr == 0 {
+				foundCoin = true
+				break
+			}
+		}
+
+		if !foundCoin {
+			return fmt.Errorf("controlled token %s not found", coinID)
+		}
+
+		for _, list := range [][]Address{controlled.Frozen, controlled.Blocklist} {
+			addresses := map[Address]struct{}{}
+			for _, address := range list {
+				if _, exists := addresses[address]; exists {
+					return fmt.Errorf("duplicated address %s of controlled coin %s", address, coinID)
+				}
+				addresses[address] = struct{}{}
+			}
+		}
+	}
+
+	airdrops := map[uint64]struct{}{}
+	for _, airdrop := range s.Airdrops {
+		if _, exists := airdrops[airdrop.ID]; exists {
+			return fmt.Errorf("duplicated airdrop %d", airdrop.ID)
+		}
+		airdrops[airdrop.ID] = struct{}{}
+
+		if _, exists := coins[airdrop.Coin]; !exists && airdrop.Coin != uint64(GetBaseCoinID()) {
+			return fmt.Errorf("coin %d of airdrop %d not found", airdrop.Coin, airdrop.ID)
+		}
+
+		if !helpers.IsValidBigInt(airdrop.Value) {
+			return fmt.Errorf("wrong value of airdrop %d: %s", airdrop.ID, airdrop.Value)
+		}
+
+		if root, err := hex.DecodeString(airdrop.Root); err != nil || len(root) != HashLength {
+			return fmt.Errorf("wrong root of airdrop %d: %s", airdrop.ID, airdrop.Root)
+		}
+
+		claimed := map[uint64]struct{}{}
+		for _, index := range airdrop.Claimed {
+			if _, exists := claimed[index]; exists {
+				return fmt.Errorf("duplicated claimed index %d of airdrop %d", index, airdrop.ID)
+			}
+			claimed[index] = struct{}{}
+		}
+	}
+
+	schedules := map[uint64]struct{}{}
+	for _, schedule := range s.Schedules {
+		if _, exists := schedules[schedule.ID]; exists {
+			return fmt.Errorf("duplicated schedule %d", schedule.ID)
+		}
+		schedules[schedule.ID] = struct{}{}
+
+		if _, exists := coins[schedule.Coin]; !exists && schedule.Coin != uint64(GetBaseCoinID()) {
+			return fmt.Errorf("coin %d of schedule %d not found", schedule.Coin, schedule.ID)
+		}
+
+		if !helpers.IsValidBigInt(schedule.Value) || helpers.StringToBigInt(schedule.Value).Sign() == 0 {
+			return fmt.Errorf("wrong value of schedule %d: %s", schedule.ID, schedule.Value)
+		}
+
+		if schedule.Count == 0 || schedule.Count > math.MaxUint32 {
+			return fmt.Errorf("wrong count of schedule %d: %d", schedule.ID, schedule.Count)
+		}
+
+		if schedule.Count > 1 && schedule.Interval == 0 {
+			return fmt.Errorf("wrong interval of schedule %d", schedule.ID)
+		}
+	}
+
+	htlcs := map[uint64]struct{}{}
+	for _, htlc := range s.HTLCs {
+		if _, exists := htlcs[htlc.ID]; exists {
+			return fmt.Errorf("duplicated htlc %d", htlc.ID)
+		}
+		htlcs[htlc.ID] = struct{}{}
+
+		if _, exists := coins[htlc.Coin]; !exists && htlc.Coin != uint64(GetBaseCoinID()) {
+			return fmt.Errorf("coin %d of htlc %d not found", htlc.Coin, htlc.ID)
+		}
+
+		if !helpers.IsValidBigInt(htlc.Value) || helpers.StringToBigInt(htlc.Value).Sign() == 0 {
+			return fmt.Errorf("wrong value of htlc %d: %s", htlc.ID, htlc.Value)
+		}
+
+		if hashLock, err := hex.DecodeString(htlc.HashLock); err != nil || len(hashLock) != HashLength {
+			return fmt.Errorf("wrong hash lock of htlc %d: %s", htlc.ID, htlc.HashLock)
+		}
+	}
+
+	blsKeys := map[Address]struct{}{}
+	for _, key := range s.BLSKeys {
+		if _, exists := blsKeys[key.Address]; exists {
+			return fmt.Errorf("duplicated BLS key of %s", key.Address)
+		}
+		blsKeys[key.Address] = struct{}{}
+
+		if b, err := hex.DecodeString(key.PublicKey); err != nil || len(b) != BLSPublicKeyLength {
+			return fmt.Errorf("wrong BLS key of %s", key.Address)
+		}
+	}
+
 	// check used checks length
 	for _, check := range s.UsedChecks {
 		b, err := hex.DecodeString(string(check))
@@ -284,6 +483,8 @@ type Candidate struct {
 	Status                   uint64  `json:"status"`
 	JailedUntil              uint64  `json:"jailed_until,omitempty"`
 	LastEditCommissionHeight uint64  `json:"last_edit_commission_height,omitempty"`
+	JailReason               uint64  `json:"jail_reason,omitempty"`
+	JailHeight               uint64  `json:"jail_height,omitempty"`
 }
 
 type Stake struct {
@@ -306,20 +507,29 @@ type Pool struct {
 	Reserve0 string `json:"reserve0"`
 	Reserve1 string `json:"reserve1"`
 	ID       uint64 `json:"id"`
+	Fee      uint32 `json:"fee,omitempty"`
 }
 
 type Coin struct {
-	ID           uint64     `json:"id"`
-	Name         string     `json:"name"`
-	Symbol       CoinSymbol `json:"symbol"`
-	Volume       string     `json:"volume"`
-	Crr          uint64     `json:"crr,omitempty"`
-	Reserve      string     `json:"reserve,omitempty"`
-	MaxSupply    string     `json:"max_supply"`
-	Version      uint64     `json:"version,omitempty"`
-	OwnerAddress *Address   `json:"owner_address,omitempty"`
-	Mintable     bool       `json:"mintable,omitempty"`
-	Burnable     bool       `json:"burnable,omitempty"`
+	ID           uint64        `json:"id"`
+	Name         string        `json:"name"`
+	Symbol       CoinSymbol    `json:"symbol"`
+	Volume       string        `json:"volume"`
+	Crr          uint64        `json:"crr,omitempty"`
+	Reserve      string        `json:"reserve,omitempty"`
+	MaxSupply    string        `json:"max_supply"`
+	Version      uint64        `json:"version,omitempty"`
+	OwnerAddress *Address      `json:"owner_address,omitempty"`
+	Mintable     bool          `json:"mintable,omitempty"`
+	Burnable     bool          `json:"burnable,omitempty"`
+	Metadata     *CoinMetadata `json:"metadata,omitempty"`
+}
+
+type CoinMetadata struct {
+	URL         string `json:"url,omitempty"`
+	Description string `json:"description,omitempty"`
+	IconHash    string `json:"icon_hash,omitempty"`
+	Decimals    uint32 `json:"decimals,omitempty"`
 }
 
 type FrozenFund struct {
@@ -339,6 +549,7 @@ type Account struct {
 	Balance      []Balance `json:"balance,omitempty"`
 	Nonce        uint64    `json:"nonce"`
 	MultisigData *Multisig `json:"multisig_data,omitempty"`
+	Signer       *Address  `json:"signer,omitempty"`
 }
 
 type Balance struct {
@@ -368,6 +579,68 @@ type UpdateVote struct {
 	Version string   `json:"version"`
 }
 
+type Slots struct {
+	Height     uint64 `json:"height"`
+	Validators uint64 `json:"validators"`
+	Candidates uint64 `json:"candidates"`
+}
+
+type SlotsVote struct {
+	Height     uint64   `json:"height"`
+	Votes      []Pubkey `json:"votes"`
+	Validators uint64   `json:"validators"`
+	Candidates uint64   `json:"candidates"`
+}
+
+type Allowance struct {
+	Owner   Address `json:"owner"`
+	Spender Address `json:"spender"`
+	Coin    uint64  `json:"coin"`
+	Value   string  `json:"value"`
+}
+
+type ControlledCoin struct {
+	Coin      uint64    `json:"coin"`
+	Frozen    []Address `json:"frozen,omitempty"`
+	Blocklist []Address `json:"blocklist,omitempty"`
+}
+
+type Airdrop struct {
+	ID           uint64   `json:"id"`
+	Creator      Address  `json:"creator"`
+	Coin         uint64   `json:"coin"`
+	Root         string   `json:"root"`
+	Value        string   `json:"value"`
+	ExpireHeight uint64   `json:"expire_height"`
+	Claimed      []uint64 `json:"claimed,omitempty"`
+}
+
+type Schedule struct {
+	ID         uint64  `json:"id"`
+	Owner      Address `json:"owner"`
+	Recipient  Address `json:"recipient"`
+	Coin       uint64  `json:"coin"`
+	Value      string  `json:"value"`
+	NextHeight uint64  `json:"next_height"`
+	Interval   uint64  `json:"interval"`
+	Count      uint64  `json:"count"`
+}
+
+type HTLC struct {
+	ID        uint64  `json:"id"`
+	Sender    Address `json:"sender"`
+	Recipient Address `json:"recipient"`
+	Coin      uint64  `json:"coin"`
+	Value     string  `json:"value"`
+	HashLock  string  `json:"hash_lock"`
+	Timeout   uint64  `json:"timeout"`
+}
+
+type BLSKey struct {
+	Address   Address `json:"address"`
+	PublicKey string  `json:"public_key"`
+}
+
 type Commission struct {
 	Coin                    uint64 `json:"coin"`
 	PayloadByte             string `json:"payload_byte"`
@@ -21,6 +21,7 @@ const (
 	PubKeyLength            = 32
 	CoinSymbolLength        = 10
 	TendermintAddressLength = 20
+	BLSPublicKeyLength      = 128
 )
 
 const (
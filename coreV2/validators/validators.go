@@ -1,11 +1,13 @@
 package validators
 
-// GetValidatorsCountForBlock returns available validators slots for given height
+// GetValidatorsCountForBlock returns default validators slots for given height,
+// it is used until the slots schedule in state has an entry for the height
 func GetValidatorsCountForBlock(block uint64) int {
 	return 64
 }
 
-// GetCandidatesCountForBlock returns available candidates slots for given height
+// GetCandidatesCountForBlock returns default candidates slots for given height,
+// it is used until the slots schedule in state has an entry for the height
 func GetCandidatesCountForBlock(block uint64) int {
 	return 192
 }
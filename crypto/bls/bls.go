@@ -0,0 +1,199 @@
+// Package bls implements BLS signatures over the bn256 curve.
+//
+// Public keys are points of G2 and signatures are points of G1, so signatures
+// of many signers of the same message are aggregated into one 64 bytes point.
+// Keys should be registered with the proof of possession to prevent rogue key attacks.
+package bls
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math/big"
+
+	"github.com/MinterTeam/minter-go-node/coreV2/types"
+	"github.com/MinterTeam/minter-go-node/crypto"
+	bn256 "github.com/MinterTeam/minter-go-node/crypto/bn256/cloudflare"
+)
+
+const (
+	PublicKeyLength = types.BLSPublicKeyLength
+	SignatureLength = 64
+)
+
+var (
+	ErrInvalidPublicKey = errors.New("bls: invalid public key")
+	ErrInvalidSignature = errors.New("bls: invalid signature")
+)
+
+var (
+	signatureDomain  = []byte("MINTER_BLS_SIG")
+	possessionDomain = []byte("MINTER_BLS_POP")
+)
+
+// GenerateKey returns a random secret key
+func GenerateKey(r io.Reader) (*big.Int, error) {
+	if r == nil {
+		r = rand.Reader
+	}
+	for {
+		k, err := rand.Int(r, bn256.Order)
+		if err != nil {
+			return nil, err
+		}
+		if k.Sign() > 0 {
+			return k, nil
+		}
+	}
+}
+
+// PublicKey returns the marshaled public key of the secret key
+func PublicKey(secret *big.Int) []byte {
+	return new(bn256.G2).ScalarBaseMult(secret).Marshal()
+}
+
+// Sign signs the message with the secret key
+func Sign(secret *big.Int, message []byte) []byte {
+	return new(bn256.G1).ScalarMult(hashToG1(signatureDomain, message), secret).Marshal()
+}
+
+// Aggregate sums signatures of the same message into one signature
+func Aggregate(signatures [][]byte) ([]byte, error) {
+	if len(signatures) == 0 {
+		return nil, ErrInvalidSignature
+	}
+
+	var sum *bn256.G1
+	for _, signature := range signatures {
+		point, err := unmarshalG1(signature)
+		if err != nil {
+			return nil, err
+		}
+		if sum == nil {
+			sum = point
+			continue
+		}
+		sum.Add(sum, point)
+	}
+
+	return sum.Marshal(), nil
+}
+
+// Verify checks the aggregated signature of the message by all given public keys
+func Verify(publicKeys [][]byte, message, signature []byte) bool {
+	if len(publicKeys) == 0 {
+		return false
+	}
+
+	var sum *bn256.G2
+	for _, publicKey := range publicKeys {
+		point, err := unmarshalG2(publicKey)
+		if err != nil {
+			return false
+		}
+		if sum == nil {
+			sum = point
+			continue
+		}
+		sum.Add(sum, point)
+	}
+
+	return verify(sum, hashToG1(signatureDomain, message), signature)
+}
+
+// ProofOfPossession signs the public key and the address it is registered for
+func ProofOfPossession(secret *big.Int, address []byte) []byte {
+	return new(bn256.G1).ScalarMult(hashToG1(possessionDomain, possessionMessage(PublicKey(secret), address)), secret).Marshal()
+}
+
+// VerifyProofOfPossession checks that the public key is valid and its owner registers it for the address
+func VerifyProofOfPossession(publicKey, address, proof []byte) bool {
+	point, err := unmarshalG2(publicKey)
+	if err != nil {
+		return false
+	}
+
+	return verify(point, hashToG1(possessionDomain, possessionMessage(publicKey, address)), proof)
+}
+
+// verify checks e(signature, g2) == e(hash, publicKey)
+func verify(publicKey *bn256.G2, hash *bn256.G1, signature []byte) bool {
+	point, err := unmarshalG1(signature)
+	if err != nil {
+		return false
+	}
+
+	g2 := new(bn256.G2).ScalarBaseMult(big.NewInt(1))
+	return bn256.PairingCheck([]*bn256.G1{point, new(bn256.G1).Neg(hash)}, []*bn256.G2{g2, publicKey})
+}
+
+func possessionMessage(publicKey, address []byte) []byte {
+	return append(append([]byte{}, publicKey...), address...)
+}
+
+// hashToG1 maps the message to the point of G1 by try-and-increment, the cofactor of G1 is 1
+func hashToG1(domain, message []byte) *bn256.G1 {
+	// (P+1)/4 is the exponent of the square root since P = 3 mod 4
+	sqrtExp := new(big.Int).Rsh(new(big.Int).Add(bn256.P, big.NewInt(1)), 2)
+	three := big.NewInt(3)
+
+	counter := make([]byte, 4)
+	for i := uint32(0); ; i++ {
+		binary.BigEndian.PutUint32(counter, i)
+		x := new(big.Int).SetBytes(crypto.Keccak256(domain, message, counter))
+		x.Mod(x, bn256.P)
+
+		// y² = x³ + 3
+		y2 := new(big.Int).Exp(x, three, bn256.P)
+		y2.Add(y2, three).Mod(y2, bn256.P)
+		y := new(big.Int).Exp(y2, sqrtExp, bn256.P)
+		if new(big.Int).Exp(y, big.NewInt(2), bn256.P).Cmp(y2) != 0 {
+			continue
+		}
+
+		m := make([]byte, 64)
+		x.FillBytes(m[:32])
+		y.FillBytes(m[32:])
+		point := new(bn256.G1)
+		if _, err := point.Unmarshal(m); err != nil {
+			continue
+		}
+		return point
+	}
+}
+
+func unmarshalG1(b []byte) (*bn256.G1, error) {
+	if len(b) != SignatureLength {
+		return nil, ErrInvalidSignature
+	}
+	point := new(bn256.G1)
+	if _, err := point.Unmarshal(b); err != nil {
+		return nil, ErrInvalidSignature
+	}
+	return point, nil
+}
+
+// unmarshalG2 also checks that the point is in the subgroup of G2 of prime order
+func unmarshalG2(b []byte) (*bn256.G2, error) {
+	if len(b) != PublicKeyLength {
+		return nil, ErrInvalidPublicKey
+	}
+	point := new(bn256.G2)
+	if _, err := point.Unmarshal(b); err != nil {
+		return nil, ErrInvalidPublicKey
+	}
+	if isInfinity(point) || !isInfinity(new(bn256.G2).ScalarMult(point, bn256.Order)) {
+		return nil, ErrInvalidPublicKey
+	}
+	return point, nil
+}
+
+func isInfinity(point *bn256.G2) bool {
+	for _, b := range point.Marshal() {
+		if b != 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,61 @@
+package bls
+
+import (
+	"testing"
+)
+
+func TestAggregateSignature(t *testing.T) {
+	message := []byte("message")
+
+	var publicKeys, signatures [][]byte
+	for i := 0; i < 3; i++ {
+		secret, err := GenerateKey(nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		publicKeys = append(publicKeys, PublicKey(secret))
+		signatures = append(signatures, Sign(secret, message))
+	}
+
+	signature, err := Aggregate(signatures)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !Verify(publicKeys, message, signature) {
+		t.Fatal("aggregated signature is not valid")
+	}
+	if Verify(publicKeys, []byte("other message"), signature) {
+		t.Fatal("aggregated signature is valid for other message")
+	}
+	if Verify(publicKeys[:2], message, signature) {
+		t.Fatal("aggregated signature is valid without signer")
+	}
+
+	partial, err := Aggregate(signatures[:2])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !Verify(publicKeys[:2], message, partial) {
+		t.Fatal("partial aggregated signature is not valid")
+	}
+}
+
+func TestProofOfPossession(t *testing.T) {
+	secret, err := GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	publicKey := PublicKey(secret)
+	address := []byte{1, 2, 3}
+
+	proof := ProofOfPossession(secret, address)
+	if !VerifyProofOfPossession(publicKey, address, proof) {
+		t.Fatal("proof of possession is not valid")
+	}
+	if VerifyProofOfPossession(publicKey, []byte{1, 2, 4}, proof) {
+		t.Fatal("proof of possession is valid for other address")
+	}
+	if VerifyProofOfPossession(make([]byte, PublicKeyLength), address, proof) {
+		t.Fatal("proof of possession is valid for infinity public key")
+	}
+}
@@ -0,0 +1,61 @@
+// Package envelope implements encrypted transaction payloads.
+//
+// The envelope is the magic prefix, the version byte and the ECIES ciphertext
+// of the message for the secp256k1 public key of the recipient. The prefix and
+// the version are authenticated by the MAC of the ciphertext.
+package envelope
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"errors"
+	"io"
+
+	"github.com/MinterTeam/minter-go-node/crypto/ecies"
+)
+
+// Version1 is ECIES with AES-128-CTR and HMAC-SHA-256
+const Version1 = byte(1)
+
+// Prefix marks the payload as the encrypted envelope
+var Prefix = []byte{0xEC, 0x1E}
+
+var (
+	ErrNotEnvelope        = errors.New("envelope: payload is not encrypted envelope")
+	ErrUnsupportedVersion = errors.New("envelope: unsupported version")
+)
+
+// IsEnvelope returns true if the payload starts with the envelope prefix
+func IsEnvelope(payload []byte) bool {
+	return len(payload) > len(Prefix) && bytes.HasPrefix(payload, Prefix)
+}
+
+// Seal encrypts the message to the public key of the recipient
+func Seal(rand io.Reader, recipient *ecdsa.PublicKey, message []byte) ([]byte, error) {
+	header := append(append([]byte{}, Prefix...), Version1)
+
+	pub := ecies.ImportECDSAPublic(recipient)
+	pub.Params = ecies.ECIES_AES128_SHA256
+	ct, err := ecies.Encrypt(rand, pub, message, nil, header)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(header, ct...), nil
+}
+
+// Open decrypts the envelope with the private key of the recipient
+func Open(recipient *ecdsa.PrivateKey, payload []byte) ([]byte, error) {
+	if !IsEnvelope(payload) {
+		return nil, ErrNotEnvelope
+	}
+
+	header := payload[:len(Prefix)+1]
+	if version := header[len(Prefix)]; version != Version1 {
+		return nil, ErrUnsupportedVersion
+	}
+
+	prv := ecies.ImportECDSA(recipient)
+	prv.PublicKey.Params = ecies.ECIES_AES128_SHA256
+	return prv.Decrypt(payload[len(header):], nil, header)
+}
@@ -0,0 +1,40 @@
+package envelope
+
+import (
+	"bytes"
+	"crypto/rand"
+	"testing"
+
+	"github.com/MinterTeam/minter-go-node/crypto"
+)
+
+func TestEnvelope(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	other, _ := crypto.GenerateKey()
+	message := []byte("memo 123456")
+
+	payload, err := Seal(rand.Reader, &key.PublicKey, message)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !IsEnvelope(payload) || IsEnvelope(message) {
+		t.Fatal("wrong envelope detection")
+	}
+
+	opened, err := Open(key, payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(opened, message) {
+		t.Fatalf("wrong decrypted message %q", opened)
+	}
+
+	if _, err := Open(other, payload); err == nil {
+		t.Fatal("envelope is opened with other key")
+	}
+
+	payload[len(Prefix)] = 2
+	if _, err := Open(key, payload); err != ErrUnsupportedVersion {
+		t.Fatalf("wrong error %v", err)
+	}
+}
@@ -0,0 +1,111 @@
+package hd
+
+import (
+	"crypto/ecdsa"
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/MinterTeam/minter-go-node/crypto"
+)
+
+// HardenedOffset is added to the index of hardened child keys
+const HardenedOffset uint32 = 0x80000000
+
+// DefaultPath is the BIP-44 path of the first key of Minter wallets, which use the coin type of Ethereum
+const DefaultPath = "m/44'/60'/0'/0/0"
+
+var ErrInvalidKey = errors.New("derived key is invalid, use next index")
+
+// AccountPath returns the BIP-44 path of the key with the index in Minter wallets
+func AccountPath(index uint32) string {
+	return fmt.Sprintf("m/44'/60'/0'/0/%d", index)
+}
+
+// ParsePath parses the derivation path like m/44'/60'/0'/0/0
+func ParsePath(path string) ([]uint32, error) {
+	parts := strings.Split(strings.TrimSpace(path), "/")
+	if len(parts) == 0 || parts[0] != "m" {
+		return nil, fmt.Errorf("invalid derivation path %q", path)
+	}
+
+	indexes := make([]uint32, 0, len(parts)-1)
+	for _, part := range parts[1:] {
+		var offset uint32
+		if strings.HasSuffix(part, "'") {
+			offset = HardenedOffset
+			part = strings.TrimSuffix(part, "'")
+		}
+
+		index, err := strconv.ParseUint(part, 10, 31)
+		if err != nil {
+			return nil, fmt.Errorf("invalid derivation path %q: %s", path, err)
+		}
+		indexes = append(indexes, uint32(index)+offset)
+	}
+
+	return indexes, nil
+}
+
+// DeriveKey derives the private key of the path from the seed
+func DeriveKey(seed []byte, path []uint32) (*ecdsa.PrivateKey, error) {
+	i := hmacSHA512([]byte("Bitcoin seed"), seed)
+	key, err := crypto.ToECDSA(i[:32])
+	if err != nil {
+		return nil, ErrInvalidKey
+	}
+	chainCode := i[32:]
+
+	n := crypto.S256().Params().N
+	for _, index := range path {
+		data := make([]byte, 0, 37)
+		if index >= HardenedOffset {
+			data = append(data, 0)
+			data = append(data, key.D.FillBytes(make([]byte, 32))...)
+		} else {
+			data = append(data, crypto.CompressPubkey(&key.PublicKey)...)
+		}
+		data = append(data, make([]byte, 4)...)
+		binary.BigEndian.PutUint32(data[len(data)-4:], index)
+
+		i = hmacSHA512(chainCode, data)
+		tweak := new(big.Int).SetBytes(i[:32])
+		if tweak.Cmp(n) >= 0 {
+			return nil, ErrInvalidKey
+		}
+
+		d := tweak.Add(tweak, key.D)
+		if key, err = crypto.ToECDSA(d.Mod(d, n).FillBytes(make([]byte, 32))); err != nil {
+			return nil, ErrInvalidKey
+		}
+		chainCode = i[32:]
+	}
+
+	return key, nil
+}
+
+// DeriveKeyFromMnemonic derives the private key of the path from the mnemonic and its passphrase
+func DeriveKeyFromMnemonic(mnemonic, passphrase, path string) (*ecdsa.PrivateKey, error) {
+	indexes, err := ParsePath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	seed, err := NewSeed(mnemonic, passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	return DeriveKey(seed, indexes)
+}
+
+func hmacSHA512(key, data []byte) []byte {
+	mac := hmac.New(sha512.New, key)
+	mac.Write(data)
+	return mac.Sum(nil)
+}
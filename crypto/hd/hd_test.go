@@ -0,0 +1,98 @@
+package hd
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/MinterTeam/minter-go-node/crypto"
+)
+
+func TestMnemonic(t *testing.T) {
+	t.Parallel()
+	vectors := []struct {
+		entropy  string
+		mnemonic string
+	}{
+		{"00000000000000000000000000000000", "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"},
+		{"7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f", "legal winner thank year wave sausage worth useful legal winner thank yellow"},
+		{"ffffffffffffffffffffffffffffffff", "zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo wrong"},
+		{"9e885d952ad362caeb4efe34a8e91bd2", "ozone drill grab fiber curtain grace pudding thank cruise elder eight picnic"},
+		{"b63a9c59a6e641f288ebc103017f1da9f8290b3da6bdef7b", "renew stay biology evidence goat welcome casual join adapt armor shuffle fault little machine walk stumble urge swap"},
+		{"f585c11aec520db57dd353c69554b21a89b20fb0650966fa0a9d6f74fd989d8f", "void come effort suffer camp survey warrior heavy shoot primary clutch crush open amazing screen patrol group space point ten exist slush involve unfold"},
+	}
+
+	for _, vector := range vectors {
+		entropy, _ := hex.DecodeString(vector.entropy)
+		mnemonic, err := NewMnemonic(entropy)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if mnemonic != vector.mnemonic {
+			t.Fatalf("wrong mnemonic of %s: %s", vector.entropy, mnemonic)
+		}
+
+		decoded, err := MnemonicToEntropy(mnemonic)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if hex.EncodeToString(decoded) != vector.entropy {
+			t.Fatalf("wrong entropy of %s: %x", vector.mnemonic, decoded)
+		}
+	}
+
+	seed, err := NewSeed(vectors[0].mnemonic, "TREZOR")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hex.EncodeToString(seed) != "c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04" {
+		t.Fatalf("wrong seed %x", seed)
+	}
+
+	if _, err := MnemonicToEntropy("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon"); !errors.Is(err, ErrInvalidChecksum) {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if _, err := MnemonicToEntropy("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon minter"); !errors.Is(err, ErrInvalidMnemonic) {
+		t.Fatalf("unexpected error %v", err)
+	}
+}
+
+func TestDeriveKey(t *testing.T) {
+	t.Parallel()
+	seed, _ := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+
+	vectors := []struct {
+		path string
+		key  string
+	}{
+		{"m", "e8f32e723decf4051aefac8e2c93c9c5b214313817cdb01a1494b917c8436b35"},
+		{"m/0'", "edb2e14f9ee77d26dd93b4ecede8d16ed408ce149b6cd80b0715a2d911a0afea"},
+		{"m/0'/1/2'/2/1000000000", "471b76e389e528d6de6d816857e012c5455051cad6660850e58372a6c3e6e7c8"},
+	}
+
+	for _, vector := range vectors {
+		path, err := ParsePath(vector.path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		key, err := DeriveKey(seed, path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if hex.EncodeToString(crypto.FromECDSA(key)) != vector.key {
+			t.Fatalf("wrong key of path %s: %x", vector.path, crypto.FromECDSA(key))
+		}
+	}
+
+	key, err := DeriveKeyFromMnemonic("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about", "", DefaultPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if address := crypto.PubkeyToAddress(key.PublicKey).String(); address != "Mx9858effd232b4033e47d90003d41ec34ecaeda94" {
+		t.Fatalf("wrong address of default path %s", address)
+	}
+
+	if _, err := ParsePath("m/44'/60'/x"); err == nil {
+		t.Fatal("invalid path is parsed")
+	}
+}
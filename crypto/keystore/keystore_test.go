@@ -0,0 +1,32 @@
+package keystore
+
+import (
+	"testing"
+
+	"github.com/MinterTeam/minter-go-node/crypto"
+)
+
+func TestKeystore(t *testing.T) {
+	t.Parallel()
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keyJSON, err := EncryptKey(key, "passphrase", LightScryptN, LightScryptP)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := DecryptKey(keyJSON, "wrong"); err != ErrDecrypt {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	decrypted, err := DecryptKey(keyJSON, "passphrase")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decrypted.D.Cmp(key.D) != 0 {
+		t.Fatal("decrypted key is not equal to encrypted one")
+	}
+}
@@ -131,6 +131,73 @@ func CalculateSaleAmount(supply *big.Int, reserve *big.Int, crr uint32, wantRece
 	return result
 }
 
+// CalculatePrice returns marginal price of a coin in BIP at given supply and reserve
+// Price = reserve / (supply * crr / 100)
+func CalculatePrice(supply *big.Int, reserve *big.Int, crr uint32) *big.Float {
+	tSupply := newFloat(0).SetInt(supply)
+	tReserve := newFloat(0).SetInt(reserve)
+
+	res := newFloat(0).Mul(tSupply, newFloat(float64(crr)/100)) // supply * crr / 100
+	res.Quo(tReserve, res)                                      // reserve / (supply * crr / 100)
+
+	return res
+}
+
+// CalculateReserve returns reserve of a coin after its supply is changed to newSupply along the curve
+// Reserve = reserve * (newSupply / supply) ^ (100 / crr)
+func CalculateReserve(supply *big.Int, reserve *big.Int, crr uint32, newSupply *big.Int) *big.Int {
+	if newSupply.Sign() == 0 {
+		return big.NewInt(0)
+	}
+
+	if crr == 100 {
+		result := big.NewInt(0).Mul(reserve, newSupply)
+
+		return result.Div(result, supply)
+	}
+
+	tSupply := newFloat(0).SetInt(supply)
+	tReserve := newFloat(0).SetInt(reserve)
+	tNewSupply := newFloat(0).SetInt(newSupply)
+
+	res := newFloat(0).Quo(tNewSupply, tSupply)     // newSupply / supply
+	res = math.Pow(res, newFloat(100/float64(crr))) // (newSupply / supply) ^ (100 / crr)
+	res.Mul(res, tReserve)                          // reserve * (newSupply / supply) ^ (100 / crr)
+
+	result, _ := res.Int(nil)
+
+	return result
+}
+
+// CalculateReserveForPrice returns supply and reserve of a coin at which its marginal price equals to given price.
+// Price of a coin with crr 100 does not depend on supply, so nil values are returned for it.
+// Reserve = reserve * (price / currentPrice) ^ (100 / (100 - crr))
+// Supply = supply * (price / currentPrice) ^ (crr / (100 - crr))
+func CalculateReserveForPrice(supply *big.Int, reserve *big.Int, crr uint32, price *big.Float) (newSupply *big.Int, newReserve *big.Int) {
+	if crr == 100 {
+		return nil, nil
+	}
+
+	if price.Sign() == 0 {
+		return big.NewInt(0), big.NewInt(0)
+	}
+
+	tSupply := newFloat(0).SetInt(supply)
+	tReserve := newFloat(0).SetInt(reserve)
+
+	ratio := newFloat(0).Quo(price, CalculatePrice(supply, reserve, crr)) // price / currentPrice
+
+	res := math.Pow(ratio, newFloat(100/float64(100-crr))) // (price / currentPrice) ^ (100 / (100 - crr))
+	res.Mul(res, tReserve)                                 // reserve * (price / currentPrice) ^ (100 / (100 - crr))
+	newReserve, _ = res.Int(nil)
+
+	res = math.Pow(ratio, newFloat(float64(crr)/float64(100-crr))) // (price / currentPrice) ^ (crr / (100 - crr))
+	res.Mul(res, tSupply)                                          // supply * (price / currentPrice) ^ (crr / (100 - crr))
+	newSupply, _ = res.Int(nil)
+
+	return newSupply, newReserve
+}
+
 func newFloat(x float64) *big.Float {
 	return big.NewFloat(x).SetPrec(precision)
 }
@@ -186,3 +186,63 @@ func TestCalculateBuyDeposit(t *testing.T) {
 		}
 	}
 }
+
+func TestCalculatePrice(t *testing.T) {
+	price := CalculatePrice(big.NewInt(1000000), big.NewInt(100000), 50)
+	if result, _ := price.Float64(); result != 0.2 {
+		t.Errorf("CalculatePrice result is not correct. Expected 0.2, got %s", price)
+	}
+}
+
+type CalculateReserveData struct {
+	Supply    *big.Int
+	Reserve   *big.Int
+	Crr       uint32
+	NewSupply *big.Int
+	Result    *big.Int
+}
+
+func TestCalculateReserve(t *testing.T) {
+	data := []CalculateReserveData{
+		{
+			Supply:    big.NewInt(1000000),
+			Reserve:   big.NewInt(100000),
+			Crr:       50,
+			NewSupply: big.NewInt(2000000),
+			Result:    big.NewInt(400000),
+		},
+		{
+			Supply:    big.NewInt(1000000),
+			Reserve:   big.NewInt(100000),
+			Crr:       100,
+			NewSupply: big.NewInt(500000),
+			Result:    big.NewInt(50000),
+		},
+		{
+			Supply:    big.NewInt(1000000),
+			Reserve:   big.NewInt(100000),
+			Crr:       50,
+			NewSupply: big.NewInt(0),
+			Result:    big.NewInt(0),
+		},
+	}
+
+	for _, item := range data {
+		result := CalculateReserve(item.Supply, item.Reserve, item.Crr, item.NewSupply)
+
+		if result.Cmp(item.Result) != 0 {
+			t.Errorf("CalculateReserve result is not correct. Expected %s, got %s", item.Result, result)
+		}
+	}
+}
+
+func TestCalculateReserveForPrice(t *testing.T) {
+	supply, reserve := CalculateReserveForPrice(big.NewInt(1000000), big.NewInt(100000), 50, big.NewFloat(0.4))
+	if supply.Cmp(big.NewInt(2000000)) != 0 || reserve.Cmp(big.NewInt(400000)) != 0 {
+		t.Errorf("CalculateReserveForPrice result is not correct. Expected 2000000 and 400000, got %s and %s", supply, reserve)
+	}
+
+	if supply, reserve := CalculateReserveForPrice(big.NewInt(1000000), big.NewInt(100000), 100, big.NewFloat(0.4)); supply != nil || reserve != nil {
+		t.Errorf("CalculateReserveForPrice result should be nil for crr 100")
+	}
+}
@@ -6,10 +6,16 @@ import (
 	"github.com/tendermint/tendermint/libs/log"
 	"io"
 	"os"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
 )
 
+const defaultLogLevel = "info"
+
 // NewLogger returns a logger based on given config
-func NewLogger(cfg *config.Config) log.Logger {
+func NewLogger(cfg *config.Config) *Logger {
 	var dest io.Writer = os.Stdout
 
 	if cfg.LogPath != "stdout" {
@@ -33,11 +39,148 @@ func NewLogger(cfg *config.Config) log.Logger {
 		panic("unsupported log format")
 	}
 
-	l, err := flags.ParseLogLevel(cfg.LogLevel, l, "info")
+	logger := &Logger{root: &levelFilter{base: l}}
+	if err := logger.SetLevel(cfg.LogLevel); err != nil {
+		panic(err)
+	}
+
+	return logger
+}
+
+// levelFilter holds the unfiltered logger and the filter built from the current log level
+type levelFilter struct {
+	lock    sync.Mutex
+	base    log.Logger
+	current atomic.Value // *filter
+}
+
+// filter is the logger filtered by the level, it is replaced as a whole on every level change
+type filter struct {
+	logger log.Logger
+	level  string
+}
+
+func (f *levelFilter) load() *filter {
+	current, _ := f.current.Load().(*filter)
+	return current
+}
+
+// Logger is a log.Logger whose module log filters can be changed at runtime.
+// All loggers derived from it with With follow the changes.
+type Logger struct {
+	root    *levelFilter
+	keyvals [][]interface{}
+	cache   atomic.Value // *cachedLogger
+}
+
+// cachedLogger is the filtered logger with applied keyvals, it is valid while the filter is current
+type cachedLogger struct {
+	filter *filter
+	logger log.Logger
+}
+
+// SetLevel replaces log filters with given level, e.g. "consensus:debug,*:error"
+func (l *Logger) SetLevel(level string) error {
+	l.root.lock.Lock()
+	defer l.root.lock.Unlock()
 
+	filtered, err := flags.ParseLogLevel(level, l.root.base, defaultLogLevel)
 	if err != nil {
-		panic(err)
+		return err
+	}
+
+	l.root.current.Store(&filter{logger: filtered, level: level})
+
+	return nil
+}
+
+// SetModuleLevel changes log filter only for given module, other modules keep their levels
+func (l *Logger) SetModuleLevel(module, level string) error {
+	return l.SetLevel(mergeModuleLevel(l.Level(), module, level))
+}
+
+// Level returns current log level
+func (l *Logger) Level() string {
+	if current := l.root.load(); current != nil {
+		return current.level
+	}
+	return ""
+}
+
+// current returns the filtered logger with keyvals of l, which is built once after each level change
+func (l *Logger) current() log.Logger {
+	current := l.root.load()
+	if cached, ok := l.cache.Load().(*cachedLogger); ok && cached.filter == current {
+		return cached.logger
+	}
+
+	// apply keyvals one by one, the filter uses the first matched module in each call
+	logger := current.logger
+	for _, keyvals := range l.keyvals {
+		logger = logger.With(keyvals...)
+	}
+	l.cache.Store(&cachedLogger{filter: current, logger: logger})
+
+	return logger
+}
+
+// Debug implements log.Logger
+func (l *Logger) Debug(msg string, keyvals ...interface{}) {
+	l.current().Debug(msg, keyvals...)
+}
+
+// Info implements log.Logger
+func (l *Logger) Info(msg string, keyvals ...interface{}) {
+	l.current().Info(msg, keyvals...)
+}
+
+// Error implements log.Logger
+func (l *Logger) Error(msg string, keyvals ...interface{}) {
+	l.current().Error(msg, keyvals...)
+}
+
+// With implements log.Logger
+func (l *Logger) With(keyvals ...interface{}) log.Logger {
+	withKeyvals := make([][]interface{}, len(l.keyvals), len(l.keyvals)+1)
+	copy(withKeyvals, l.keyvals)
+
+	return &Logger{
+		root:    l.root,
+		keyvals: append(withKeyvals, keyvals),
+	}
+}
+
+func mergeModuleLevel(current, module, level string) string {
+	if !strings.Contains(current, ":") {
+		current = "*:" + current
+	}
+
+	levels := map[string]string{}
+	for _, item := range strings.Split(current, ",") {
+		moduleAndLevel := strings.Split(item, ":")
+		if len(moduleAndLevel) != 2 {
+			continue
+		}
+		levels[moduleAndLevel[0]] = moduleAndLevel[1]
+	}
+	levels[module] = level
+
+	modules := make([]string, 0, len(levels))
+	for m := range levels {
+		if m == "*" {
+			continue
+		}
+		modules = append(modules, m)
+	}
+	sort.Strings(modules)
+
+	list := make([]string, 0, len(levels))
+	for _, m := range modules {
+		list = append(list, m+":"+levels[m])
+	}
+	if l, ok := levels["*"]; ok {
+		list = append(list, "*:"+l)
 	}
 
-	return l
+	return strings.Join(list, ",")
 }
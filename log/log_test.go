@@ -0,0 +1,65 @@
+package log
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+func TestLogger_SetModuleLevel(t *testing.T) {
+	buf := new(bytes.Buffer)
+	logger := &Logger{root: &levelFilter{base: log.NewTMLogger(buf)}}
+	if err := logger.SetLevel("consensus:info,*:error"); err != nil {
+		t.Fatal(err)
+	}
+
+	consensus := logger.With("module", "consensus")
+	state := logger.With("module", "state")
+
+	state.Info("hidden")
+	consensus.Debug("hidden")
+	if buf.Len() != 0 {
+		t.Fatalf("unexpected output: %s", buf.String())
+	}
+
+	if err := logger.SetModuleLevel("state", "debug"); err != nil {
+		t.Fatal(err)
+	}
+	if logger.Level() != "consensus:info,state:debug,*:error" {
+		t.Fatalf("unexpected level %s", logger.Level())
+	}
+
+	state.Debug("visible")
+	if !strings.Contains(buf.String(), "visible") {
+		t.Fatal("derived logger did not follow level change")
+	}
+
+	if err := logger.SetLevel("consensus:verbose"); err == nil {
+		t.Fatal("expected error for invalid level")
+	}
+	if logger.Level() != "consensus:info,state:debug,*:error" {
+		t.Fatalf("level changed after error: %s", logger.Level())
+	}
+}
+
+func TestLogger_CachesFilteredLogger(t *testing.T) {
+	logger := &Logger{root: &levelFilter{base: log.NewNopLogger()}}
+	if err := logger.SetLevel("info"); err != nil {
+		t.Fatal(err)
+	}
+
+	consensus := logger.With("module", "consensus").(*Logger)
+	first := consensus.current()
+	if consensus.current() != first {
+		t.Fatal("filtered logger is built again without level change")
+	}
+
+	if err := logger.SetLevel("debug"); err != nil {
+		t.Fatal(err)
+	}
+	if consensus.current() == first {
+		t.Fatal("filtered logger is not rebuilt after level change")
+	}
+}
@@ -20,6 +20,7 @@ type MTree interface {
 
 	DeleteVersion(version int64) error
 	DeleteVersionsRange(fromVersion, toVersion int64) error
+	LoadVersionForOverwriting(version int64) error
 
 	AvailableVersions() []int
 	Version() int64
@@ -133,6 +134,15 @@ func (t *mutableTree) DeleteVersion(version int64) error {
 	return t.tree.DeleteVersion(version)
 }
 
+// LoadVersionForOverwriting loads given version and deletes all versions after it
+func (t *mutableTree) LoadVersionForOverwriting(version int64) error {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	_, err := t.tree.LoadVersionForOverwriting(version)
+	return err
+}
+
 func (t *mutableTree) AvailableVersions() []int {
 	t.lock.RLock()
 	defer t.lock.RUnlock()